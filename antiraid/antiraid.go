@@ -0,0 +1,124 @@
+// Package antiraid provides an optional bot.EventListener which detects suspicious member join rates.
+package antiraid
+
+import (
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var _ bot.EventListener = (*Detector)(nil)
+
+// New returns a new Detector with the given ConfigOpt(s) applied.
+// Add it to your bot.Client with bot.WithEventListeners.
+func New(opts ...ConfigOpt) *Detector {
+	config := DefaultConfig()
+	config.Apply(opts)
+
+	return &Detector{
+		config: *config,
+		guilds: map[snowflake.ID]*guildJoins{},
+	}
+}
+
+// RaidSuspected is dispatched when the join rate of a guild exceeded its Thresholds.
+type RaidSuspected struct {
+	*events.GenericEvent
+	GuildID    snowflake.ID
+	Thresholds Thresholds
+	// Joiners are the members which joined within the Thresholds.Window.
+	Joiners []discord.Member
+}
+
+// Detector tracks the events.GuildMemberJoin rate per guild over a sliding window and dispatches RaidSuspected once it exceeds the configured Thresholds.
+type Detector struct {
+	config Config
+
+	mu     sync.Mutex
+	guilds map[snowflake.ID]*guildJoins
+}
+
+type guildJoins struct {
+	joins []join
+	// alertedUntil suppresses further RaidSuspected events until the window of the last alert passed
+	alertedUntil time.Time
+}
+
+type join struct {
+	at     time.Time
+	member discord.Member
+}
+
+// OnEvent implements bot.EventListener.
+func (d *Detector) OnEvent(event bot.Event) {
+	switch e := event.(type) {
+	case *events.GuildMemberJoin:
+		d.memberJoin(e)
+	case *events.GuildLeave:
+		d.Reset(e.GuildID)
+	}
+}
+
+// Reset clears all tracked joins of the given guild.
+func (d *Detector) Reset(guildID snowflake.ID) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.guilds, guildID)
+}
+
+// Thresholds returns the Thresholds used for the given guild.
+func (d *Detector) Thresholds(guildID snowflake.ID) Thresholds {
+	if d.config.GuildThresholds != nil {
+		if thresholds, ok := d.config.GuildThresholds(guildID); ok {
+			return thresholds
+		}
+	}
+	return d.config.Thresholds
+}
+
+func (d *Detector) memberJoin(e *events.GuildMemberJoin) {
+	thresholds := d.Thresholds(e.GuildID)
+	if thresholds.Joins <= 0 || thresholds.Window <= 0 {
+		return
+	}
+	now := d.config.Clock.Now()
+
+	d.mu.Lock()
+	g, ok := d.guilds[e.GuildID]
+	if !ok {
+		g = &guildJoins{}
+		d.guilds[e.GuildID] = g
+	}
+
+	g.joins = append(g.joins, join{at: now, member: e.Member})
+	cutoff := now.Add(-thresholds.Window)
+	i := 0
+	for i < len(g.joins) && g.joins[i].at.Before(cutoff) {
+		i++
+	}
+	g.joins = g.joins[i:]
+
+	if len(g.joins) < thresholds.Joins || now.Before(g.alertedUntil) {
+		d.mu.Unlock()
+		return
+	}
+	g.alertedUntil = now.Add(thresholds.Window)
+
+	joiners := make([]discord.Member, len(g.joins))
+	for i, j := range g.joins {
+		joiners[i] = j.member
+	}
+	d.mu.Unlock()
+
+	// dispatch in a new goroutine as we are called from within the bot.EventManager which holds its listener lock
+	go e.Client().EventManager().DispatchEvent(&RaidSuspected{
+		GenericEvent: events.NewGenericEvent(e.Client(), e.SequenceNumber(), e.ShardID()),
+		GuildID:      e.GuildID,
+		Thresholds:   thresholds,
+		Joiners:      joiners,
+	})
+}
@@ -0,0 +1,90 @@
+package antiraid
+
+import (
+	"context"
+	"time"
+
+	"github.com/disgoorg/disgo/clock"
+	"github.com/disgoorg/disgo/json"
+	"github.com/disgoorg/disgo/store"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() *Config {
+	return &Config{
+		Thresholds: Thresholds{
+			Joins:  10,
+			Window: 10 * time.Second,
+		},
+		Clock: clock.Default,
+	}
+}
+
+// Config lets you configure your Detector instance.
+type Config struct {
+	Thresholds      Thresholds
+	GuildThresholds GuildThresholdsFunc
+	Clock           clock.Clock
+}
+
+// Thresholds define how many joins within which window are considered a raid.
+type Thresholds struct {
+	// Joins is the amount of joins within the Window which trigger a RaidSuspected event.
+	Joins int `json:"joins"`
+	// Window is the sliding window joins are counted in.
+	Window time.Duration `json:"window"`
+}
+
+// GuildThresholdsFunc is used to look up the Thresholds of a specific guild.
+// Return false to fall back to the default Thresholds.
+type GuildThresholdsFunc func(guildID snowflake.ID) (Thresholds, bool)
+
+// ConfigOpt is a type alias for a function that takes a Config and is used to configure your Detector.
+type ConfigOpt func(config *Config)
+
+// Apply applies the given ConfigOpt(s) to the Config
+func (c *Config) Apply(opts []ConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithThresholds sets the default Thresholds of the Config.
+func WithThresholds(joins int, window time.Duration) ConfigOpt {
+	return func(config *Config) {
+		config.Thresholds = Thresholds{
+			Joins:  joins,
+			Window: window,
+		}
+	}
+}
+
+// WithGuildThresholds sets the GuildThresholdsFunc of the Config which is used to look up per guild Thresholds.
+func WithGuildThresholds(guildThresholds GuildThresholdsFunc) ConfigOpt {
+	return func(config *Config) {
+		config.GuildThresholds = guildThresholds
+	}
+}
+
+// WithGuildThresholdsStore looks up per guild Thresholds as json from the given store.KV using the guild id as key.
+func WithGuildThresholdsStore(kv store.KV) ConfigOpt {
+	return WithGuildThresholds(func(guildID snowflake.ID) (Thresholds, bool) {
+		data, err := kv.Get(context.TODO(), guildID.String())
+		if err != nil {
+			return Thresholds{}, false
+		}
+		var thresholds Thresholds
+		if err = json.Unmarshal(data, &thresholds); err != nil {
+			return Thresholds{}, false
+		}
+		return thresholds, true
+	})
+}
+
+// WithClock sets the clock.Clock the Detector uses to track joins within the Thresholds.Window.
+func WithClock(clock clock.Clock) ConfigOpt {
+	return func(config *Config) {
+		config.Clock = clock
+	}
+}
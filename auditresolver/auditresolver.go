@@ -0,0 +1,161 @@
+// Package auditresolver provides an optional bot.EventListener which resolves the moderator responsible for bans, kicks & channel deletions via the audit log.
+package auditresolver
+
+import (
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var _ bot.EventListener = (*Resolver)(nil)
+
+// New returns a new Resolver with the given ConfigOpt(s) applied.
+// Add it to your bot.Client with bot.WithEventListeners. The bot requires the discord.PermissionViewAuditLogs permission.
+func New(opts ...ConfigOpt) *Resolver {
+	config := DefaultConfig()
+	config.Apply(opts)
+
+	return &Resolver{
+		config: *config,
+		logs:   map[logKey]*cachedLog{},
+	}
+}
+
+// ExecutorResolved is dispatched after the responsible moderator of a moderation event was found in the audit log.
+// Kicks are inferred from events.GuildMemberLeave, so this is only dispatched for leaves which have a matching discord.AuditLogEventMemberKick entry.
+type ExecutorResolved struct {
+	*events.GenericEvent
+	// Event is the original event, one of *events.GuildBan, *events.GuildMemberLeave or *events.GuildChannelDelete.
+	Event      bot.Event
+	GuildID    snowflake.ID
+	ActionType discord.AuditLogEvent
+	TargetID   snowflake.ID
+	Entry      discord.AuditLogEntry
+	// Executor is the user who executed the action. It is nil if the user was not included in the audit log.
+	Executor *discord.User
+}
+
+// Reason returns the audit log reason of the action if one was provided.
+func (e *ExecutorResolved) Reason() *string {
+	return e.Entry.Reason
+}
+
+// Resolver queries recent audit log entries for moderation events and dispatches ExecutorResolved events.
+type Resolver struct {
+	config Config
+
+	mu   sync.Mutex
+	logs map[logKey]*cachedLog
+}
+
+type logKey struct {
+	guildID    snowflake.ID
+	actionType discord.AuditLogEvent
+}
+
+type cachedLog struct {
+	mu        sync.Mutex
+	fetchedAt time.Time
+	auditLog  *discord.AuditLog
+}
+
+// OnEvent implements bot.EventListener.
+func (r *Resolver) OnEvent(event bot.Event) {
+	switch e := event.(type) {
+	case *events.GuildBan:
+		go r.resolve(e, e.GuildID, discord.AuditLogEventMemberBanAdd, e.User.ID)
+	case *events.GuildMemberLeave:
+		go r.resolve(e, e.GuildID, discord.AuditLogEventMemberKick, e.User.ID)
+	case *events.GuildChannelDelete:
+		go r.resolve(e, e.GuildID, discord.AuditLogEventChannelDelete, e.ChannelID)
+	case *events.GuildLeave:
+		r.Reset(e.GuildID)
+	}
+}
+
+// Reset clears all cached audit logs of the given guild.
+func (r *Resolver) Reset(guildID snowflake.ID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key := range r.logs {
+		if key.guildID == guildID {
+			delete(r.logs, key)
+		}
+	}
+}
+
+// Resolve looks up the newest audit log entry of the given action type & target which is not older than the configured MaxEntryAge.
+// Audit logs fetched after since are reused for the configured CacheDuration to avoid hitting rate limits during mass bans or deletions.
+func (r *Resolver) Resolve(client bot.Client, guildID snowflake.ID, actionType discord.AuditLogEvent, targetID snowflake.ID, since time.Time) (*discord.AuditLogEntry, *discord.User, error) {
+	key := logKey{guildID: guildID, actionType: actionType}
+
+	r.mu.Lock()
+	cached, ok := r.logs[key]
+	if !ok {
+		cached = &cachedLog{}
+		r.logs[key] = cached
+	}
+	r.mu.Unlock()
+
+	// only one request per guild & action type at a time, concurrent events wait for and reuse its result
+	cached.mu.Lock()
+	defer cached.mu.Unlock()
+
+	now := r.config.Clock.Now()
+	if cached.auditLog == nil || cached.fetchedAt.Before(since) || now.Sub(cached.fetchedAt) > r.config.CacheDuration {
+		auditLog, err := client.Rest().GetAuditLog(guildID, 0, actionType, 0, r.config.Limit)
+		if err != nil {
+			return nil, nil, err
+		}
+		cached.auditLog = auditLog
+		cached.fetchedAt = now
+	}
+
+	for _, entry := range cached.auditLog.Entries {
+		if entry.TargetID == nil || *entry.TargetID != targetID || now.Sub(entry.ID.Time()) > r.config.MaxEntryAge {
+			continue
+		}
+		for _, user := range cached.auditLog.Users {
+			if user.ID == entry.UserID {
+				return &entry, &user, nil
+			}
+		}
+		return &entry, nil, nil
+	}
+	return nil, nil, nil
+}
+
+func (r *Resolver) resolve(event bot.Event, guildID snowflake.ID, actionType discord.AuditLogEvent, targetID snowflake.ID) {
+	since := r.config.Clock.Now()
+	if r.config.Delay > 0 {
+		<-r.config.Clock.After(r.config.Delay)
+	}
+
+	client := event.Client()
+	entry, executor, err := r.Resolve(client, guildID, actionType, targetID, since)
+	if err != nil {
+		client.Logger().Errorf("failed to resolve audit log entry for guild %s and action type %d: %s", guildID, actionType, err)
+		return
+	}
+	if entry == nil {
+		return
+	}
+
+	var shardID int
+	if e, ok := event.(interface{ ShardID() int }); ok {
+		shardID = e.ShardID()
+	}
+	client.EventManager().DispatchEvent(&ExecutorResolved{
+		GenericEvent: events.NewGenericEvent(client, event.SequenceNumber(), shardID),
+		Event:        event,
+		GuildID:      guildID,
+		ActionType:   actionType,
+		TargetID:     targetID,
+		Entry:        *entry,
+		Executor:     executor,
+	})
+}
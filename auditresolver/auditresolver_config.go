@@ -0,0 +1,77 @@
+package auditresolver
+
+import (
+	"time"
+
+	"github.com/disgoorg/disgo/clock"
+)
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() *Config {
+	return &Config{
+		Delay:         time.Second,
+		MaxEntryAge:   15 * time.Second,
+		CacheDuration: 5 * time.Second,
+		Limit:         10,
+		Clock:         clock.Default,
+	}
+}
+
+// Config lets you configure your Resolver instance.
+type Config struct {
+	// Delay is how long to wait before querying the audit log as entries are created slightly after the gateway event is sent.
+	Delay time.Duration
+	// MaxEntryAge is how old an audit log entry may be to still be attributed to an event.
+	MaxEntryAge time.Duration
+	// CacheDuration is how long fetched audit log pages are reused for further events of the same guild and action type.
+	CacheDuration time.Duration
+	// Limit is the amount of audit log entries fetched per request.
+	Limit int
+	// Clock is used for the Delay, the MaxEntryAge and the CacheDuration.
+	Clock clock.Clock
+}
+
+// ConfigOpt is a type alias for a function that takes a Config and is used to configure your Resolver.
+type ConfigOpt func(config *Config)
+
+// Apply applies the given ConfigOpt(s) to the Config
+func (c *Config) Apply(opts []ConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithDelay sets the Delay of the Config.
+func WithDelay(delay time.Duration) ConfigOpt {
+	return func(config *Config) {
+		config.Delay = delay
+	}
+}
+
+// WithMaxEntryAge sets the MaxEntryAge of the Config.
+func WithMaxEntryAge(maxEntryAge time.Duration) ConfigOpt {
+	return func(config *Config) {
+		config.MaxEntryAge = maxEntryAge
+	}
+}
+
+// WithCacheDuration sets the CacheDuration of the Config.
+func WithCacheDuration(cacheDuration time.Duration) ConfigOpt {
+	return func(config *Config) {
+		config.CacheDuration = cacheDuration
+	}
+}
+
+// WithLimit sets the Limit of the Config.
+func WithLimit(limit int) ConfigOpt {
+	return func(config *Config) {
+		config.Limit = limit
+	}
+}
+
+// WithClock sets the Clock of the Config.
+func WithClock(clock clock.Clock) ConfigOpt {
+	return func(config *Config) {
+		config.Clock = clock
+	}
+}
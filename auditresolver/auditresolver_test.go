@@ -0,0 +1,76 @@
+package auditresolver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/clock"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRest returns the same audit log for every request and counts them.
+type fakeRest struct {
+	rest.Rest
+	auditLog discord.AuditLog
+	requests int
+}
+
+func (r *fakeRest) GetAuditLog(_ snowflake.ID, _ snowflake.ID, _ discord.AuditLogEvent, _ snowflake.ID, _ int, _ ...rest.RequestOpt) (*discord.AuditLog, error) {
+	r.requests++
+	auditLog := r.auditLog
+	return &auditLog, nil
+}
+
+type fakeClient struct {
+	bot.Client
+	rest *fakeRest
+}
+
+func (c *fakeClient) Rest() rest.Rest {
+	return c.rest
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	mock := clock.NewMock(now)
+	targetID := snowflake.ID(5)
+	executor := discord.User{ID: 7}
+	client := &fakeClient{rest: &fakeRest{auditLog: discord.AuditLog{
+		Entries: []discord.AuditLogEntry{{ID: snowflake.New(now.Add(-time.Second)), TargetID: &targetID, UserID: executor.ID}},
+		Users:   []discord.User{executor},
+	}}}
+	r := New(WithClock(mock))
+
+	entry, user, err := r.Resolve(client, 1, discord.AuditLogEventMemberBanAdd, targetID, now)
+	assert.NoError(t, err)
+	if assert.NotNil(t, entry) && assert.NotNil(t, user) {
+		assert.Equal(t, executor.ID, user.ID)
+	}
+	assert.Equal(t, 1, client.rest.requests)
+
+	// the audit log is reused within the CacheDuration
+	mock.Advance(5 * time.Second)
+	_, _, err = r.Resolve(client, 1, discord.AuditLogEventMemberBanAdd, targetID, now)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, client.rest.requests)
+
+	// but not for events after it was fetched
+	_, _, err = r.Resolve(client, 1, discord.AuditLogEventMemberBanAdd, targetID, now.Add(time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, client.rest.requests)
+
+	mock.Advance(5*time.Second + time.Nanosecond)
+	_, _, err = r.Resolve(client, 1, discord.AuditLogEventMemberBanAdd, targetID, now)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, client.rest.requests)
+
+	// the entry is older than the MaxEntryAge by now
+	mock.Advance(5 * time.Second)
+	entry, _, err = r.Resolve(client, 1, discord.AuditLogEventMemberBanAdd, targetID, now)
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+}
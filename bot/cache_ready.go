@@ -0,0 +1,76 @@
+package bot
+
+import (
+	"context"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+)
+
+// cacheReadyPollInterval is how often Client.WaitForCacheReady checks whether the cache.Caches are ready.
+const cacheReadyPollInterval = 100 * time.Millisecond
+
+// CacheReadyEntity is a part of the cache.Caches Client.WaitForCacheReady can wait for.
+type CacheReadyEntity int
+
+// All CacheReadyEntity(s)
+const (
+	// CacheReadyGuilds is ready once every shard received its gateway.EventTypeReady and the gateway.EventTypeGuildCreate of every guild in it.
+	CacheReadyGuilds CacheReadyEntity = iota
+	// CacheReadyMembers is ready once CacheReadyGuilds is ready and the MemberChunkingManager received the members of every guild passing the MemberChunkingFilter.
+	CacheReadyMembers
+)
+
+func (c *clientImpl) WaitForCacheReady(ctx context.Context, entities ...CacheReadyEntity) error {
+	if !c.HasGateway() && !c.HasShardManager() {
+		return discord.ErrNoGatewayOrShardManager
+	}
+	if len(entities) == 0 {
+		entities = []CacheReadyEntity{CacheReadyGuilds, CacheReadyMembers}
+	}
+
+	ticker := c.clock.NewTicker(cacheReadyPollInterval)
+	defer ticker.Stop()
+	for {
+		if c.cacheReady(entities) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+		}
+	}
+}
+
+func (c *clientImpl) cacheReady(entities []CacheReadyEntity) bool {
+	// the guilds are checked before the pending chunks, as guilds are only marked ready after their chunking was registered
+	if !c.guildsReady() {
+		return false
+	}
+	for _, entity := range entities {
+		if entity == CacheReadyMembers && len(c.memberChunkingManager.PendingGuilds()) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *clientImpl) guildsReady() bool {
+	shards := map[int]gateway.Gateway{}
+	if c.HasGateway() {
+		shards[c.gateway.ShardID()] = c.gateway
+	} else {
+		shards = c.shardManager.Shards()
+	}
+	if len(shards) == 0 {
+		return false
+	}
+	for shardID, shard := range shards {
+		if shard.Status() != gateway.StatusReady || len(c.caches.Guilds().UnreadyGuilds(shardID)) > 0 {
+			return false
+		}
+	}
+	return true
+}
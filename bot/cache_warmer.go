@@ -0,0 +1,97 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var _ CacheWarmer = (*cacheWarmerImpl)(nil)
+
+// NewCacheWarmer returns a new CacheWarmer which fetches the entities with the rest.Rest of the given Client.
+func NewCacheWarmer(client Client) CacheWarmer {
+	return &cacheWarmerImpl{client: client}
+}
+
+// CacheWarmer pre-populates the cache.Caches via the rest API, so early event listeners don't see empty caches.
+// It is used after the gateway.EventTypeReady event, which is followed by an events.CacheReady event once warming is done.
+type CacheWarmer interface {
+	// WarmGuilds fetches the channels, roles & emojis of the given guilds and puts them into the cache.Caches.
+	// Entities which are already cached are not replaced, as they were received via the gateway in the meantime.
+	// It continues with the next guild if a request fails and returns a *CacheWarmError with all failed guilds.
+	WarmGuilds(ctx context.Context, guildIDs ...snowflake.ID) error
+}
+
+// CacheWarmError is returned by CacheWarmer.WarmGuilds if some guilds could not be warmed.
+type CacheWarmError struct {
+	// Errors are the errors of the guilds which could not be warmed by their ID.
+	Errors map[snowflake.ID]error
+}
+
+// GuildIDs returns the IDs of the guilds which could not be warmed.
+func (e *CacheWarmError) GuildIDs() []snowflake.ID {
+	guildIDs := make([]snowflake.ID, 0, len(e.Errors))
+	for guildID := range e.Errors {
+		guildIDs = append(guildIDs, guildID)
+	}
+	return guildIDs
+}
+
+func (e *CacheWarmError) Error() string {
+	return fmt.Sprintf("failed to warm cache of %d guilds", len(e.Errors))
+}
+
+type cacheWarmerImpl struct {
+	client Client
+}
+
+func (w *cacheWarmerImpl) WarmGuilds(ctx context.Context, guildIDs ...snowflake.ID) error {
+	errs := make(map[snowflake.ID]error)
+	for _, guildID := range guildIDs {
+		err := ctx.Err()
+		if err == nil {
+			err = w.warmGuild(ctx, guildID)
+		}
+		if err != nil {
+			w.client.Logger().Errorf("failed to warm cache of guild %s: %s", guildID, err)
+			errs[guildID] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &CacheWarmError{Errors: errs}
+	}
+	return nil
+}
+
+func (w *cacheWarmerImpl) warmGuild(ctx context.Context, guildID snowflake.ID) error {
+	caches := w.client.Caches()
+
+	channels, err := w.client.Rest().GetGuildChannels(guildID, rest.WithCtx(ctx))
+	if err != nil {
+		return err
+	}
+	for _, channel := range channels {
+		channel = discord.ApplyGuildIDToChannel(channel, guildID) // populate unset field
+		caches.Channels().PutIfAbsent(channel.ID(), channel)
+	}
+
+	roles, err := w.client.Rest().GetRoles(guildID, rest.WithCtx(ctx))
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		caches.Roles().PutIfAbsent(guildID, role.ID, role)
+	}
+
+	emojis, err := w.client.Rest().GetEmojis(guildID, rest.WithCtx(ctx))
+	if err != nil {
+		return err
+	}
+	for _, emoji := range emojis {
+		caches.Emojis().PutIfAbsent(guildID, emoji.ID, emoji)
+	}
+	return nil
+}
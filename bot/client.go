@@ -2,8 +2,11 @@ package bot
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/clock"
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/gateway"
 	"github.com/disgoorg/disgo/httpserver"
@@ -22,9 +25,17 @@ type Client interface {
 	// Logger returns the logger for the client.
 	Logger() log.Logger
 
+	// Clock returns the clock.Clock used by the client and its default components.
+	Clock() clock.Clock
+
 	// Close will clean up all disgo internals and close the discord gracefully.
+	// If a StateStore is configured, the gateway sessions are kept open for resuming and the State is saved before.
 	Close(ctx context.Context)
 
+	// SaveState saves the gateway sessions and cache snapshot to the configured StateStore. It does nothing without a StateStore.
+	// Saving periodically allows resuming after a crash, as Discord replays all events since the saved sequence.
+	SaveState(ctx context.Context) error
+
 	// Token returns the configured bot token.
 	Token() string
 
@@ -46,6 +57,12 @@ type Client interface {
 	// RemoveEventListeners removes one or more EventListener(s) from the EventManager
 	RemoveEventListeners(listeners ...EventListener)
 
+	// AddGuildEventListeners adds one or more EventListener(s) to the EventManager which only receive events of the given guild.
+	AddGuildEventListeners(guildID snowflake.ID, listeners ...EventListener)
+
+	// RemoveGuildEventListeners removes one or more guild scoped EventListener(s) from the EventManager
+	RemoveGuildEventListeners(guildID snowflake.ID, listeners ...EventListener)
+
 	// EventManager returns the EventManager used by the Client.
 	EventManager() EventManager
 
@@ -76,6 +93,9 @@ type Client interface {
 	// Disconnect sends a discord.MessageDataVoiceStateUpdate to the specific gateway.Gateway and disconnects the bot from this guild.
 	Disconnect(ctx context.Context, guildID snowflake.ID) error
 
+	// VoiceManager returns the VoiceManager used by the Client.
+	VoiceManager() VoiceManager
+
 	// RequestMembers sends a discord.MessageDataRequestGuildMembers to the specific gateway.Gateway and requests the Member(s) of the specified guild.
 	//  guildID  : is the snowflake of the guild to request the members of.
 	//  presence : Weather or not to include discord.Presence data.
@@ -100,6 +120,20 @@ type Client interface {
 	// MemberChunkingManager returns the MemberChunkingManager used by the Client.
 	MemberChunkingManager() MemberChunkingManager
 
+	// CacheWarmer returns the CacheWarmer used by the Client or nil if cache warming is disabled.
+	CacheWarmer() CacheWarmer
+
+	// WaitForCacheReady blocks until the given CacheReadyEntity(s) are ready or the context is done, so startup routines can rely on the cache.Caches.
+	// Without any CacheReadyEntity given, it waits for all of them. It returns the error of the context if it is done first.
+	WaitForCacheReady(ctx context.Context, entities ...CacheReadyEntity) error
+
+	// MessageScheduler returns the MessageScheduler used by the Client.
+	MessageScheduler() MessageScheduler
+
+	// ScheduleMessage schedules the discord.MessageCreate to be sent to the channel at the given time via the MessageScheduler.
+	// Use discord.MessageCreateBuilder.Build to schedule a message from a builder.
+	ScheduleMessage(ctx context.Context, channelID snowflake.ID, messageCreate discord.MessageCreate, at time.Time) (*ScheduledMessage, error)
+
 	// StartHTTPServer starts the configured HTTPServer used for interactions over webhooks.
 	StartHTTPServer() error
 
@@ -108,6 +142,17 @@ type Client interface {
 
 	// HasHTTPServer returns whether the Client has a configured HTTPServer.
 	HasHTTPServer() bool
+
+	// IsOwner returns whether the user owns the application or is an accepted member of the owning team.
+	// The owners are fetched once on first use and cached. Use RefreshOwners to update them.
+	IsOwner(userID snowflake.ID) bool
+
+	// RefreshOwners fetches the owners of the application and caches them for IsOwner.
+	RefreshOwners(opts ...rest.RequestOpt) error
+
+	// CurrentApplication returns the discord.Application of the bot with its discord.ApplicationFlags and approximate counts.
+	// The application is cached and only fetched again once it is older than maxAge.
+	CurrentApplication(maxAge time.Duration, opts ...rest.RequestOpt) (*discord.Application, error)
 }
 
 type clientImpl struct {
@@ -115,6 +160,7 @@ type clientImpl struct {
 	applicationID snowflake.ID
 
 	logger log.Logger
+	clock  clock.Clock
 
 	restServices rest.Rest
 
@@ -128,16 +174,53 @@ type clientImpl struct {
 	caches cache.Caches
 
 	memberChunkingManager MemberChunkingManager
+	voiceManager          VoiceManager
+	messageScheduler      MessageScheduler
+	cacheWarmer           CacheWarmer
+	stateStore            StateStore
+
+	ownersMu sync.Mutex
+	ownerIDs map[snowflake.ID]struct{}
+
+	applicationMu        sync.Mutex
+	application          *discord.Application
+	applicationFetchedAt time.Time
+
+	stopReconcile context.CancelFunc
 }
 
 func (c *clientImpl) Logger() log.Logger {
 	return c.logger
 }
 
+func (c *clientImpl) Clock() clock.Clock {
+	return c.clock
+}
+
 func (c *clientImpl) Close(ctx context.Context) {
+	if c.stopReconcile != nil {
+		c.stopReconcile()
+	}
+	if c.messageScheduler != nil {
+		c.messageScheduler.Close()
+	}
 	if c.restServices != nil {
 		c.restServices.Close(ctx)
 	}
+	if c.stateStore != nil {
+		// close with a code which keeps the sessions resumable
+		if c.gateway != nil {
+			c.gateway.CloseWithCode(ctx, int(gateway.CloseEventCodeServiceRestart), "Restarting")
+		}
+		if c.shardManager != nil {
+			for _, shard := range c.shardManager.Shards() {
+				shard.CloseWithCode(ctx, int(gateway.CloseEventCodeServiceRestart), "Restarting")
+			}
+		}
+		if err := c.SaveState(ctx); err != nil {
+			c.logger.Error("failed to save state: ", err)
+		}
+	}
 	if c.gateway != nil {
 		c.gateway.Close(ctx)
 	}
@@ -172,6 +255,14 @@ func (c *clientImpl) Rest() rest.Rest {
 	return c.restServices
 }
 
+// getMessage returns the message from the cache or requests it from the rest api. It is used as discord.MessageGetter.
+func (c *clientImpl) getMessage(ctx context.Context, channelID snowflake.ID, messageID snowflake.ID) (*discord.Message, error) {
+	if message, ok := c.caches.Messages().Get(channelID, messageID); ok {
+		return &message, nil
+	}
+	return c.restServices.GetMessage(channelID, messageID, rest.WithCtx(ctx))
+}
+
 func (c *clientImpl) AddEventListeners(listeners ...EventListener) {
 	c.eventManager.AddEventListeners(listeners...)
 }
@@ -180,6 +271,14 @@ func (c *clientImpl) RemoveEventListeners(listeners ...EventListener) {
 	c.eventManager.RemoveEventListeners(listeners...)
 }
 
+func (c *clientImpl) AddGuildEventListeners(guildID snowflake.ID, listeners ...EventListener) {
+	c.eventManager.AddGuildEventListeners(guildID, listeners...)
+}
+
+func (c *clientImpl) RemoveGuildEventListeners(guildID snowflake.ID, listeners ...EventListener) {
+	c.eventManager.RemoveGuildEventListeners(guildID, listeners...)
+}
+
 func (c *clientImpl) EventManager() EventManager {
 	return c.eventManager
 }
@@ -228,25 +327,15 @@ func (c *clientImpl) Shard(guildID snowflake.ID) (gateway.Gateway, error) {
 }
 
 func (c *clientImpl) Connect(ctx context.Context, guildID snowflake.ID, channelID snowflake.ID) error {
-	shard, err := c.Shard(guildID)
-	if err != nil {
-		return err
-	}
-	return shard.Send(ctx, gateway.OpcodeVoiceStateUpdate, gateway.MessageDataVoiceStateUpdate{
-		GuildID:   guildID,
-		ChannelID: &channelID,
-	})
+	return c.voiceManager.UpdateVoiceState(ctx, guildID, &channelID, false, false)
 }
 
 func (c *clientImpl) Disconnect(ctx context.Context, guildID snowflake.ID) error {
-	shard, err := c.Shard(guildID)
-	if err != nil {
-		return err
-	}
-	return shard.Send(ctx, gateway.OpcodeVoiceStateUpdate, gateway.MessageDataVoiceStateUpdate{
-		GuildID:   guildID,
-		ChannelID: nil,
-	})
+	return c.voiceManager.UpdateVoiceState(ctx, guildID, nil, false, false)
+}
+
+func (c *clientImpl) VoiceManager() VoiceManager {
+	return c.voiceManager
 }
 
 func (c *clientImpl) RequestMembers(ctx context.Context, guildID snowflake.ID, presence bool, nonce string, userIDs ...snowflake.ID) error {
@@ -254,7 +343,7 @@ func (c *clientImpl) RequestMembers(ctx context.Context, guildID snowflake.ID, p
 	if err != nil {
 		return err
 	}
-	return shard.Send(ctx, gateway.OpcodeRequestGuildMembers, gateway.MessageDataRequestGuildMembers{
+	return shard.RequestGuildMembers(ctx, gateway.MessageDataRequestGuildMembers{
 		GuildID:   guildID,
 		Presences: presence,
 		UserIDs:   userIDs,
@@ -267,7 +356,7 @@ func (c *clientImpl) RequestMembersWithQuery(ctx context.Context, guildID snowfl
 	if err != nil {
 		return err
 	}
-	return shard.Send(ctx, gateway.OpcodeRequestGuildMembers, gateway.MessageDataRequestGuildMembers{
+	return shard.RequestGuildMembers(ctx, gateway.MessageDataRequestGuildMembers{
 		GuildID:   guildID,
 		Query:     &query,
 		Limit:     &limit,
@@ -280,7 +369,7 @@ func (c *clientImpl) SetPresence(ctx context.Context, presenceUpdate gateway.Mes
 	if !c.HasGateway() {
 		return discord.ErrNoGateway
 	}
-	return c.gateway.Send(ctx, gateway.OpcodePresenceUpdate, presenceUpdate)
+	return c.gateway.UpdatePresence(ctx, presenceUpdate)
 }
 
 func (c *clientImpl) SetPresenceForShard(ctx context.Context, shardId int, presenceUpdate gateway.MessageDataPresenceUpdate) error {
@@ -291,7 +380,19 @@ func (c *clientImpl) SetPresenceForShard(ctx context.Context, shardId int, prese
 	if shard == nil {
 		return discord.ErrShardNotFound
 	}
-	return shard.Send(ctx, gateway.OpcodePresenceUpdate, presenceUpdate)
+	return shard.UpdatePresence(ctx, presenceUpdate)
+}
+
+func (c *clientImpl) CacheWarmer() CacheWarmer {
+	return c.cacheWarmer
+}
+
+func (c *clientImpl) MessageScheduler() MessageScheduler {
+	return c.messageScheduler
+}
+
+func (c *clientImpl) ScheduleMessage(ctx context.Context, channelID snowflake.ID, messageCreate discord.MessageCreate, at time.Time) (*ScheduledMessage, error) {
+	return c.messageScheduler.ScheduleMessage(ctx, channelID, messageCreate, at)
 }
 
 func (c *clientImpl) MemberChunkingManager() MemberChunkingManager {
@@ -313,3 +414,55 @@ func (c *clientImpl) HTTPServer() httpserver.Server {
 func (c *clientImpl) HasHTTPServer() bool {
 	return c.httpServer != nil
 }
+
+func (c *clientImpl) IsOwner(userID snowflake.ID) bool {
+	c.ownersMu.Lock()
+	loaded := c.ownerIDs != nil
+	c.ownersMu.Unlock()
+
+	if !loaded {
+		if err := c.RefreshOwners(); err != nil {
+			c.logger.Error("failed to fetch application owners: ", err)
+			return false
+		}
+	}
+
+	c.ownersMu.Lock()
+	defer c.ownersMu.Unlock()
+	_, ok := c.ownerIDs[userID]
+	return ok
+}
+
+func (c *clientImpl) RefreshOwners(opts ...rest.RequestOpt) error {
+	application, err := c.restServices.GetBotApplicationInfo(opts...)
+	if err != nil {
+		return err
+	}
+
+	ownerIDs := make(map[snowflake.ID]struct{})
+	for _, ownerID := range application.OwnerIDs() {
+		ownerIDs[ownerID] = struct{}{}
+	}
+
+	c.ownersMu.Lock()
+	defer c.ownersMu.Unlock()
+	c.ownerIDs = ownerIDs
+	return nil
+}
+
+func (c *clientImpl) CurrentApplication(maxAge time.Duration, opts ...rest.RequestOpt) (*discord.Application, error) {
+	c.applicationMu.Lock()
+	defer c.applicationMu.Unlock()
+
+	if c.application != nil && c.clock.Now().Sub(c.applicationFetchedAt) < maxAge {
+		return c.application, nil
+	}
+
+	application, err := c.restServices.GetCurrentApplication(opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.application = application
+	c.applicationFetchedAt = c.clock.Now()
+	return application, nil
+}
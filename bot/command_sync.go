@@ -0,0 +1,46 @@
+package bot
+
+import (
+	"fmt"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// SyncCommands overwrites the application commands with the given discord.ApplicationCommandCreate(s).
+// If no guildIDs are provided the commands are registered globally, otherwise to each of the given guilds.
+// Commands not part of the given commands are removed.
+func SyncCommands(client Client, commands []discord.ApplicationCommandCreate, guildIDs []snowflake.ID, opts ...rest.RequestOpt) error {
+	if len(guildIDs) == 0 {
+		_, err := client.Rest().SetGlobalCommands(client.ApplicationID(), commands, opts...)
+		return err
+	}
+	for _, guildID := range guildIDs {
+		if _, err := client.Rest().SetGuildCommands(client.ApplicationID(), guildID, commands, opts...); err != nil {
+			return fmt.Errorf("failed to sync commands in guild %s: %w", guildID, err)
+		}
+	}
+	return nil
+}
+
+// SyncCommandsDev works like SyncCommands but switches between development & production registration.
+// In development mode all commands are registered to the devGuildIDs, which makes them available instantly instead of waiting for global commands to propagate.
+// Global commands are left untouched in development mode.
+// Outside development mode the commands are registered globally and the commands of the devGuildIDs are removed, so they don't show up twice.
+func SyncCommandsDev(client Client, development bool, commands []discord.ApplicationCommandCreate, devGuildIDs []snowflake.ID, opts ...rest.RequestOpt) error {
+	if development {
+		if len(devGuildIDs) == 0 {
+			return fmt.Errorf("development mode requires at least one dev guild id")
+		}
+		return SyncCommands(client, commands, devGuildIDs, opts...)
+	}
+
+	if err := SyncCommands(client, commands, nil, opts...); err != nil {
+		return err
+	}
+	if len(devGuildIDs) == 0 {
+		return nil
+	}
+	return SyncCommands(client, []discord.ApplicationCommandCreate{}, devGuildIDs, opts...)
+}
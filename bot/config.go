@@ -1,22 +1,29 @@
 package bot
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/clock"
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/gateway"
 	"github.com/disgoorg/disgo/httpserver"
 	"github.com/disgoorg/disgo/internal/tokenhelper"
 	"github.com/disgoorg/disgo/rest"
 	"github.com/disgoorg/disgo/sharding"
+	"github.com/disgoorg/disgo/store"
 	"github.com/disgoorg/log"
+	"github.com/disgoorg/snowflake/v2"
 )
 
 // DefaultConfig returns a Config with sensible defaults.
 func DefaultConfig(gatewayHandlers map[gateway.EventType]GatewayEventHandler, httpHandler HTTPServerEventHandler) *Config {
 	return &Config{
 		Logger:                 log.Default(),
+		Clock:                  clock.Default,
 		EventManagerConfigOpts: []EventManagerConfigOpt{WithGatewayHandlers(gatewayHandlers), WithHTTPServerHandler(httpHandler)},
 		MemberChunkingFilter:   MemberChunkingFilterNone,
 	}
@@ -25,6 +32,7 @@ func DefaultConfig(gatewayHandlers map[gateway.EventType]GatewayEventHandler, ht
 // Config lets you configure your Client instance.
 type Config struct {
 	Logger log.Logger
+	Clock  clock.Clock
 
 	RestClient           rest.Client
 	RestClientConfigOpts []rest.ConfigOpt
@@ -46,8 +54,22 @@ type Config struct {
 	Caches          cache.Caches
 	CacheConfigOpts []cache.ConfigOpt
 
-	MemberChunkingManager MemberChunkingManager
-	MemberChunkingFilter  MemberChunkingFilter
+	MemberChunkingManager       MemberChunkingManager
+	MemberChunkingFilter        MemberChunkingFilter
+	AutoMemberChunking          bool
+	MemberChunkingDoneEventFunc MemberChunkingDoneEventFunc
+
+	VoiceManager VoiceManager
+
+	MessageScheduler      MessageScheduler
+	MessageSchedulerStore store.KV
+
+	CacheWarming bool
+	CacheWarmer  CacheWarmer
+
+	GuildMemberCountReconcileInterval time.Duration
+
+	StateStore StateStore
 }
 
 // ConfigOpt is a type alias for a function that takes a Config and is used to configure your Client.
@@ -67,6 +89,14 @@ func WithLogger(logger log.Logger) ConfigOpt {
 	}
 }
 
+// WithClock lets you inject your own clock.Clock. It is passed to the rate limiters, Caches & MessageScheduler created by default,
+// which lets tests advance time deterministically with a clock.Mock.
+func WithClock(clock clock.Clock) ConfigOpt {
+	return func(config *Config) {
+		config.Clock = clock
+	}
+}
+
 // WithRestClient lets you inject your own rest.Client.
 func WithRestClient(restClient rest.Client) ConfigOpt {
 	return func(config *Config) {
@@ -185,11 +215,84 @@ func WithMemberChunkingFilter(memberChunkingFilter MemberChunkingFilter) ConfigO
 	}
 }
 
+// WithAutoMemberChunking lets the default MemberChunkingManager request the members of every guild on gateway.EventTypeGuildCreate while cache.FlagMembers is enabled.
+// The members are put into the member cache and an events.GuildMembersChunkingDone event is dispatched once a guild is fully chunked.
+// It is combined with the MemberChunkingFilter, so guilds passing either of them are chunked. Notice: This requires the gateway.IntentGuildMembers.
+func WithAutoMemberChunking() ConfigOpt {
+	return func(config *Config) {
+		config.AutoMemberChunking = true
+	}
+}
+
+// WithMemberChunkingDoneEventFunc lets you configure the MemberChunkingDoneEventFunc the default MemberChunkingManager uses to create the Event dispatched once a guild is fully chunked.
+func WithMemberChunkingDoneEventFunc(memberChunkingDoneEventFunc MemberChunkingDoneEventFunc) ConfigOpt {
+	return func(config *Config) {
+		config.MemberChunkingDoneEventFunc = memberChunkingDoneEventFunc
+	}
+}
+
+// WithVoiceManager lets you inject your own VoiceManager.
+func WithVoiceManager(voiceManager VoiceManager) ConfigOpt {
+	return func(config *Config) {
+		config.VoiceManager = voiceManager
+	}
+}
+
+// WithMessageScheduler lets you inject your own MessageScheduler.
+func WithMessageScheduler(messageScheduler MessageScheduler) ConfigOpt {
+	return func(config *Config) {
+		config.MessageScheduler = messageScheduler
+	}
+}
+
+// WithMessageSchedulerStore sets the store.KV the default MessageScheduler persists its ScheduledMessage(s) in.
+// The persisted messages are restored when the Client is built. Without a store.KV, scheduled messages are lost on restart.
+func WithMessageSchedulerStore(kv store.KV) ConfigOpt {
+	return func(config *Config) {
+		config.MessageSchedulerStore = kv
+	}
+}
+
+// WithCacheWarming enables the default CacheWarmer, which fetches the channels, roles & emojis of all guilds via the rest API after the gateway.EventTypeReady event.
+// Once done, an events.CacheReady event is dispatched. This costs 3 requests per guild on every identify.
+func WithCacheWarming() ConfigOpt {
+	return func(config *Config) {
+		config.CacheWarming = true
+	}
+}
+
+// WithCacheWarmer lets you inject your own CacheWarmer. This also enables cache warming.
+func WithCacheWarmer(cacheWarmer CacheWarmer) ConfigOpt {
+	return func(config *Config) {
+		config.CacheWarmer = cacheWarmer
+	}
+}
+
+// WithGuildMemberCountReconcileInterval lets you periodically reconcile the cache.GuildMemberCount of all cached guilds via the rest API.
+// Every interval one request per guild is made, so choose it according to the number of guilds. By default, counts are only tracked via gateway events.
+func WithGuildMemberCountReconcileInterval(interval time.Duration) ConfigOpt {
+	return func(config *Config) {
+		config.GuildMemberCountReconcileInterval = interval
+	}
+}
+
+// WithStateStore lets you persist the gateway sessions & cache snapshot across restarts with the given StateStore.
+// The State is saved on Client.Close and restored when the Client is built, so the gateway.Gateway or the shards RESUME their sessions instead of identifying again.
+func WithStateStore(stateStore StateStore) ConfigOpt {
+	return func(config *Config) {
+		config.StateStore = stateStore
+	}
+}
+
 // BuildClient creates a new Client instance with the given token, Config, gateway handlers, http handlers os, name, github & version.
+// The Config is validated before anything is created, see Config.Validate.
 func BuildClient(token string, config Config, gatewayEventHandlerFunc func(client Client) gateway.EventHandlerFunc, httpServerEventHandlerFunc func(client Client) httpserver.EventHandlerFunc, os string, name string, github string, version string) (Client, error) {
 	if token == "" {
 		return nil, discord.ErrNoBotToken
 	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
 	id, err := tokenhelper.IDFromToken(token)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting application id from token: %w", err)
@@ -197,6 +300,7 @@ func BuildClient(token string, config Config, gatewayEventHandlerFunc func(clien
 	client := &clientImpl{
 		token:  token,
 		logger: config.Logger,
+		clock:  config.Clock,
 	}
 
 	client.applicationID = *id
@@ -207,7 +311,7 @@ func BuildClient(token string, config Config, gatewayEventHandlerFunc func(clien
 			rest.WithUserAgent(fmt.Sprintf("DiscordBot (%s, %s)", github, version)),
 			rest.WithLogger(client.logger),
 			func(config *rest.Config) {
-				config.RateRateLimiterConfigOpts = append([]rest.RateLimiterConfigOpt{rest.WithRateLimiterLogger(client.logger)}, config.RateRateLimiterConfigOpts...)
+				config.RateRateLimiterConfigOpts = append([]rest.RateLimiterConfigOpt{rest.WithRateLimiterLogger(client.logger), rest.WithRateLimiterClock(client.clock)}, config.RateRateLimiterConfigOpts...)
 			},
 		}, config.RestClientConfigOpts...)
 
@@ -219,6 +323,21 @@ func BuildClient(token string, config Config, gatewayEventHandlerFunc func(clien
 	}
 	client.restServices = config.Rest
 
+	if discord.AttachmentURLRefresher == nil {
+		discord.AttachmentURLRefresher = client.restServices.RefreshAttachmentURL
+	}
+	if discord.MessageGetter == nil {
+		discord.MessageGetter = client.getMessage
+	}
+
+	client.stateStore = config.StateStore
+	var state *State
+	if client.stateStore != nil {
+		if state, err = client.stateStore.LoadState(context.TODO()); err != nil {
+			return nil, fmt.Errorf("failed to load state: %w", err)
+		}
+	}
+
 	if config.EventManager == nil {
 		config.EventManager = NewEventManager(client, config.EventManagerConfigOpts...)
 	}
@@ -233,14 +352,24 @@ func BuildClient(token string, config Config, gatewayEventHandlerFunc func(clien
 
 		config.GatewayConfigOpts = append([]gateway.ConfigOpt{
 			gateway.WithURL(gatewayRs.URL),
+			gateway.WithURLResolver(func(ctx context.Context) (string, error) {
+				rs, err := client.restServices.GetGateway(rest.WithCtx(ctx))
+				if err != nil {
+					return "", err
+				}
+				return rs.URL, nil
+			}),
 			gateway.WithLogger(client.logger),
 			gateway.WithOS(os),
 			gateway.WithBrowser(name),
 			gateway.WithDevice(name),
 			func(config *gateway.Config) {
-				config.RateRateLimiterConfigOpts = append([]gateway.RateLimiterConfigOpt{gateway.WithRateLimiterLogger(client.logger)}, config.RateRateLimiterConfigOpts...)
+				config.RateRateLimiterConfigOpts = append([]gateway.RateLimiterConfigOpt{gateway.WithRateLimiterLogger(client.logger), gateway.WithRateLimiterClock(client.clock)}, config.RateRateLimiterConfigOpts...)
 			},
 		}, config.GatewayConfigOpts...)
+		if state != nil {
+			config.GatewayConfigOpts = append(config.GatewayConfigOpts, restoreSessions(state.Sessions))
+		}
 
 		config.Gateway = gateway.New(token, gatewayEventHandlerFunc(client), nil, config.GatewayConfigOpts...)
 	}
@@ -263,19 +392,29 @@ func BuildClient(token string, config Config, gatewayEventHandlerFunc func(clien
 			sharding.WithShardIDs(shardIDs...),
 			sharding.WithGatewayConfigOpts(
 				gateway.WithURL(gatewayBotRs.URL),
+				gateway.WithURLResolver(func(ctx context.Context) (string, error) {
+					rs, err := client.restServices.GetGatewayBot(rest.WithCtx(ctx))
+					if err != nil {
+						return "", err
+					}
+					return rs.URL, nil
+				}),
 				gateway.WithLogger(client.logger),
 				gateway.WithOS(os),
 				gateway.WithBrowser(name),
 				gateway.WithDevice(name),
 				func(config *gateway.Config) {
-					config.RateRateLimiterConfigOpts = append([]gateway.RateLimiterConfigOpt{gateway.WithRateLimiterLogger(client.logger)}, config.RateRateLimiterConfigOpts...)
+					config.RateRateLimiterConfigOpts = append([]gateway.RateLimiterConfigOpt{gateway.WithRateLimiterLogger(client.logger), gateway.WithRateLimiterClock(client.clock)}, config.RateRateLimiterConfigOpts...)
 				},
 			),
 			sharding.WithLogger(client.logger),
 			func(config *sharding.Config) {
-				config.RateRateLimiterConfigOpts = append([]sharding.RateLimiterConfigOpt{sharding.WithRateLimiterLogger(client.logger), sharding.WithMaxConcurrency(gatewayBotRs.SessionStartLimit.MaxConcurrency)}, config.RateRateLimiterConfigOpts...)
+				config.RateRateLimiterConfigOpts = append([]sharding.RateLimiterConfigOpt{sharding.WithRateLimiterLogger(client.logger), sharding.WithRateLimiterClock(client.clock), sharding.WithMaxConcurrency(gatewayBotRs.SessionStartLimit.MaxConcurrency), sharding.WithSessionStartLimit(gatewayBotRs.SessionStartLimit)}, config.RateRateLimiterConfigOpts...)
 			},
 		}, config.ShardManagerConfigOpts...)
+		if state != nil {
+			config.ShardManagerConfigOpts = append(config.ShardManagerConfigOpts, restoreShardSessions(state.Sessions))
+		}
 
 		config.ShardManager = sharding.New(token, gatewayEventHandlerFunc(client), config.ShardManagerConfigOpts...)
 	}
@@ -291,14 +430,54 @@ func BuildClient(token string, config Config, gatewayEventHandlerFunc func(clien
 	client.httpServer = config.HTTPServer
 
 	if config.MemberChunkingManager == nil {
-		config.MemberChunkingManager = NewMemberChunkingManager(client, config.MemberChunkingFilter)
+		memberChunkingFilter := config.MemberChunkingFilter
+		if config.AutoMemberChunking {
+			membersCached := func(_ snowflake.ID) bool {
+				return client.caches.CacheFlags().Has(cache.FlagMembers)
+			}
+			if memberChunkingFilter == nil {
+				memberChunkingFilter = MemberChunkingFilterNone
+			}
+			memberChunkingFilter = memberChunkingFilter.Or(membersCached)
+		}
+		config.MemberChunkingManager = newMemberChunkingManager(client, memberChunkingFilter, config.MemberChunkingDoneEventFunc)
 	}
 	client.memberChunkingManager = config.MemberChunkingManager
 
+	if config.VoiceManager == nil {
+		config.VoiceManager = NewVoiceManager(client)
+	}
+	client.voiceManager = config.VoiceManager
+
 	if config.Caches == nil {
+		config.CacheConfigOpts = append([]cache.ConfigOpt{cache.WithClock(client.clock)}, config.CacheConfigOpts...)
 		config.Caches = cache.New(config.CacheConfigOpts...)
 	}
 	client.caches = config.Caches
+	if state != nil && len(state.Cache) > 0 {
+		if err = client.caches.Import(bytes.NewReader(state.Cache)); err != nil {
+			return nil, fmt.Errorf("failed to import cache snapshot: %w", err)
+		}
+	}
+
+	if config.MessageScheduler == nil {
+		config.MessageScheduler = NewMessageScheduler(client, config.MessageSchedulerStore)
+	}
+	client.messageScheduler = config.MessageScheduler
+	if err = client.messageScheduler.Restore(context.TODO()); err != nil {
+		return nil, fmt.Errorf("failed to restore scheduled messages: %w", err)
+	}
+
+	if config.CacheWarming && config.CacheWarmer == nil {
+		config.CacheWarmer = NewCacheWarmer(client)
+	}
+	client.cacheWarmer = config.CacheWarmer
+
+	if config.GuildMemberCountReconcileInterval > 0 {
+		var ctx context.Context
+		ctx, client.stopReconcile = context.WithCancel(context.Background())
+		go client.reconcileGuildMemberCounts(ctx, config.GuildMemberCountReconcileInterval)
+	}
 
 	return client, nil
 }
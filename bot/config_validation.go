@@ -0,0 +1,102 @@
+package bot
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/sharding"
+)
+
+// ConfigErrors is returned by Config.Validate and contains all problems found in the Config.
+type ConfigErrors []error
+
+func (e ConfigErrors) Error() string {
+	errs := make([]string, len(e))
+	for i, err := range e {
+		errs[i] = err.Error()
+	}
+	return "invalid config: " + strings.Join(errs, "; ")
+}
+
+var cacheFlagIntents = []struct {
+	flag    cache.Flags
+	name    string
+	intents gateway.Intents
+}{
+	{cache.FlagGuilds, "FlagGuilds", gateway.IntentGuilds},
+	{cache.FlagChannels, "FlagChannels", gateway.IntentGuilds},
+	{cache.FlagRoles, "FlagRoles", gateway.IntentGuilds},
+	{cache.FlagStageInstances, "FlagStageInstances", gateway.IntentGuilds},
+	{cache.FlagThreadMembers, "FlagThreadMembers", gateway.IntentGuilds},
+	{cache.FlagMembers, "FlagMembers", gateway.IntentGuildMembers},
+	{cache.FlagPresences, "FlagPresences", gateway.IntentGuildPresences},
+	{cache.FlagVoiceStates, "FlagVoiceStates", gateway.IntentGuildVoiceStates},
+	{cache.FlagEmojis, "FlagEmojis", gateway.IntentGuildEmojisAndStickers},
+	{cache.FlagStickers, "FlagStickers", gateway.IntentGuildEmojisAndStickers},
+	{cache.FlagGuildScheduledEvents, "FlagGuildScheduledEvents", gateway.IntentGuildScheduledEvents},
+}
+
+// Validate checks the Config for conflicting or incomplete settings, like cache flags which can't be filled without the matching gateway.Intents.
+// All problems are returned together as ConfigErrors. Only settings configured via ConfigOpt(s) can be validated, injected instances are trusted.
+func (c *Config) Validate() error {
+	var errs ConfigErrors
+
+	hasGateway := c.Gateway != nil || c.GatewayConfigOpts != nil
+	hasShardManager := c.ShardManager != nil || c.ShardManagerConfigOpts != nil
+	if hasGateway && hasShardManager {
+		errs = append(errs, fmt.Errorf("both a gateway and a shard manager are configured, only use one of them"))
+	}
+
+	if c.HTTPServer == nil && c.HTTPServerConfigOpts != nil && c.PublicKey == "" {
+		errs = append(errs, fmt.Errorf("http server is configured without a public key"))
+	}
+
+	intents, knownIntents := c.intents()
+
+	if c.AutoMemberChunking || c.MemberChunkingFilter != nil && reflect.ValueOf(c.MemberChunkingFilter).Pointer() != reflect.ValueOf(MemberChunkingFilterNone).Pointer() {
+		if !hasGateway && !hasShardManager {
+			errs = append(errs, fmt.Errorf("member chunking requires a gateway or shard manager"))
+		} else if knownIntents && intents.Missing(gateway.IntentGuildMembers) {
+			errs = append(errs, fmt.Errorf("member chunking requires the IntentGuildMembers intent"))
+		}
+	}
+
+	if c.Caches == nil && knownIntents {
+		cacheConfig := cache.DefaultConfig()
+		cacheConfig.Apply(c.CacheConfigOpts)
+
+		for _, flagIntents := range cacheFlagIntents {
+			if cacheConfig.CacheFlags.Has(flagIntents.flag) && intents.Missing(flagIntents.intents) {
+				errs = append(errs, fmt.Errorf("cache flag %s requires the intents %d", flagIntents.name, flagIntents.intents))
+			}
+		}
+		if cacheConfig.CacheFlags.Has(cache.FlagMessages) && intents.Missing(gateway.IntentGuildMessages) && intents.Missing(gateway.IntentDirectMessages) {
+			errs = append(errs, fmt.Errorf("cache flag FlagMessages requires the IntentGuildMessages or IntentDirectMessages intent"))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// intents returns the gateway.Intents configured via GatewayConfigOpts or ShardManagerConfigOpts and whether they could be determined.
+func (c *Config) intents() (gateway.Intents, bool) {
+	if c.Gateway == nil && c.GatewayConfigOpts != nil {
+		gatewayConfig := gateway.DefaultConfig()
+		gatewayConfig.Apply(c.GatewayConfigOpts)
+		return gatewayConfig.Intents, true
+	}
+	if c.ShardManager == nil && c.ShardManagerConfigOpts != nil {
+		shardManagerConfig := sharding.DefaultConfig()
+		shardManagerConfig.Apply(c.ShardManagerConfigOpts)
+		gatewayConfig := gateway.DefaultConfig()
+		gatewayConfig.Apply(shardManagerConfig.GatewayConfigOpts)
+		return gatewayConfig.Intents, true
+	}
+	return gateway.IntentsNone, false
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/disgoorg/disgo/gateway"
 	"github.com/disgoorg/disgo/httpserver"
+	"github.com/disgoorg/snowflake/v2"
 )
 
 var _ EventManager = (*eventManagerImpl)(nil)
@@ -16,8 +17,9 @@ func NewEventManager(client Client, opts ...EventManagerConfigOpt) EventManager
 	config.Apply(opts)
 
 	return &eventManagerImpl{
-		client: client,
-		config: *config,
+		client:          client,
+		config:          *config,
+		gatewayHandlers: NewGatewayHandlerRegistry(config.GatewayHandlers),
 	}
 }
 
@@ -29,6 +31,16 @@ type EventManager interface {
 	// RemoveEventListeners removes one or more EventListener(s) from the EventManager
 	RemoveEventListeners(eventListeners ...EventListener)
 
+	// AddGuildEventListeners adds one or more EventListener(s) which only receive events of the given guild.
+	// See EventGuildID for how the guild of an event is resolved.
+	AddGuildEventListeners(guildID snowflake.ID, eventListeners ...EventListener)
+
+	// RemoveGuildEventListeners removes one or more guild scoped EventListener(s) from the EventManager
+	RemoveGuildEventListeners(guildID snowflake.ID, eventListeners ...EventListener)
+
+	// GatewayHandlers returns the GatewayHandlerRegistry which holds the GatewayEventHandler(s) used by HandleGatewayEvent.
+	GatewayHandlers() GatewayHandlerRegistry
+
 	// HandleGatewayEvent calls the correct GatewayEventHandler for the payload
 	HandleGatewayEvent(gatewayEventType gateway.EventType, sequenceNumber int, shardID int, event gateway.EventData)
 
@@ -104,14 +116,27 @@ type eventManagerImpl struct {
 	client          Client
 	eventListenerMu sync.Mutex
 	config          EventManagerConfig
+	guildListeners  map[snowflake.ID][]EventListener
+	gatewayHandlers GatewayHandlerRegistry
 
 	mu sync.Mutex
 }
 
+func (e *eventManagerImpl) GatewayHandlers() GatewayHandlerRegistry {
+	return e.gatewayHandlers
+}
+
 func (e *eventManagerImpl) HandleGatewayEvent(gatewayEventType gateway.EventType, sequenceNumber int, shardID int, event gateway.EventData) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
-	if handler, ok := e.config.GatewayHandlers[gatewayEventType]; ok {
+	// dispatch events disgo does not support yet are handled by the handler registered for their original type if there is one
+	if unknown, ok := event.(gateway.EventUnknown); ok {
+		if handler, ok := e.gatewayHandlers.Get(unknown.EventType); ok {
+			handler.HandleGatewayEvent(e.client, sequenceNumber, shardID, event)
+			return
+		}
+	}
+	if handler, ok := e.gatewayHandlers.Get(gatewayEventType); ok {
 		handler.HandleGatewayEvent(e.client, sequenceNumber, shardID, event)
 	} else {
 		e.client.Logger().Warnf("no handler for gateway event '%s' found", gatewayEventType)
@@ -134,22 +159,35 @@ func (e *eventManagerImpl) DispatchEvent(event Event) {
 	e.eventListenerMu.Lock()
 	defer e.eventListenerMu.Unlock()
 	for i := range e.config.EventListeners {
-		if e.config.AsyncEventsEnabled {
-			go func() {
-				defer func() {
-					if r := recover(); r != nil {
-						e.client.Logger().Errorf("recovered from panic in event listener: %+v\nstack: %s", r, string(debug.Stack()))
-						return
-					}
-				}()
-				e.config.EventListeners[i].OnEvent(event)
-			}()
-			continue
+		e.dispatchTo(e.config.EventListeners[i], event)
+	}
+
+	if len(e.guildListeners) == 0 {
+		return
+	}
+	if guildID, ok := EventGuildID(event); ok {
+		for _, listener := range e.guildListeners[guildID] {
+			e.dispatchTo(listener, event)
 		}
-		e.config.EventListeners[i].OnEvent(event)
 	}
 }
 
+func (e *eventManagerImpl) dispatchTo(listener EventListener, event Event) {
+	if e.config.AsyncEventsEnabled {
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					e.client.Logger().Errorf("recovered from panic in event listener: %+v\nstack: %s", r, string(debug.Stack()))
+					return
+				}
+			}()
+			listener.OnEvent(event)
+		}()
+		return
+	}
+	listener.OnEvent(event)
+}
+
 func (e *eventManagerImpl) AddEventListeners(listeners ...EventListener) {
 	e.eventListenerMu.Lock()
 	defer e.eventListenerMu.Unlock()
@@ -168,3 +206,31 @@ func (e *eventManagerImpl) RemoveEventListeners(listeners ...EventListener) {
 		}
 	}
 }
+
+func (e *eventManagerImpl) AddGuildEventListeners(guildID snowflake.ID, listeners ...EventListener) {
+	e.eventListenerMu.Lock()
+	defer e.eventListenerMu.Unlock()
+	if e.guildListeners == nil {
+		e.guildListeners = map[snowflake.ID][]EventListener{}
+	}
+	e.guildListeners[guildID] = append(e.guildListeners[guildID], listeners...)
+}
+
+func (e *eventManagerImpl) RemoveGuildEventListeners(guildID snowflake.ID, listeners ...EventListener) {
+	e.eventListenerMu.Lock()
+	defer e.eventListenerMu.Unlock()
+	guildListeners := e.guildListeners[guildID]
+	for _, listener := range listeners {
+		for i, l := range guildListeners {
+			if l == listener {
+				guildListeners = append(guildListeners[:i], guildListeners[i+1:]...)
+				break
+			}
+		}
+	}
+	if len(guildListeners) == 0 {
+		delete(e.guildListeners, guildID)
+		return
+	}
+	e.guildListeners[guildID] = guildListeners
+}
@@ -54,6 +54,21 @@ func WithGatewayHandlers(handlers map[gateway.EventType]GatewayEventHandler) Eve
 	}
 }
 
+// WithGatewayHandler registers the given GatewayEventHandler(s) in addition to the default ones. Default handlers of the same gateway.EventType are replaced.
+// Handlers can also be registered after the Client was built via EventManager.GatewayHandlers.
+func WithGatewayHandler(handlers ...GatewayEventHandler) EventManagerConfigOpt {
+	return func(config *EventManagerConfig) {
+		gatewayHandlers := make(map[gateway.EventType]GatewayEventHandler, len(config.GatewayHandlers)+len(handlers))
+		for eventType, handler := range config.GatewayHandlers {
+			gatewayHandlers[eventType] = handler
+		}
+		for _, handler := range handlers {
+			gatewayHandlers[handler.EventType()] = handler
+		}
+		config.GatewayHandlers = gatewayHandlers
+	}
+}
+
 // WithHTTPServerHandler overrides the given HTTPServerEventHandler in the EventManagerConfig.
 func WithHTTPServerHandler(handler HTTPServerEventHandler) EventManagerConfigOpt {
 	return func(config *EventManagerConfig) {
@@ -0,0 +1,87 @@
+package bot
+
+import (
+	"sync"
+
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/json"
+)
+
+var _ GatewayHandlerRegistry = (*gatewayHandlerRegistryImpl)(nil)
+
+// NewGatewayHandlerRegistry returns a new GatewayHandlerRegistry containing the given GatewayEventHandler(s).
+func NewGatewayHandlerRegistry(handlers map[gateway.EventType]GatewayEventHandler) GatewayHandlerRegistry {
+	r := &gatewayHandlerRegistryImpl{
+		handlers: make(map[gateway.EventType]GatewayEventHandler, len(handlers)),
+	}
+	for eventType, handler := range handlers {
+		r.handlers[eventType] = handler
+	}
+	return r
+}
+
+// GatewayHandlerRegistry holds the GatewayEventHandler(s) the EventManager uses to process gateway events.
+// Handlers can be registered or overridden at any time, for example to replace the gateway.EventTypeGuildCreate handler
+// or to handle a dispatch event disgo does not support yet, see NewUnknownGatewayEventHandler.
+type GatewayHandlerRegistry interface {
+	// Register registers the GatewayEventHandler(s) for their gateway.EventType. Existing handlers of the same gateway.EventType are replaced.
+	Register(handlers ...GatewayEventHandler)
+
+	// Unregister removes the GatewayEventHandler of the gateway.EventType. Events of this type are ignored afterwards.
+	Unregister(eventType gateway.EventType)
+
+	// Get returns the GatewayEventHandler of the gateway.EventType.
+	Get(eventType gateway.EventType) (GatewayEventHandler, bool)
+
+	// All returns a copy of all registered GatewayEventHandler(s).
+	All() map[gateway.EventType]GatewayEventHandler
+}
+
+type gatewayHandlerRegistryImpl struct {
+	mu       sync.RWMutex
+	handlers map[gateway.EventType]GatewayEventHandler
+}
+
+func (r *gatewayHandlerRegistryImpl) Register(handlers ...GatewayEventHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, handler := range handlers {
+		r.handlers[handler.EventType()] = handler
+	}
+}
+
+func (r *gatewayHandlerRegistryImpl) Unregister(eventType gateway.EventType) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.handlers, eventType)
+}
+
+func (r *gatewayHandlerRegistryImpl) Get(eventType gateway.EventType) (GatewayEventHandler, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	handler, ok := r.handlers[eventType]
+	return handler, ok
+}
+
+func (r *gatewayHandlerRegistryImpl) All() map[gateway.EventType]GatewayEventHandler {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	handlers := make(map[gateway.EventType]GatewayEventHandler, len(r.handlers))
+	for eventType, handler := range r.handlers {
+		handlers[eventType] = handler
+	}
+	return handlers
+}
+
+// NewUnknownGatewayEventHandler returns a new GatewayEventHandler for a dispatch event disgo does not support yet.
+// The payload of the gateway.EventUnknown is decoded into T before the handler func is called. Decoding errors are logged.
+func NewUnknownGatewayEventHandler[T any](eventType gateway.EventType, handleFunc func(client Client, sequenceNumber int, shardID int, event T)) GatewayEventHandler {
+	return NewGatewayEventHandler(eventType, func(client Client, sequenceNumber int, shardID int, event gateway.EventUnknown) {
+		var v T
+		if err := json.Unmarshal(event.Payload, &v); err != nil {
+			client.Logger().Errorf("failed to decode gateway event '%s': %s", eventType, err)
+			return
+		}
+		handleFunc(client, sequenceNumber, shardID, v)
+	})
+}
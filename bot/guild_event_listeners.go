@@ -0,0 +1,63 @@
+package bot
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var (
+	snowflakeType        = reflect.TypeOf(snowflake.ID(0))
+	snowflakePointerType = reflect.TypeOf((*snowflake.ID)(nil))
+
+	// guildIDFields caches the index of the GuildID field per event type. nil means the event type has no GuildID field.
+	guildIDFields sync.Map
+)
+
+// EventGuildID returns the guild ID of the given Event and whether it happened in a guild.
+// Events are resolved by their GuildID field or GuildID() method. This is used to route events to guild scoped EventListener(s).
+func EventGuildID(event Event) (snowflake.ID, bool) {
+	if e, ok := event.(interface{ GuildID() *snowflake.ID }); ok {
+		if guildID := e.GuildID(); guildID != nil {
+			return *guildID, true
+		}
+		return 0, false
+	}
+
+	v := reflect.ValueOf(event)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return 0, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return 0, false
+	}
+
+	index, ok := guildIDFields.Load(v.Type())
+	if !ok {
+		var fieldIndex []int
+		if field, found := v.Type().FieldByName("GuildID"); found && (field.Type == snowflakeType || field.Type == snowflakePointerType) {
+			fieldIndex = field.Index
+		}
+		index, _ = guildIDFields.LoadOrStore(v.Type(), fieldIndex)
+	}
+	if index.([]int) == nil {
+		return 0, false
+	}
+
+	field, err := v.FieldByIndexErr(index.([]int))
+	if err != nil {
+		return 0, false
+	}
+	if field.Type() == snowflakePointerType {
+		if field.IsNil() {
+			return 0, false
+		}
+		field = field.Elem()
+	}
+	guildID := field.Interface().(snowflake.ID)
+	return guildID, guildID != 0
+}
@@ -0,0 +1,49 @@
+package bot
+
+import (
+	"context"
+	"time"
+
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// reconcileGuildMemberCounts fetches the approximate member & presence counts of all cached guilds every interval
+// to correct the drift of the counts tracked via gateway events until the given context is canceled.
+func (c *clientImpl) reconcileGuildMemberCounts(ctx context.Context, interval time.Duration) {
+	ticker := c.clock.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+		}
+
+		var guildIDs []snowflake.ID
+		c.Caches().Guilds().ForEach(func(guild discord.Guild) {
+			guildIDs = append(guildIDs, guild.ID)
+		})
+		for _, guildID := range guildIDs {
+			if ctx.Err() != nil {
+				return
+			}
+			guild, err := c.Rest().GetGuild(guildID, true, rest.WithCtx(ctx), rest.WithPriority(rest.PriorityBackground))
+			if err != nil {
+				c.logger.Debugf("failed to reconcile member count of guild %s: %s", guildID, err)
+				continue
+			}
+			// the guild might have been removed by a gateway.EventTypeGuildDelete in the meantime
+			if _, ok := c.Caches().Guilds().Get(guildID); !ok {
+				continue
+			}
+			c.Caches().PutGuildMemberCount(guildID, cache.GuildMemberCount{
+				MemberCount:   guild.ApproximateMemberCount,
+				PresenceCount: guild.ApproximatePresenceCount,
+				ReconciledAt:  c.clock.Now(),
+			})
+		}
+	}
+}
@@ -12,15 +12,24 @@ import (
 
 var _ MemberChunkingManager = (*memberChunkingManagerImpl)(nil)
 
+// MemberChunkingDoneEventFunc creates the Event the MemberChunkingManager dispatches once all members of a guild requested via MemberChunkingManager.ChunkGuild were received.
+type MemberChunkingDoneEventFunc func(client Client, sequenceNumber int, shardID int, guildID snowflake.ID, memberCount int) Event
+
 // NewMemberChunkingManager returns a new MemberChunkingManager with the given MemberChunkingFilter.
 func NewMemberChunkingManager(client Client, memberChunkingFilter MemberChunkingFilter) MemberChunkingManager {
+	return newMemberChunkingManager(client, memberChunkingFilter, nil)
+}
+
+func newMemberChunkingManager(client Client, memberChunkingFilter MemberChunkingFilter, chunkingDoneEventFunc MemberChunkingDoneEventFunc) *memberChunkingManagerImpl {
 	if memberChunkingFilter == nil {
 		memberChunkingFilter = MemberChunkingFilterNone
 	}
 	return &memberChunkingManagerImpl{
-		client:               client,
-		memberChunkingFilter: memberChunkingFilter,
-		chunkingRequests:     map[string]*chunkingRequest{},
+		client:                client,
+		memberChunkingFilter:  memberChunkingFilter,
+		chunkingDoneEventFunc: chunkingDoneEventFunc,
+		chunkingRequests:      map[string]*chunkingRequest{},
+		pendingGuilds:         map[snowflake.ID]struct{}{},
 	}
 }
 
@@ -32,6 +41,12 @@ type MemberChunkingManager interface {
 	// HandleChunk handles the discord.EventGuildMembersChunk event payloads from the discord gateway.
 	HandleChunk(payload gateway.EventGuildMembersChunk)
 
+	// ChunkGuild requests all members of the given guildID in the background and puts them into the member cache. The guild is returned by PendingGuilds until all chunks were received or the request failed.
+	// It is called on gateway.EventTypeGuildCreate for guilds passing the MemberChunkingFilter. Once all chunks were received, the Event created by the MemberChunkingDoneEventFunc is dispatched, which is an events.GuildMembersChunkingDone for clients created via disgo.New.
+	ChunkGuild(guildID snowflake.ID)
+	// PendingGuilds returns the guilds ChunkGuild is still requesting the members of.
+	PendingGuilds() []snowflake.ID
+
 	// RequestMembers requests members from the given guildID and userIDs.
 	// Notice: This action requires the gateway.IntentGuildMembers.
 	RequestMembers(guildID snowflake.ID, userIDs ...snowflake.ID) ([]discord.Member, error)
@@ -76,16 +91,22 @@ type chunkingRequest struct {
 
 	memberChan       chan<- discord.Member
 	memberFilterFunc func(member discord.Member) bool
+	guildChunk       bool
+	shard            gateway.Gateway
 
 	chunks int
 }
 
 type memberChunkingManagerImpl struct {
-	client               Client
-	memberChunkingFilter MemberChunkingFilter
+	client                Client
+	memberChunkingFilter  MemberChunkingFilter
+	chunkingDoneEventFunc MemberChunkingDoneEventFunc
 
 	chunkingRequestsMu sync.RWMutex
 	chunkingRequests   map[string]*chunkingRequest
+
+	pendingGuildsMu sync.Mutex
+	pendingGuilds   map[snowflake.ID]struct{}
 }
 
 func (m *memberChunkingManagerImpl) MemberChunkingFilter() MemberChunkingFilter {
@@ -101,6 +122,19 @@ func (m *memberChunkingManagerImpl) HandleChunk(payload gateway.EventGuildMember
 		return
 	}
 
+	if !m.handleChunk(request, payload) || !request.guildChunk || m.chunkingDoneEventFunc == nil {
+		return
+	}
+	// the last chunk is the last event the shard received
+	var sequenceNumber int
+	if lastSequenceReceived := request.shard.LastSequenceReceived(); lastSequenceReceived != nil {
+		sequenceNumber = *lastSequenceReceived
+	}
+	m.client.EventManager().DispatchEvent(m.chunkingDoneEventFunc(m.client, sequenceNumber, request.shard.ShardID(), payload.GuildID, m.client.Caches().Members().GroupLen(payload.GuildID)))
+}
+
+// handleChunk sends the members of the chunk to the request and returns true once the last chunk was handled.
+func (m *memberChunkingManagerImpl) handleChunk(request *chunkingRequest, payload gateway.EventGuildMembersChunk) bool {
 	request.Lock()
 	defer request.Unlock()
 
@@ -116,9 +150,44 @@ func (m *memberChunkingManagerImpl) HandleChunk(payload gateway.EventGuildMember
 	// all chunks sent cleanup
 	if request.chunks == payload.ChunkCount-1 {
 		cleanupRequest(m, request)
-		return
+		return true
 	}
 	request.chunks++
+	return false
+}
+
+func (m *memberChunkingManagerImpl) ChunkGuild(guildID snowflake.ID) {
+	m.pendingGuildsMu.Lock()
+	m.pendingGuilds[guildID] = struct{}{}
+	m.pendingGuildsMu.Unlock()
+
+	go func() {
+		defer func() {
+			m.pendingGuildsMu.Lock()
+			delete(m.pendingGuilds, guildID)
+			m.pendingGuildsMu.Unlock()
+		}()
+		query := ""
+		limit := 0
+		memberChan, _, err := m.requestGuildMembersChan(context.Background(), guildID, &query, &limit, nil, nil, true)
+		if err != nil {
+			m.client.Logger().Errorf("failed to chunk guild %s: %s", guildID, err)
+			return
+		}
+		// the members are cached by HandleChunk, so they only need to be drained here
+		for range memberChan {
+		}
+	}()
+}
+
+func (m *memberChunkingManagerImpl) PendingGuilds() []snowflake.ID {
+	m.pendingGuildsMu.Lock()
+	defer m.pendingGuildsMu.Unlock()
+	guildIDs := make([]snowflake.ID, 0, len(m.pendingGuilds))
+	for guildID := range m.pendingGuilds {
+		guildIDs = append(guildIDs, guildID)
+	}
+	return guildIDs
 }
 
 func cleanupRequest(m *memberChunkingManagerImpl, request *chunkingRequest) {
@@ -128,7 +197,7 @@ func cleanupRequest(m *memberChunkingManagerImpl, request *chunkingRequest) {
 	m.chunkingRequestsMu.Unlock()
 }
 
-func (m *memberChunkingManagerImpl) requestGuildMembersChan(ctx context.Context, guildID snowflake.ID, query *string, limit *int, userIDs []snowflake.ID, memberFilterFunc func(member discord.Member) bool) (<-chan discord.Member, func(), error) {
+func (m *memberChunkingManagerImpl) requestGuildMembersChan(ctx context.Context, guildID snowflake.ID, query *string, limit *int, userIDs []snowflake.ID, memberFilterFunc func(member discord.Member) bool, guildChunk bool) (<-chan discord.Member, func(), error) {
 	shard, err := m.client.Shard(guildID)
 	if err != nil {
 		return nil, nil, err
@@ -153,6 +222,8 @@ func (m *memberChunkingManagerImpl) requestGuildMembersChan(ctx context.Context,
 		nonce:            nonce,
 		memberChan:       memberChan,
 		memberFilterFunc: memberFilterFunc,
+		guildChunk:       guildChunk,
+		shard:            shard,
 	}
 
 	m.chunkingRequestsMu.Lock()
@@ -170,12 +241,12 @@ func (m *memberChunkingManagerImpl) requestGuildMembersChan(ctx context.Context,
 
 	return memberChan, func() {
 		cleanupRequest(m, request)
-	}, shard.Send(ctx, gateway.OpcodeRequestGuildMembers, command)
+	}, shard.RequestGuildMembers(ctx, command)
 }
 
 func (m *memberChunkingManagerImpl) requestGuildMembers(ctx context.Context, guildID snowflake.ID, query *string, limit *int, userIDs []snowflake.ID, memberFilterFunc func(member discord.Member) bool) ([]discord.Member, error) {
 	var members []discord.Member
-	memberChan, cls, err := m.requestGuildMembersChan(ctx, guildID, query, limit, userIDs, memberFilterFunc)
+	memberChan, cls, err := m.requestGuildMembersChan(ctx, guildID, query, limit, userIDs, memberFilterFunc, false)
 	if err != nil {
 		return nil, err
 	}
@@ -227,21 +298,21 @@ func (m *memberChunkingManagerImpl) RequestMembersWithFilterCtx(ctx context.Cont
 }
 
 func (m *memberChunkingManagerImpl) RequestMembersChan(guildID snowflake.ID, userIDs ...snowflake.ID) (<-chan discord.Member, func(), error) {
-	return m.requestGuildMembersChan(context.Background(), guildID, nil, nil, userIDs, nil)
+	return m.requestGuildMembersChan(context.Background(), guildID, nil, nil, userIDs, nil, false)
 }
 
 func (m *memberChunkingManagerImpl) RequestMembersWithQueryChan(guildID snowflake.ID, query string, limit int) (<-chan discord.Member, func(), error) {
-	return m.requestGuildMembersChan(context.Background(), guildID, &query, &limit, nil, nil)
+	return m.requestGuildMembersChan(context.Background(), guildID, &query, &limit, nil, nil, false)
 }
 
 func (m *memberChunkingManagerImpl) RequestAllMembersChan(guildID snowflake.ID) (<-chan discord.Member, func(), error) {
 	query := ""
 	limit := 0
-	return m.requestGuildMembersChan(context.Background(), guildID, &query, &limit, nil, nil)
+	return m.requestGuildMembersChan(context.Background(), guildID, &query, &limit, nil, nil, false)
 }
 
 func (m *memberChunkingManagerImpl) RequestMembersWithFilterChan(guildID snowflake.ID, memberFilterFunc func(member discord.Member) bool) (<-chan discord.Member, func(), error) {
 	query := ""
 	limit := 0
-	return m.requestGuildMembersChan(context.Background(), guildID, &query, &limit, nil, memberFilterFunc)
+	return m.requestGuildMembersChan(context.Background(), guildID, &query, &limit, nil, memberFilterFunc, false)
 }
@@ -0,0 +1,187 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/clock"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/internal/insecurerandstr"
+	"github.com/disgoorg/disgo/json"
+	"github.com/disgoorg/disgo/store"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// scheduledMessagesKey is the store.KV key all ScheduledMessage(s) are persisted under.
+const scheduledMessagesKey = "scheduled_messages"
+
+var _ MessageScheduler = (*messageSchedulerImpl)(nil)
+
+// NewMessageScheduler returns a new MessageScheduler which persists its ScheduledMessage(s) in the given store.KV.
+// If kv is nil, the ScheduledMessage(s) are only kept in memory and lost on restart.
+func NewMessageScheduler(client Client, kv store.KV) MessageScheduler {
+	return &messageSchedulerImpl{
+		client:   client,
+		kv:       kv,
+		messages: map[string]ScheduledMessage{},
+		timers:   map[string]clock.Timer{},
+	}
+}
+
+// ScheduledMessage is a discord.MessageCreate which is sent to a channel at a specific time.
+type ScheduledMessage struct {
+	ID        string                `json:"id"`
+	ChannelID snowflake.ID          `json:"channel_id"`
+	Message   discord.MessageCreate `json:"message"`
+	At        time.Time             `json:"at"`
+}
+
+// MessageScheduler sends messages at a later time. The ScheduledMessage(s) are persisted, so they survive restarts.
+// Only one process should use the same store.KV, as every process sends the restored messages.
+type MessageScheduler interface {
+	// ScheduleMessage schedules the discord.MessageCreate to be sent to the channel at the given time.
+	// Messages with files can't be persisted and return discord.ErrScheduledMessageFiles.
+	ScheduleMessage(ctx context.Context, channelID snowflake.ID, messageCreate discord.MessageCreate, at time.Time) (*ScheduledMessage, error)
+
+	// CancelMessage cancels the ScheduledMessage with the given ID or returns discord.ErrScheduledMessageNotFound.
+	CancelMessage(ctx context.Context, id string) error
+
+	// ScheduledMessages returns all ScheduledMessage(s) which were not sent yet.
+	ScheduledMessages() []ScheduledMessage
+
+	// Restore loads the persisted ScheduledMessage(s) and schedules them again. Messages which are due already are sent immediately.
+	Restore(ctx context.Context) error
+
+	// Close stops all timers. The ScheduledMessage(s) stay persisted and are restored on the next start.
+	Close()
+}
+
+type messageSchedulerImpl struct {
+	client Client
+	kv     store.KV
+
+	mu       sync.Mutex
+	messages map[string]ScheduledMessage
+	timers   map[string]clock.Timer
+}
+
+func (s *messageSchedulerImpl) ScheduleMessage(ctx context.Context, channelID snowflake.ID, messageCreate discord.MessageCreate, at time.Time) (*ScheduledMessage, error) {
+	if len(messageCreate.Files) > 0 {
+		return nil, discord.ErrScheduledMessageFiles
+	}
+	message := ScheduledMessage{
+		ID:        insecurerandstr.RandStr(16),
+		ChannelID: channelID,
+		Message:   messageCreate,
+		At:        at,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages[message.ID] = message
+	if err := s.persist(ctx); err != nil {
+		delete(s.messages, message.ID)
+		return nil, err
+	}
+	s.schedule(message)
+	return &message, nil
+}
+
+func (s *messageSchedulerImpl) CancelMessage(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.messages[id]; !ok {
+		return discord.ErrScheduledMessageNotFound
+	}
+	if timer, ok := s.timers[id]; ok {
+		timer.Stop()
+		delete(s.timers, id)
+	}
+	delete(s.messages, id)
+	return s.persist(ctx)
+}
+
+func (s *messageSchedulerImpl) ScheduledMessages() []ScheduledMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	messages := make([]ScheduledMessage, 0, len(s.messages))
+	for _, message := range s.messages {
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+func (s *messageSchedulerImpl) Restore(ctx context.Context) error {
+	if s.kv == nil {
+		return nil
+	}
+	data, err := s.kv.Get(ctx, scheduledMessagesKey)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	var messages []ScheduledMessage
+	if err = json.Unmarshal(data, &messages); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, message := range messages {
+		if _, ok := s.messages[message.ID]; ok {
+			continue
+		}
+		s.messages[message.ID] = message
+		s.schedule(message)
+	}
+	return nil
+}
+
+func (s *messageSchedulerImpl) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, timer := range s.timers {
+		timer.Stop()
+		delete(s.timers, id)
+	}
+}
+
+// schedule starts the timer of the ScheduledMessage. It needs to be called with the lock held.
+func (s *messageSchedulerImpl) schedule(message ScheduledMessage) {
+	s.timers[message.ID] = s.client.Clock().AfterFunc(message.At.Sub(s.client.Clock().Now()), func() {
+		s.send(message)
+	})
+}
+
+func (s *messageSchedulerImpl) send(message ScheduledMessage) {
+	if _, err := s.client.Rest().CreateMessage(message.ChannelID, message.Message); err != nil {
+		s.client.Logger().Errorf("failed to send scheduled message %s to channel %s: %s", message.ID, message.ChannelID, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.timers, message.ID)
+	delete(s.messages, message.ID)
+	if err := s.persist(context.Background()); err != nil {
+		s.client.Logger().Errorf("failed to persist scheduled messages: %s", err)
+	}
+}
+
+// persist stores all ScheduledMessage(s) in the store.KV. It needs to be called with the lock held.
+func (s *messageSchedulerImpl) persist(ctx context.Context) error {
+	if s.kv == nil {
+		return nil
+	}
+	messages := make([]ScheduledMessage, 0, len(s.messages))
+	for _, message := range s.messages {
+		messages = append(messages, message)
+	}
+	data, err := json.Marshal(messages)
+	if err != nil {
+		return err
+	}
+	return s.kv.Set(ctx, scheduledMessagesKey, data, 0)
+}
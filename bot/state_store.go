@@ -0,0 +1,163 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/json"
+	"github.com/disgoorg/disgo/sharding"
+	"github.com/disgoorg/disgo/store"
+)
+
+// stateKey is the store.KV key the State is persisted under.
+const stateKey = "state"
+
+var _ StateStore = (*kvStateStore)(nil)
+
+// GatewaySession holds everything needed to resume the session of one gateway.Gateway.
+type GatewaySession struct {
+	ShardID    int    `json:"shard_id"`
+	ShardCount int    `json:"shard_count"`
+	SessionID  string `json:"session_id"`
+	Sequence   int    `json:"sequence"`
+	ResumeURL  string `json:"resume_url,omitempty"`
+}
+
+// State is the gateway sessions and cache snapshot of a Client which is persisted by a StateStore.
+type State struct {
+	Sessions []GatewaySession `json:"sessions"`
+	Cache    json.RawMessage  `json:"cache,omitempty"`
+	SavedAt  time.Time        `json:"saved_at"`
+}
+
+// StateStore persists the State of a Client, so it can RESUME its gateway sessions and reload its cache after a restart instead of identifying again.
+// If a session can't be resumed anymore, the gateway.Gateway identifies as usual and the reloaded cache is updated by the following gateway.EventTypeGuildCreate events.
+type StateStore interface {
+	// LoadState returns the persisted State or nil if there is none.
+	LoadState(ctx context.Context) (*State, error)
+
+	// SaveState persists the given State and replaces the previous one.
+	SaveState(ctx context.Context, state State) error
+}
+
+// NewStateStore returns a StateStore which persists the State in the given store.KV.
+// Discord only allows resuming a session for a few minutes, so a ttl of a few minutes avoids restoring sessions which are invalid anyway. A ttl of 0 keeps the State forever.
+func NewStateStore(kv store.KV, ttl time.Duration) StateStore {
+	return &kvStateStore{
+		kv:  kv,
+		ttl: ttl,
+	}
+}
+
+type kvStateStore struct {
+	kv  store.KV
+	ttl time.Duration
+}
+
+func (s *kvStateStore) LoadState(ctx context.Context) (*State, error) {
+	data, err := s.kv.Get(ctx, stateKey)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var state State
+	if err = json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+func (s *kvStateStore) SaveState(ctx context.Context, state State) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return s.kv.Set(ctx, stateKey, data, s.ttl)
+}
+
+// restoreSessions returns a gateway.ConfigOpt which sets the session of the matching GatewaySession.
+// It needs to be applied after the shard id & count are set. Every GatewaySession is only used once, so gateways created later on identify as usual.
+func restoreSessions(sessions []GatewaySession) gateway.ConfigOpt {
+	sessions = append([]GatewaySession(nil), sessions...)
+	var mu sync.Mutex
+	return func(config *gateway.Config) {
+		mu.Lock()
+		defer mu.Unlock()
+		for i, session := range sessions {
+			if session.ShardID != config.ShardID || session.ShardCount != config.ShardCount {
+				continue
+			}
+			config.SessionID = &session.SessionID
+			config.LastSequenceReceived = &session.Sequence
+			if session.ResumeURL != "" {
+				config.ResumeURL = &session.ResumeURL
+			}
+			sessions = append(sessions[:i], sessions[i+1:]...)
+			return
+		}
+	}
+}
+
+// restoreShardSessions returns a sharding.ConfigOpt which wraps the sharding.Config GatewayCreateFunc to restore the sessions of the created shards.
+// It needs to be applied last, so the shard id & count are set before restoreSessions runs.
+func restoreShardSessions(sessions []GatewaySession) sharding.ConfigOpt {
+	restore := restoreSessions(sessions)
+	return func(config *sharding.Config) {
+		createFunc := config.GatewayCreateFunc
+		config.GatewayCreateFunc = func(token string, eventHandlerFunc gateway.EventHandlerFunc, closeHandlerFunc gateway.CloseHandlerFunc, opts ...gateway.ConfigOpt) gateway.Gateway {
+			return createFunc(token, eventHandlerFunc, closeHandlerFunc, append(opts, restore)...)
+		}
+	}
+}
+
+// gatewaySession returns the GatewaySession of the gateway.Gateway and false if it has no session to resume.
+func gatewaySession(g gateway.Gateway) (GatewaySession, bool) {
+	sessionID := g.SessionID()
+	sequence := g.LastSequenceReceived()
+	if sessionID == nil || sequence == nil {
+		return GatewaySession{}, false
+	}
+	session := GatewaySession{
+		ShardID:    g.ShardID(),
+		ShardCount: g.ShardCount(),
+		SessionID:  *sessionID,
+		Sequence:   *sequence,
+	}
+	if resumeURL := g.ResumeURL(); resumeURL != nil {
+		session.ResumeURL = *resumeURL
+	}
+	return session, true
+}
+
+func (c *clientImpl) SaveState(ctx context.Context) error {
+	if c.stateStore == nil {
+		return nil
+	}
+	state := State{
+		SavedAt: c.clock.Now(),
+	}
+	if c.gateway != nil {
+		if session, ok := gatewaySession(c.gateway); ok {
+			state.Sessions = append(state.Sessions, session)
+		}
+	}
+	if c.shardManager != nil {
+		for _, shard := range c.shardManager.Shards() {
+			if session, ok := gatewaySession(shard); ok {
+				state.Sessions = append(state.Sessions, session)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := c.caches.Export(&buf); err != nil {
+		return err
+	}
+	state.Cache = buf.Bytes()
+	return c.stateStore.SaveState(ctx, state)
+}
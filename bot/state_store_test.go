@@ -0,0 +1,53 @@
+package bot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateStore(t *testing.T) {
+	stateStore := NewStateStore(store.NewMemory(), 0)
+
+	state, err := stateStore.LoadState(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, state)
+
+	sessions := []GatewaySession{
+		{ShardID: 0, ShardCount: 2, SessionID: "a", Sequence: 10, ResumeURL: "wss://resume"},
+		{ShardID: 1, ShardCount: 2, SessionID: "b", Sequence: 20},
+	}
+	err = stateStore.SaveState(context.Background(), State{Sessions: sessions, Cache: []byte(`{"version":1}`)})
+	assert.NoError(t, err)
+
+	state, err = stateStore.LoadState(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, sessions, state.Sessions)
+	assert.JSONEq(t, `{"version":1}`, string(state.Cache))
+}
+
+func TestRestoreSessions(t *testing.T) {
+	restore := restoreSessions([]GatewaySession{
+		{ShardID: 1, ShardCount: 2, SessionID: "b", Sequence: 20, ResumeURL: "wss://resume"},
+	})
+
+	config := gateway.DefaultConfig()
+	config.Apply([]gateway.ConfigOpt{gateway.WithShardID(0), gateway.WithShardCount(2), restore})
+	assert.Nil(t, config.SessionID)
+
+	config = gateway.DefaultConfig()
+	config.Apply([]gateway.ConfigOpt{gateway.WithShardID(1), gateway.WithShardCount(2), restore})
+	if assert.NotNil(t, config.SessionID) {
+		assert.Equal(t, "b", *config.SessionID)
+		assert.Equal(t, 20, *config.LastSequenceReceived)
+		assert.Equal(t, "wss://resume", *config.ResumeURL)
+	}
+
+	// a session is only restored once
+	config = gateway.DefaultConfig()
+	config.Apply([]gateway.ConfigOpt{gateway.WithShardID(1), gateway.WithShardCount(2), restore})
+	assert.Nil(t, config.SessionID)
+}
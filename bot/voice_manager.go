@@ -0,0 +1,125 @@
+package bot
+
+import (
+	"context"
+	"sync"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var _ VoiceManager = (*voiceManagerImpl)(nil)
+
+// NewVoiceManager returns a new VoiceManager.
+func NewVoiceManager(client Client) VoiceManager {
+	return &voiceManagerImpl{
+		client: client,
+		states: map[snowflake.ID]IntendedVoiceState{},
+	}
+}
+
+// IntendedVoiceState is the voice state the bot requested via VoiceManager.UpdateVoiceState.
+type IntendedVoiceState struct {
+	ChannelID snowflake.ID
+	SelfMute  bool
+	SelfDeaf  bool
+}
+
+// VoiceManager keeps track of the voice state the bot intends to have in each guild and corrects it when it drifts.
+type VoiceManager interface {
+	// UpdateVoiceState sends a gateway.MessageDataVoiceStateUpdate to the gateway.Gateway of the guild and remembers it as the IntendedVoiceState.
+	// Passing a nil channelID disconnects the bot and forgets the IntendedVoiceState.
+	UpdateVoiceState(ctx context.Context, guildID snowflake.ID, channelID *snowflake.ID, selfMute bool, selfDeaf bool) error
+
+	// IntendedVoiceState returns the IntendedVoiceState of the bot in the given guild.
+	IntendedVoiceState(guildID snowflake.ID) (IntendedVoiceState, bool)
+
+	// HandleVoiceStateUpdate compares the bots discord.VoiceState with its IntendedVoiceState and re-sends the IntendedVoiceState if the self mute or self deaf state drifted.
+	// If the bot was moved or disconnected by someone else, the IntendedVoiceState is updated accordingly instead.
+	// Returns whether a correction was sent.
+	HandleVoiceStateUpdate(voiceState discord.VoiceState) bool
+}
+
+type voiceManagerImpl struct {
+	client Client
+
+	statesMu sync.Mutex
+	states   map[snowflake.ID]IntendedVoiceState
+}
+
+func (m *voiceManagerImpl) UpdateVoiceState(ctx context.Context, guildID snowflake.ID, channelID *snowflake.ID, selfMute bool, selfDeaf bool) error {
+	shard, err := m.client.Shard(guildID)
+	if err != nil {
+		return err
+	}
+
+	m.statesMu.Lock()
+	if channelID == nil {
+		delete(m.states, guildID)
+	} else {
+		m.states[guildID] = IntendedVoiceState{
+			ChannelID: *channelID,
+			SelfMute:  selfMute,
+			SelfDeaf:  selfDeaf,
+		}
+	}
+	m.statesMu.Unlock()
+
+	return shard.UpdateVoiceState(ctx, gateway.MessageDataVoiceStateUpdate{
+		GuildID:   guildID,
+		ChannelID: channelID,
+		SelfMute:  selfMute,
+		SelfDeaf:  selfDeaf,
+	})
+}
+
+func (m *voiceManagerImpl) IntendedVoiceState(guildID snowflake.ID) (IntendedVoiceState, bool) {
+	m.statesMu.Lock()
+	defer m.statesMu.Unlock()
+	state, ok := m.states[guildID]
+	return state, ok
+}
+
+func (m *voiceManagerImpl) HandleVoiceStateUpdate(voiceState discord.VoiceState) bool {
+	m.statesMu.Lock()
+	state, ok := m.states[voiceState.GuildID]
+	if !ok {
+		m.statesMu.Unlock()
+		return false
+	}
+
+	// we got disconnected or moved by someone else, respect that
+	if voiceState.ChannelID == nil {
+		delete(m.states, voiceState.GuildID)
+		m.statesMu.Unlock()
+		return false
+	}
+	if *voiceState.ChannelID != state.ChannelID {
+		state.ChannelID = *voiceState.ChannelID
+		m.states[voiceState.GuildID] = state
+	}
+	m.statesMu.Unlock()
+
+	if voiceState.SelfMute == state.SelfMute && voiceState.SelfDeaf == state.SelfDeaf {
+		return false
+	}
+
+	m.client.Logger().Debugf("voice state in guild %s drifted, re-sending intended voice state", voiceState.GuildID)
+	go func() {
+		shard, err := m.client.Shard(voiceState.GuildID)
+		if err != nil {
+			m.client.Logger().Errorf("failed to correct voice state in guild %s: %s", voiceState.GuildID, err)
+			return
+		}
+		if err = shard.UpdateVoiceState(context.Background(), gateway.MessageDataVoiceStateUpdate{
+			GuildID:   voiceState.GuildID,
+			ChannelID: &state.ChannelID,
+			SelfMute:  state.SelfMute,
+			SelfDeaf:  state.SelfDeaf,
+		}); err != nil {
+			m.client.Logger().Errorf("failed to correct voice state in guild %s: %s", voiceState.GuildID, err)
+		}
+	}()
+	return true
+}
@@ -0,0 +1,389 @@
+// Package botconfig loads the options of a bot from a JSON or YAML file and environment variables, so deployments can tune the bot without recompiling.
+package botconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/json"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/disgo/sharding"
+	"github.com/disgoorg/snowflake/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// File is the declarative configuration of a bot.
+//
+//	{
+//		"token": "...",
+//		"intents": ["guilds", "guild_messages", "message_content"],
+//		"cache_flags": ["guilds", "channels", "roles"],
+//		"shards": {"ids": [0, 1], "count": 2},
+//		"presence": {"status": "idle", "activity": {"type": "watching", "name": "the logs"}},
+//		"dev_guild_ids": ["123456789012345678"],
+//		"development": true
+//	}
+//
+// Intents and cache flags are given by their snake case names as listed in IntentNames and CacheFlagNames.
+// Like in the discord api, snowflakes are given as strings.
+type File struct {
+	Token       string         `json:"token"`
+	Intents     []string       `json:"intents"`
+	CacheFlags  []string       `json:"cache_flags"`
+	Shards      *Shards        `json:"shards"`
+	Presence    *Presence      `json:"presence"`
+	DevGuildIDs []snowflake.ID `json:"dev_guild_ids"`
+	Development bool           `json:"development"`
+}
+
+// Shards configures the sharding.ShardManager. Without it the bot connects with a single gateway.Gateway.
+type Shards struct {
+	IDs         []int `json:"ids"`
+	Count       int   `json:"count"`
+	AutoScaling bool  `json:"auto_scaling"`
+}
+
+// Presence is the presence the bot identifies with.
+type Presence struct {
+	Status   discord.OnlineStatus `json:"status"`
+	Activity *Activity            `json:"activity"`
+}
+
+// Activity is the activity shown in the Presence. The Type is one of the names listed in ActivityTypeNames.
+type Activity struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// IntentNames maps the names usable in File.Intents to their gateway.Intents.
+var IntentNames = map[string]gateway.Intents{
+	"guilds":                        gateway.IntentGuilds,
+	"guild_members":                 gateway.IntentGuildMembers,
+	"guild_moderation":              gateway.IntentGuildBans,
+	"guild_bans":                    gateway.IntentGuildBans,
+	"guild_emojis_and_stickers":     gateway.IntentGuildEmojisAndStickers,
+	"guild_integrations":            gateway.IntentGuildIntegrations,
+	"guild_webhooks":                gateway.IntentGuildWebhooks,
+	"guild_invites":                 gateway.IntentGuildInvites,
+	"guild_voice_states":            gateway.IntentGuildVoiceStates,
+	"guild_presences":               gateway.IntentGuildPresences,
+	"guild_messages":                gateway.IntentGuildMessages,
+	"guild_message_reactions":       gateway.IntentGuildMessageReactions,
+	"guild_message_typing":          gateway.IntentGuildMessageTyping,
+	"direct_messages":               gateway.IntentDirectMessages,
+	"direct_message_reactions":      gateway.IntentDirectMessageReactions,
+	"direct_message_typing":         gateway.IntentDirectMessageTyping,
+	"message_content":               gateway.IntentMessageContent,
+	"guild_scheduled_events":        gateway.IntentGuildScheduledEvents,
+	"auto_moderation_configuration": gateway.IntentAutoModerationConfiguration,
+	"auto_moderation_execution":     gateway.IntentAutoModerationExecution,
+	"non_privileged":                gateway.IntentsNonPrivileged,
+	"privileged":                    gateway.IntentsPrivileged,
+	"all":                           gateway.IntentsAll,
+}
+
+// CacheFlagNames maps the names usable in File.CacheFlags to their cache.Flags.
+var CacheFlagNames = map[string]cache.Flags{
+	"guilds":                 cache.FlagGuilds,
+	"guild_scheduled_events": cache.FlagGuildScheduledEvents,
+	"members":                cache.FlagMembers,
+	"thread_members":         cache.FlagThreadMembers,
+	"messages":               cache.FlagMessages,
+	"presences":              cache.FlagPresences,
+	"channels":               cache.FlagChannels,
+	"roles":                  cache.FlagRoles,
+	"emojis":                 cache.FlagEmojis,
+	"stickers":               cache.FlagStickers,
+	"voice_states":           cache.FlagVoiceStates,
+	"stage_instances":        cache.FlagStageInstances,
+	"all":                    cache.FlagsAll,
+}
+
+// ActivityTypeNames maps the names usable in Activity.Type to their discord.ActivityType.
+var ActivityTypeNames = map[string]discord.ActivityType{
+	"playing":   discord.ActivityTypeGame,
+	"streaming": discord.ActivityTypeStreaming,
+	"listening": discord.ActivityTypeListening,
+	"watching":  discord.ActivityTypeWatching,
+	"custom":    discord.ActivityTypeCustom,
+	"competing": discord.ActivityTypeCompeting,
+}
+
+// Load reads the File at the given path, applies the overrides of the environment variables and validates it.
+// The format is chosen by the file extension, ".yaml" & ".yml" files are decoded as YAML and all others as JSON.
+// If the path is empty, the File is only built from the environment variables.
+//
+// The following environment variables, prefixed with Config.EnvPrefix, override the values of the file:
+// TOKEN, INTENTS, CACHE_FLAGS, SHARD_IDS, SHARD_COUNT, SHARD_AUTO_SCALING, STATUS, ACTIVITY_TYPE, ACTIVITY_NAME, ACTIVITY_URL, DEV_GUILD_IDS & DEVELOPMENT.
+// Lists are separated by commas.
+func Load(path string, opts ...ConfigOpt) (*File, error) {
+	config := DefaultConfig()
+	config.Apply(opts)
+
+	var file File
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err = decode(data, filepath.Ext(path), &file); err != nil {
+			return nil, fmt.Errorf("failed to decode bot config %s: %w", path, err)
+		}
+	}
+	if err := file.applyEnv(config); err != nil {
+		return nil, err
+	}
+	if err := file.Validate(); err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+func decode(data []byte, ext string, file *File) error {
+	if ext != ".yaml" && ext != ".yml" {
+		return json.Unmarshal(data, file)
+	}
+	// YAML is converted to JSON, so both formats are decoded the same way
+	var v any
+	if err := yaml.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	jsonData, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(jsonData, file)
+}
+
+func (f *File) applyEnv(config *Config) error {
+	lookup := func(key string) (string, bool) {
+		value, ok := config.LookupEnv(config.EnvPrefix + key)
+		return strings.TrimSpace(value), ok
+	}
+	var err error
+	if value, ok := lookup("TOKEN"); ok {
+		f.Token = value
+	}
+	if value, ok := lookup("INTENTS"); ok {
+		f.Intents = splitList(value)
+	}
+	if value, ok := lookup("CACHE_FLAGS"); ok {
+		f.CacheFlags = splitList(value)
+	}
+	if value, ok := lookup("SHARD_IDS"); ok {
+		f.shards().IDs = nil
+		for _, id := range splitList(value) {
+			shardID, err := strconv.Atoi(id)
+			if err != nil {
+				return fmt.Errorf("%w: invalid shard id %q", discord.ErrInvalidBotConfig, id)
+			}
+			f.shards().IDs = append(f.shards().IDs, shardID)
+		}
+	}
+	if value, ok := lookup("SHARD_COUNT"); ok {
+		if f.shards().Count, err = strconv.Atoi(value); err != nil {
+			return fmt.Errorf("%w: invalid shard count %q", discord.ErrInvalidBotConfig, value)
+		}
+	}
+	if value, ok := lookup("SHARD_AUTO_SCALING"); ok {
+		if f.shards().AutoScaling, err = strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%w: invalid shard auto scaling %q", discord.ErrInvalidBotConfig, value)
+		}
+	}
+	if value, ok := lookup("STATUS"); ok {
+		f.presence().Status = discord.OnlineStatus(value)
+	}
+	if value, ok := lookup("ACTIVITY_TYPE"); ok {
+		f.activity().Type = value
+	}
+	if value, ok := lookup("ACTIVITY_NAME"); ok {
+		f.activity().Name = value
+	}
+	if value, ok := lookup("ACTIVITY_URL"); ok {
+		f.activity().URL = value
+	}
+	if value, ok := lookup("DEV_GUILD_IDS"); ok {
+		f.DevGuildIDs = nil
+		for _, id := range splitList(value) {
+			guildID, err := snowflake.Parse(id)
+			if err != nil {
+				return fmt.Errorf("%w: invalid dev guild id %q", discord.ErrInvalidBotConfig, id)
+			}
+			f.DevGuildIDs = append(f.DevGuildIDs, guildID)
+		}
+	}
+	if value, ok := lookup("DEVELOPMENT"); ok {
+		if f.Development, err = strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%w: invalid development %q", discord.ErrInvalidBotConfig, value)
+		}
+	}
+	return nil
+}
+
+func (f *File) shards() *Shards {
+	if f.Shards == nil {
+		f.Shards = &Shards{}
+	}
+	return f.Shards
+}
+
+func (f *File) presence() *Presence {
+	if f.Presence == nil {
+		f.Presence = &Presence{Status: discord.OnlineStatusOnline}
+	}
+	return f.Presence
+}
+
+func (f *File) activity() *Activity {
+	if f.presence().Activity == nil {
+		f.Presence.Activity = &Activity{}
+	}
+	return f.Presence.Activity
+}
+
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// Validate checks the File for missing or unknown values. The returned errors wrap discord.ErrInvalidBotConfig.
+func (f File) Validate() error {
+	if f.Token == "" {
+		return fmt.Errorf("%w: token must be set", discord.ErrInvalidBotConfig)
+	}
+	if _, err := f.GatewayIntents(); err != nil {
+		return err
+	}
+	if _, err := f.Flags(); err != nil {
+		return err
+	}
+	if f.Shards != nil {
+		if f.Shards.Count < 0 {
+			return fmt.Errorf("%w: shard count must not be negative", discord.ErrInvalidBotConfig)
+		}
+		for _, shardID := range f.Shards.IDs {
+			if shardID < 0 || (f.Shards.Count > 0 && shardID >= f.Shards.Count) {
+				return fmt.Errorf("%w: shard id %d is out of range", discord.ErrInvalidBotConfig, shardID)
+			}
+		}
+	}
+	if _, err := f.PresenceUpdate(); err != nil {
+		return err
+	}
+	if f.Development && len(f.DevGuildIDs) == 0 {
+		return fmt.Errorf("%w: dev guild ids must be set in development", discord.ErrInvalidBotConfig)
+	}
+	return nil
+}
+
+// GatewayIntents returns the gateway.Intents of the File.
+func (f File) GatewayIntents() (gateway.Intents, error) {
+	intents := gateway.IntentsNone
+	for _, name := range f.Intents {
+		intent, ok := IntentNames[strings.ToLower(name)]
+		if !ok {
+			return 0, fmt.Errorf("%w: unknown intent %q", discord.ErrInvalidBotConfig, name)
+		}
+		intents = intents.Add(intent)
+	}
+	return intents, nil
+}
+
+// Flags returns the cache.Flags of the File.
+func (f File) Flags() (cache.Flags, error) {
+	flags := cache.FlagsNone
+	for _, name := range f.CacheFlags {
+		flag, ok := CacheFlagNames[strings.ToLower(name)]
+		if !ok {
+			return 0, fmt.Errorf("%w: unknown cache flag %q", discord.ErrInvalidBotConfig, name)
+		}
+		flags = flags.Add(flag)
+	}
+	return flags, nil
+}
+
+// PresenceUpdate returns the gateway.MessageDataPresenceUpdate of the File.Presence or nil if it isn't set.
+func (f File) PresenceUpdate() (*gateway.MessageDataPresenceUpdate, error) {
+	if f.Presence == nil {
+		return nil, nil
+	}
+	presence := &gateway.MessageDataPresenceUpdate{
+		Status: f.Presence.Status,
+	}
+	switch presence.Status {
+	case discord.OnlineStatusOnline, discord.OnlineStatusDND, discord.OnlineStatusIdle, discord.OnlineStatusInvisible, discord.OnlineStatusOffline:
+	case "":
+		presence.Status = discord.OnlineStatusOnline
+	default:
+		return nil, fmt.Errorf("%w: %s", discord.ErrInvalidBotConfig, discord.ErrInvalidOnlineStatus)
+	}
+	if activity := f.Presence.Activity; activity != nil {
+		activityType, ok := ActivityTypeNames[strings.ToLower(activity.Type)]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown activity type %q", discord.ErrInvalidBotConfig, activity.Type)
+		}
+		if activity.Name == "" {
+			return nil, fmt.Errorf("%w: activity name must be set", discord.ErrInvalidBotConfig)
+		}
+		a := discord.Activity{
+			Name: activity.Name,
+			Type: activityType,
+		}
+		if activity.URL != "" {
+			url := activity.URL
+			a.URL = &url
+		}
+		presence.Activities = []discord.Activity{a}
+	}
+	return presence, nil
+}
+
+// ConfigOpts returns the bot.ConfigOpt(s) of the File. The File needs to be valid, see Validate.
+// The bot connects with a sharding.ShardManager if File.Shards is set and with a single gateway.Gateway otherwise.
+func (f File) ConfigOpts() ([]bot.ConfigOpt, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+	intents, _ := f.GatewayIntents()
+	flags, _ := f.Flags()
+	presence, _ := f.PresenceUpdate()
+
+	gatewayConfigOpts := []gateway.ConfigOpt{gateway.WithIntents(intents)}
+	if presence != nil {
+		gatewayConfigOpts = append(gatewayConfigOpts, gateway.WithPresence(*presence))
+	}
+
+	opts := []bot.ConfigOpt{bot.WithCacheConfigOpts(cache.WithCacheFlags(flags))}
+	if f.Shards == nil {
+		return append(opts, bot.WithGatewayConfigOpts(gatewayConfigOpts...)), nil
+	}
+	shardManagerConfigOpts := []sharding.ConfigOpt{
+		sharding.WithAutoScaling(f.Shards.AutoScaling),
+		sharding.WithGatewayConfigOpts(gatewayConfigOpts...),
+	}
+	if len(f.Shards.IDs) > 0 {
+		shardManagerConfigOpts = append(shardManagerConfigOpts, sharding.WithShardIDs(f.Shards.IDs...))
+	}
+	if f.Shards.Count > 0 {
+		shardManagerConfigOpts = append(shardManagerConfigOpts, sharding.WithShardCount(f.Shards.Count))
+	}
+	return append(opts, bot.WithShardManagerConfigOpts(shardManagerConfigOpts...)), nil
+}
+
+// SyncCommands syncs the given commands with bot.SyncCommandsDev using File.Development & File.DevGuildIDs.
+func (f File) SyncCommands(client bot.Client, commands []discord.ApplicationCommandCreate, opts ...rest.RequestOpt) error {
+	return bot.SyncCommandsDev(client, f.Development, commands, f.DevGuildIDs, opts...)
+}
@@ -0,0 +1,43 @@
+package botconfig
+
+import (
+	"os"
+)
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() *Config {
+	return &Config{
+		EnvPrefix: "DISGO_",
+		LookupEnv: os.LookupEnv,
+	}
+}
+
+// Config lets you configure how a File is loaded.
+type Config struct {
+	EnvPrefix string
+	LookupEnv func(key string) (string, bool)
+}
+
+// ConfigOpt is a type alias for a function that takes a Config and is used to configure how a File is loaded.
+type ConfigOpt func(config *Config)
+
+// Apply applies the given ConfigOpt(s) to the Config
+func (c *Config) Apply(opts []ConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithEnvPrefix sets the prefix of the environment variables which override the values of the File.
+func WithEnvPrefix(prefix string) ConfigOpt {
+	return func(config *Config) {
+		config.EnvPrefix = prefix
+	}
+}
+
+// WithLookupEnv sets the function used to look up environment variables. It defaults to os.LookupEnv.
+func WithLookupEnv(lookupEnv func(key string) (string, bool)) ConfigOpt {
+	return func(config *Config) {
+		config.LookupEnv = lookupEnv
+	}
+}
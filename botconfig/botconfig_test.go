@@ -0,0 +1,68 @@
+package botconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bot.yaml")
+	data := []byte(`
+token: file-token
+intents: [guilds, guild_messages]
+cache_flags: [guilds, roles]
+shards:
+  ids: [0, 1]
+  count: 2
+presence:
+  status: idle
+  activity:
+    type: watching
+    name: the logs
+dev_guild_ids: ["123", "456"]
+`)
+	assert.NoError(t, os.WriteFile(path, data, 0o600))
+
+	env := map[string]string{
+		"BOT_TOKEN":       "env-token",
+		"BOT_DEVELOPMENT": "true",
+	}
+	file, err := Load(path, WithEnvPrefix("BOT_"), WithLookupEnv(func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "env-token", file.Token)
+	assert.True(t, file.Development)
+	assert.Equal(t, []snowflake.ID{123, 456}, file.DevGuildIDs)
+
+	intents, _ := file.GatewayIntents()
+	assert.Equal(t, gateway.IntentGuilds|gateway.IntentGuildMessages, intents)
+	flags, _ := file.Flags()
+	assert.Equal(t, cache.FlagGuilds|cache.FlagRoles, flags)
+
+	presence, err := file.PresenceUpdate()
+	assert.NoError(t, err)
+	assert.Equal(t, discord.OnlineStatusIdle, presence.Status)
+	assert.Equal(t, discord.ActivityTypeWatching, presence.Activities[0].Type)
+
+	opts, err := file.ConfigOpts()
+	assert.NoError(t, err)
+	assert.Len(t, opts, 2)
+}
+
+func TestFile_Validate(t *testing.T) {
+	assert.ErrorIs(t, File{}.Validate(), discord.ErrInvalidBotConfig)
+	assert.ErrorIs(t, File{Token: "token", Intents: []string{"unknown"}}.Validate(), discord.ErrInvalidBotConfig)
+	assert.ErrorIs(t, File{Token: "token", Shards: &Shards{IDs: []int{2}, Count: 2}}.Validate(), discord.ErrInvalidBotConfig)
+	assert.ErrorIs(t, File{Token: "token", Presence: &Presence{Status: "away"}}.Validate(), discord.ErrInvalidBotConfig)
+	assert.NoError(t, File{Token: "token", Intents: []string{"Guilds"}}.Validate())
+}
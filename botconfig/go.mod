@@ -0,0 +1,21 @@
+module github.com/disgoorg/disgo/botconfig
+
+go 1.18
+
+replace github.com/disgoorg/disgo => ../
+
+require (
+	github.com/disgoorg/disgo v0.11.5
+	github.com/disgoorg/snowflake/v2 v2.0.0
+	github.com/stretchr/testify v1.7.0
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/disgoorg/log v1.2.0 // indirect
+	github.com/gorilla/websocket v1.5.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/sasha-s/go-csync v0.0.0-20210812194225-61421b77c44b // indirect
+	golang.org/x/exp v0.0.0-20220325121720-054d8573a5d8 // indirect
+)
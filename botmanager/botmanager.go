@@ -0,0 +1,176 @@
+// Package botmanager provides a Manager which runs multiple bots with different tokens in one process.
+// The bots share their rest transport, logger & cache statistics, and their events are combined into one stream tagged by application id.
+package botmanager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/disgoorg/disgo"
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// Event is a bot.Event of one of the bots of the Manager tagged with the application id of that bot.
+type Event struct {
+	bot.Event
+	ApplicationID snowflake.ID
+}
+
+// EventListener receives the combined Event(s) of all bots of the Manager.
+type EventListener interface {
+	OnEvent(event Event)
+}
+
+var _ EventListener = ListenerFunc(nil)
+
+// ListenerFunc is an EventListener for a func(event Event).
+type ListenerFunc func(event Event)
+
+// OnEvent calls the ListenerFunc.
+func (l ListenerFunc) OnEvent(event Event) {
+	l(event)
+}
+
+// New returns a new Manager with the given ConfigOpt(s) applied.
+func New(opts ...ConfigOpt) *Manager {
+	config := DefaultConfig()
+	config.Apply(opts)
+
+	return &Manager{
+		config:  *config,
+		clients: map[snowflake.ID]bot.Client{},
+	}
+}
+
+// Manager runs multiple bot.Client(s) identified by their application id.
+type Manager struct {
+	config Config
+
+	clientsMu sync.RWMutex
+	clients   map[snowflake.ID]bot.Client
+
+	listenersMu sync.RWMutex
+	listeners   []EventListener
+}
+
+// Add builds a new bot.Client for the token with the shared rest transport, logger & cache statistics and adds it to the Manager.
+// The given bot.ConfigOpt(s) are applied after the ones of the Manager. The gateway of the bot is not opened.
+func (m *Manager) Add(token string, opts ...bot.ConfigOpt) (bot.Client, error) {
+	sharedOpts := []bot.ConfigOpt{
+		bot.WithLogger(m.config.Logger),
+		bot.WithRestClientConfigOpts(rest.WithHTTPClient(m.config.HTTPClient)),
+		bot.WithEventListeners(bot.NewListenerFunc(m.dispatch)),
+	}
+	if m.config.StatsRecorder != nil {
+		sharedOpts = append(sharedOpts, bot.WithCacheConfigOpts(cache.WithStatsRecorder(m.config.StatsRecorder)))
+	}
+	sharedOpts = append(sharedOpts, m.config.BotConfigOpts...)
+
+	client, err := disgo.New(token, append(sharedOpts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	m.clientsMu.Lock()
+	defer m.clientsMu.Unlock()
+	if _, ok := m.clients[client.ApplicationID()]; ok {
+		client.Close(context.Background())
+		return nil, fmt.Errorf("%w: %s", discord.ErrBotAlreadyManaged, client.ApplicationID())
+	}
+	m.clients[client.ApplicationID()] = client
+	return client, nil
+}
+
+// Remove closes the bot.Client with the given application id and removes it from the Manager. It returns false if no such bot.Client is managed.
+func (m *Manager) Remove(ctx context.Context, applicationID snowflake.ID) bool {
+	m.clientsMu.Lock()
+	client, ok := m.clients[applicationID]
+	delete(m.clients, applicationID)
+	m.clientsMu.Unlock()
+	if ok {
+		client.Close(ctx)
+	}
+	return ok
+}
+
+// Client returns the bot.Client with the given application id.
+func (m *Manager) Client(applicationID snowflake.ID) (bot.Client, bool) {
+	m.clientsMu.RLock()
+	defer m.clientsMu.RUnlock()
+	client, ok := m.clients[applicationID]
+	return client, ok
+}
+
+// Clients returns all managed bot.Client(s).
+func (m *Manager) Clients() []bot.Client {
+	m.clientsMu.RLock()
+	defer m.clientsMu.RUnlock()
+	clients := make([]bot.Client, 0, len(m.clients))
+	for _, client := range m.clients {
+		clients = append(clients, client)
+	}
+	return clients
+}
+
+// Open connects the gateway or sharding.ShardManager of every bot.Client which has one configured.
+// It continues with the next bot.Client if one fails to connect and returns the first error.
+func (m *Manager) Open(ctx context.Context) error {
+	var firstErr error
+	for _, client := range m.Clients() {
+		var err error
+		if client.HasShardManager() {
+			err = client.ConnectShardManager(ctx)
+		} else if client.HasGateway() {
+			err = client.ConnectGateway(ctx)
+		}
+		if err != nil {
+			m.config.Logger.Errorf("failed to connect bot %s: %s", client.ApplicationID(), err)
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to connect bot %s: %w", client.ApplicationID(), err)
+			}
+		}
+	}
+	return firstErr
+}
+
+// Close closes all managed bot.Client(s) and removes them from the Manager.
+func (m *Manager) Close(ctx context.Context) {
+	m.clientsMu.Lock()
+	clients := m.clients
+	m.clients = map[snowflake.ID]bot.Client{}
+	m.clientsMu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, client := range clients {
+		wg.Add(1)
+		go func(client bot.Client) {
+			defer wg.Done()
+			client.Close(ctx)
+		}(client)
+	}
+	wg.Wait()
+}
+
+// AddEventListeners adds the EventListener(s) to the combined event stream of all bots.
+func (m *Manager) AddEventListeners(listeners ...EventListener) {
+	m.listenersMu.Lock()
+	defer m.listenersMu.Unlock()
+	m.listeners = append(m.listeners, listeners...)
+}
+
+func (m *Manager) dispatch(event bot.Event) {
+	m.listenersMu.RLock()
+	defer m.listenersMu.RUnlock()
+	tagged := Event{
+		Event:         event,
+		ApplicationID: event.Client().ApplicationID(),
+	}
+	for _, listener := range m.listeners {
+		listener.OnEvent(tagged)
+	}
+}
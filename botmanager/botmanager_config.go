@@ -0,0 +1,64 @@
+package botmanager
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/log"
+)
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() *Config {
+	return &Config{
+		Logger:     log.Default(),
+		HTTPClient: &http.Client{Timeout: 20 * time.Second},
+	}
+}
+
+// Config lets you configure your Manager instance.
+type Config struct {
+	Logger        log.Logger
+	HTTPClient    *http.Client
+	StatsRecorder cache.StatsRecorder
+	BotConfigOpts []bot.ConfigOpt
+}
+
+// ConfigOpt is a type alias for a function that takes a Config and is used to configure your Manager.
+type ConfigOpt func(config *Config)
+
+// Apply applies the given ConfigOpt(s) to the Config
+func (c *Config) Apply(opts []ConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithLogger sets the log.Logger shared by all bots.
+func WithLogger(logger log.Logger) ConfigOpt {
+	return func(config *Config) {
+		config.Logger = logger
+	}
+}
+
+// WithHTTPClient sets the http.Client the rest clients of all bots share, so they reuse the same connections.
+func WithHTTPClient(httpClient *http.Client) ConfigOpt {
+	return func(config *Config) {
+		config.HTTPClient = httpClient
+	}
+}
+
+// WithStatsRecorder sets the cache.StatsRecorder shared by the caches of all bots.
+func WithStatsRecorder(statsRecorder cache.StatsRecorder) ConfigOpt {
+	return func(config *Config) {
+		config.StatsRecorder = statsRecorder
+	}
+}
+
+// WithBotConfigOpts applies the given bot.ConfigOpt(s) to every bot. They are applied before the bot.ConfigOpt(s) passed to Manager.Add.
+func WithBotConfigOpts(opts ...bot.ConfigOpt) ConfigOpt {
+	return func(config *Config) {
+		config.BotConfigOpts = append(config.BotConfigOpts, opts...)
+	}
+}
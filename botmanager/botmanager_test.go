@@ -0,0 +1,41 @@
+package botmanager
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func token(applicationID snowflake.ID) string {
+	return base64.StdEncoding.EncodeToString([]byte(applicationID.String())) + ".x.y"
+}
+
+func TestManager(t *testing.T) {
+	m := New()
+	var received []snowflake.ID
+	m.AddEventListeners(ListenerFunc(func(event Event) {
+		received = append(received, event.ApplicationID)
+	}))
+
+	client1, err := m.Add(token(1))
+	assert.NoError(t, err)
+	client2, err := m.Add(token(2))
+	assert.NoError(t, err)
+	_, err = m.Add(token(1))
+	assert.ErrorIs(t, err, discord.ErrBotAlreadyManaged)
+	assert.Len(t, m.Clients(), 2)
+
+	client2.EventManager().DispatchEvent(events.NewGenericEvent(client2, 0, 0))
+	client1.EventManager().DispatchEvent(events.NewGenericEvent(client1, 0, 0))
+	assert.Equal(t, []snowflake.ID{2, 1}, received)
+	assert.Same(t, m.config.HTTPClient, client1.Rest().HTTPClient())
+
+	assert.True(t, m.Remove(context.Background(), 1))
+	_, ok := m.Client(1)
+	assert.False(t, ok)
+}
@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"github.com/disgoorg/disgo/discord"
+)
+
+// Backend is the storage layer of a Caches. Implement it to store all entities in your own storage, for example a database, and inject it with WithBackend.
+// The Caches built around the Backend still provide the permission calculation, guild readiness tracking, stats and the other utility methods,
+// so the gateway handlers keep working through the usual accessors like Caches.Members.
+// The Flags of the Caches are still checked before entities are put into the Backend, while its Policy(s), MaxSize, MaxGroupSize, Expirations and listeners are not applied.
+// Methods may return nil to keep the default in memory cache for these entities.
+type Backend interface {
+	// Guilds returns the Cache which stores the guilds.
+	Guilds() Cache[discord.Guild]
+
+	// Channels returns the Cache which stores the channels.
+	Channels() Cache[discord.Channel]
+
+	// StageInstances returns the GroupedCache which stores the stage instances grouped by their guild.
+	StageInstances() GroupedCache[discord.StageInstance]
+
+	// GuildScheduledEvents returns the GroupedCache which stores the guild scheduled events grouped by their guild.
+	GuildScheduledEvents() GroupedCache[discord.GuildScheduledEvent]
+
+	// Roles returns the GroupedCache which stores the roles grouped by their guild.
+	Roles() GroupedCache[discord.Role]
+
+	// Members returns the GroupedCache which stores the members grouped by their guild.
+	Members() GroupedCache[discord.Member]
+
+	// ThreadMembers returns the GroupedCache which stores the thread members grouped by their thread.
+	ThreadMembers() GroupedCache[discord.ThreadMember]
+
+	// Presences returns the GroupedCache which stores the presences grouped by their guild.
+	Presences() GroupedCache[discord.Presence]
+
+	// VoiceStates returns the GroupedCache which stores the voice states grouped by their guild.
+	VoiceStates() GroupedCache[discord.VoiceState]
+
+	// Messages returns the GroupedCache which stores the messages grouped by their channel.
+	Messages() GroupedCache[discord.Message]
+
+	// Emojis returns the GroupedCache which stores the emojis grouped by their guild.
+	Emojis() GroupedCache[discord.Emoji]
+
+	// Stickers returns the GroupedCache which stores the stickers grouped by their guild.
+	Stickers() GroupedCache[discord.Sticker]
+}
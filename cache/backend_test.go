@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/stretchr/testify/assert"
+)
+
+type testBackend struct {
+	guilds Cache[discord.Guild]
+	roles  GroupedCache[discord.Role]
+}
+
+func (b *testBackend) Guilds() Cache[discord.Guild]                                    { return b.guilds }
+func (b *testBackend) Channels() Cache[discord.Channel]                                { return nil }
+func (b *testBackend) StageInstances() GroupedCache[discord.StageInstance]             { return nil }
+func (b *testBackend) GuildScheduledEvents() GroupedCache[discord.GuildScheduledEvent] { return nil }
+func (b *testBackend) Roles() GroupedCache[discord.Role]                               { return b.roles }
+func (b *testBackend) Members() GroupedCache[discord.Member]                           { return nil }
+func (b *testBackend) ThreadMembers() GroupedCache[discord.ThreadMember]               { return nil }
+func (b *testBackend) Presences() GroupedCache[discord.Presence]                       { return nil }
+func (b *testBackend) VoiceStates() GroupedCache[discord.VoiceState]                   { return nil }
+func (b *testBackend) Messages() GroupedCache[discord.Message]                         { return nil }
+func (b *testBackend) Emojis() GroupedCache[discord.Emoji]                             { return nil }
+func (b *testBackend) Stickers() GroupedCache[discord.Sticker]                         { return nil }
+
+func TestCaches_Backend(t *testing.T) {
+	backend := &testBackend{
+		guilds: NewCache[discord.Guild](FlagsNone, FlagsNone, nil),
+		roles:  NewGroupedCache[discord.Role](FlagsNone, FlagsNone, nil),
+	}
+	caches := New(WithCacheFlags(FlagGuilds, FlagRoles), WithBackend(backend))
+
+	caches.Guilds().Put(1, discord.Guild{ID: 1, OwnerID: 2})
+	caches.Roles().Put(1, 1, discord.Role{ID: 1, Permissions: discord.PermissionSendMessages})
+	assert.Equal(t, 1, backend.guilds.Len())
+	assert.Equal(t, 1, backend.roles.Len())
+
+	assert.Equal(t, discord.PermissionSendMessages, caches.GetMemberPermissions(discord.Member{GuildID: 1, User: discord.User{ID: 3}}))
+	assert.Equal(t, discord.PermissionsAll, caches.GetMemberPermissions(discord.Member{GuildID: 1, User: discord.User{ID: 2}}))
+}
@@ -9,6 +9,9 @@ import (
 // FilterFunc is used to filter cached entities.
 type FilterFunc[T any] func(T) bool
 
+// ComputeFunc is used to update a cached entity. It receives the current entity and whether it is present and returns the new entity and whether it should be stored.
+type ComputeFunc[T any] func(entity T, ok bool) (T, bool)
+
 // Cache is a simple key value store. They key is always a snowflake.ID.
 // The cache provides a simple way to store and retrieve entities. But is not guaranteed to be thread safe as this depends on the underlying implementation.
 type Cache[T any] interface {
@@ -18,6 +21,17 @@ type Cache[T any] interface {
 	// Put stores the given entity with the given snowflake as key. If the entity is already present, it will be overwritten.
 	Put(id snowflake.ID, entity T)
 
+	// GetOrPut returns the entity with the given snowflake if present, else it stores and returns the entity created by the supplier.
+	// The bool reports whether the entity was already present. The check and insert happen atomically, so the supplier must not access the cache.
+	GetOrPut(id snowflake.ID, supplier func() T) (T, bool)
+
+	// PutIfAbsent stores the given entity with the given snowflake as key if no entity is present and returns whether it was stored.
+	PutIfAbsent(id snowflake.ID, entity T) bool
+
+	// Compute updates the entity with the given snowflake with the entity returned by the ComputeFunc. If the ComputeFunc returns false, the entity is removed instead.
+	// It returns the computed entity and whether it was stored. The read and write happen atomically, so the ComputeFunc must not access the cache.
+	Compute(id snowflake.ID, computeFunc ComputeFunc[T]) (T, bool)
+
 	// Remove removes the entity with the given snowflake as key and returns a copy of the entity and a bool whether it was removed or not.
 	Remove(id snowflake.ID) (T, bool)
 
@@ -41,6 +55,14 @@ type Cache[T any] interface {
 
 	// ForEach calls the given function for each entity in the cache.
 	ForEach(func(entity T))
+
+	// Iter returns an iterator over all entities in the cache without copying them. It is compatible with iter.Seq2 and can be used with range over func.
+	// The read lock is held while iterating, so the cache must not be modified from within the loop.
+	Iter() func(yield func(id snowflake.ID, entity T) bool)
+
+	// Snapshot returns an immutable View of all entities in the cache, which can be iterated without holding the lock of the cache.
+	// The View shares its entities with the cache until the cache is modified the next time, which copies them once. This makes taking a Snapshot cheap.
+	Snapshot() View[T]
 }
 
 var _ Cache[any] = (*DefaultCache[any])(nil)
@@ -57,6 +79,28 @@ func NewCache[T any](flags Flags, neededFlags Flags, policy Policy[T]) Cache[T]
 	}
 }
 
+// NewLRUCache returns a new DefaultCache implementation like NewCache which holds at most maxSize entities.
+// If the cache is full, Put evicts the least recently used entity and calls the given EvictFunc with it.
+// A maxSize of 0 disables the limit.
+func NewLRUCache[T any](flags Flags, neededFlags Flags, policy Policy[T], maxSize int, evictFunc EvictFunc[T]) Cache[T] {
+	return newLRUCache[T](flags, neededFlags, policy, maxSize, evictFunc)
+}
+
+func newLRUCache[T any](flags Flags, neededFlags Flags, policy Policy[T], maxSize int, evictFunc EvictFunc[T]) *DefaultCache[T] {
+	c := &DefaultCache[T]{
+		flags:       flags,
+		neededFlags: neededFlags,
+		policy:      policy,
+		cache:       make(map[snowflake.ID]T),
+		maxSize:     maxSize,
+		evictFunc:   evictFunc,
+	}
+	if maxSize > 0 {
+		c.order = newLRUOrder()
+	}
+	return c
+}
+
 // DefaultCache is a simple thread safe cache key value store.
 type DefaultCache[T any] struct {
 	mu          sync.RWMutex
@@ -64,9 +108,28 @@ type DefaultCache[T any] struct {
 	neededFlags Flags
 	policy      Policy[T]
 	cache       map[snowflake.ID]T
+	// shared reports whether the map is referenced by a View and needs to be copied before it is modified
+	shared bool
+
+	maxSize   int
+	evictFunc EvictFunc[T]
+	order     *lruOrder
+
+	putListener    Listener[T]
+	removeListener Listener[T]
 }
 
 func (c *DefaultCache[T]) Get(id snowflake.ID) (T, bool) {
+	if c.order != nil {
+		// getting an entity changes the access order, so we need a write lock
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		entity, ok := c.cache[id]
+		if ok {
+			c.order.touch(id)
+		}
+		return entity, ok
+	}
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 	entity, ok := c.cache[id]
@@ -74,35 +137,182 @@ func (c *DefaultCache[T]) Get(id snowflake.ID) (T, bool) {
 }
 
 func (c *DefaultCache[T]) Put(id snowflake.ID, entity T) {
-	if c.neededFlags != FlagsNone && c.flags.Missing(c.neededFlags) {
+	if !c.allowed(entity) {
 		return
 	}
-	if c.policy != nil && !c.policy(entity) {
-		return
+	c.mu.Lock()
+	evictedID, evicted, ok := c.put(id, entity)
+	c.mu.Unlock()
+	c.stored(id, entity)
+	c.evicted(evictedID, evicted, ok)
+}
+
+func (c *DefaultCache[T]) GetOrPut(id snowflake.ID, supplier func() T) (T, bool) {
+	c.mu.Lock()
+	if entity, ok := c.cache[id]; ok {
+		if c.order != nil {
+			c.order.touch(id)
+		}
+		c.mu.Unlock()
+		return entity, true
+	}
+	entity := supplier()
+	if !c.allowed(entity) {
+		c.mu.Unlock()
+		return entity, false
+	}
+	evictedID, evicted, ok := c.put(id, entity)
+	c.mu.Unlock()
+	c.stored(id, entity)
+	c.evicted(evictedID, evicted, ok)
+	return entity, false
+}
+
+func (c *DefaultCache[T]) PutIfAbsent(id snowflake.ID, entity T) bool {
+	if !c.allowed(entity) {
+		return false
 	}
 	c.mu.Lock()
-	defer c.mu.Unlock()
-	c.cache[id] = entity
+	if _, ok := c.cache[id]; ok {
+		c.mu.Unlock()
+		return false
+	}
+	evictedID, evicted, ok := c.put(id, entity)
+	c.mu.Unlock()
+	c.stored(id, entity)
+	c.evicted(evictedID, evicted, ok)
+	return true
+}
+
+func (c *DefaultCache[T]) Compute(id snowflake.ID, computeFunc ComputeFunc[T]) (T, bool) {
+	c.mu.Lock()
+	old, exists := c.cache[id]
+	entity, ok := computeFunc(old, exists)
+	if !ok {
+		if exists {
+			delete(c.writable(), id)
+			if c.order != nil {
+				c.order.remove(id)
+			}
+		}
+		c.mu.Unlock()
+		if exists && c.removeListener != nil {
+			c.removeListener(0, id, old)
+		}
+		return entity, false
+	}
+	if !c.allowed(entity) {
+		c.mu.Unlock()
+		return entity, false
+	}
+	evictedID, evicted, evictedOk := c.put(id, entity)
+	c.mu.Unlock()
+	c.stored(id, entity)
+	c.evicted(evictedID, evicted, evictedOk)
+	return entity, true
+}
+
+// allowed returns whether the entity passes the Flags and Policy of the cache.
+func (c *DefaultCache[T]) allowed(entity T) bool {
+	if c.neededFlags != FlagsNone && c.flags.Missing(c.neededFlags) {
+		return false
+	}
+	return c.policy == nil || c.policy(entity)
+}
+
+// put stores the entity and evicts the least recently used entity if the cache is full. It needs to be called with the write lock held.
+func (c *DefaultCache[T]) put(id snowflake.ID, entity T) (snowflake.ID, T, bool) {
+	c.writable()[id] = entity
+	if c.order == nil {
+		var zero T
+		return 0, zero, false
+	}
+	c.order.touch(id)
+	return c.evict()
+}
+
+// stored calls the put Listener with the stored entity. It needs to be called without holding the lock.
+func (c *DefaultCache[T]) stored(id snowflake.ID, entity T) {
+	if c.putListener != nil {
+		c.putListener(0, id, entity)
+	}
+}
+
+// evicted calls the EvictFunc & the remove Listener with the evicted entity. It needs to be called without holding the lock.
+func (c *DefaultCache[T]) evicted(id snowflake.ID, entity T, ok bool) {
+	if !ok {
+		return
+	}
+	if c.evictFunc != nil {
+		c.evictFunc(0, id, entity)
+	}
+	if c.removeListener != nil {
+		c.removeListener(0, id, entity)
+	}
+}
+
+// evict removes the least recently used entity if the cache exceeds its maximum size.
+func (c *DefaultCache[T]) evict() (snowflake.ID, T, bool) {
+	var entity T
+	if len(c.cache) <= c.maxSize {
+		return 0, entity, false
+	}
+	id, ok := c.order.oldest()
+	if !ok {
+		return 0, entity, false
+	}
+	entity = c.cache[id]
+	delete(c.writable(), id)
+	c.order.remove(id)
+	return id, entity, true
+}
+
+// writable returns the map for modification and copies it first if it is shared with a View. It needs to be called with the write lock held.
+func (c *DefaultCache[T]) writable() map[snowflake.ID]T {
+	if c.shared {
+		c.cache = copyMap(c.cache)
+		c.shared = false
+	}
+	return c.cache
 }
 
 func (c *DefaultCache[T]) Remove(id snowflake.ID) (T, bool) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
 	entity, ok := c.cache[id]
 	if ok {
-		delete(c.cache, id)
+		delete(c.writable(), id)
+		if c.order != nil {
+			c.order.remove(id)
+		}
+	}
+	c.mu.Unlock()
+	if ok && c.removeListener != nil {
+		c.removeListener(0, id, entity)
 	}
 	return entity, ok
 }
 
 func (c *DefaultCache[T]) RemoveIf(filterFunc FilterFunc[T]) {
+	var removed map[snowflake.ID]T
 	c.mu.Lock()
-	defer c.mu.Unlock()
 	for id, entity := range c.cache {
 		if filterFunc(entity) {
-			delete(c.cache, id)
+			delete(c.writable(), id)
+			if c.order != nil {
+				c.order.remove(id)
+			}
+			if c.removeListener != nil {
+				if removed == nil {
+					removed = map[snowflake.ID]T{}
+				}
+				removed[id] = entity
+			}
 		}
 	}
+	c.mu.Unlock()
+	for id, entity := range removed {
+		c.removeListener(0, id, entity)
+	}
 }
 
 func (c *DefaultCache[T]) Len() int {
@@ -165,3 +375,23 @@ func (c *DefaultCache[T]) ForEach(forEachFunc func(entity T)) {
 		forEachFunc(entity)
 	}
 }
+
+func (c *DefaultCache[T]) Iter() func(yield func(id snowflake.ID, entity T) bool) {
+	return func(yield func(id snowflake.ID, entity T) bool) {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+
+		for id, entity := range c.cache {
+			if !yield(id, entity) {
+				return
+			}
+		}
+	}
+}
+
+func (c *DefaultCache[T]) Snapshot() View[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.shared = true
+	return View[T]{entities: c.cache}
+}
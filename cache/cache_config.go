@@ -1,6 +1,9 @@
 package cache
 
 import (
+	"time"
+
+	"github.com/disgoorg/disgo/clock"
 	"github.com/disgoorg/disgo/discord"
 )
 
@@ -8,6 +11,7 @@ import (
 func DefaultConfig() *Config {
 	return &Config{
 		CacheFlags:                     FlagsDefault,
+		GuildCreateFlags:               GuildCreateFlagsAll,
 		GuildCachePolicy:               PolicyDefault[discord.Guild],
 		ChannelCachePolicy:             PolicyDefault[discord.Channel],
 		StageInstanceCachePolicy:       PolicyDefault[discord.StageInstance],
@@ -20,12 +24,14 @@ func DefaultConfig() *Config {
 		MessageCachePolicy:             PolicyDefault[discord.Message],
 		EmojiCachePolicy:               PolicyDefault[discord.Emoji],
 		StickerCachePolicy:             PolicyDefault[discord.Sticker],
+		Clock:                          clock.Default,
 	}
 }
 
 // Config lets you configure your Caches instance.
 type Config struct {
-	CacheFlags Flags
+	CacheFlags       Flags
+	GuildCreateFlags GuildCreateFlags
 
 	GuildCachePolicy               Policy[discord.Guild]
 	ChannelCachePolicy             Policy[discord.Channel]
@@ -39,6 +45,41 @@ type Config struct {
 	MessageCachePolicy             Policy[discord.Message]
 	EmojiCachePolicy               Policy[discord.Emoji]
 	StickerCachePolicy             Policy[discord.Sticker]
+
+	GroupedCachePolicy GroupedPolicy[any]
+
+	// injected caches replace the default ones, their policies, MaxSize, MaxGroupSize, Expirations and listeners are not applied
+	GuildCache               Cache[discord.Guild]
+	ChannelCache             Cache[discord.Channel]
+	StageInstanceCache       GroupedCache[discord.StageInstance]
+	GuildScheduledEventCache GroupedCache[discord.GuildScheduledEvent]
+	RoleCache                GroupedCache[discord.Role]
+	MemberCache              GroupedCache[discord.Member]
+	ThreadMemberCache        GroupedCache[discord.ThreadMember]
+	PresenceCache            GroupedCache[discord.Presence]
+	VoiceStateCache          GroupedCache[discord.VoiceState]
+	MessageCache             GroupedCache[discord.Message]
+	EmojiCache               GroupedCache[discord.Emoji]
+	StickerCache             GroupedCache[discord.Sticker]
+
+	MaxSize      int
+	MaxGroupSize int
+	EvictFunc    EvictFunc[any]
+
+	Expirations []Expiration
+
+	Indexes []Index
+
+	SizeEstimators []SizeEstimator
+
+	PutListener    Listener[any]
+	RemoveListener Listener[any]
+
+	StatsRecorder StatsRecorder
+
+	Invalidator Invalidator
+
+	Clock clock.Clock
 }
 
 // ConfigOpt is a type alias for a function that takes a Config and is used to configure your Caches.
@@ -58,6 +99,14 @@ func WithCacheFlags(flags ...Flags) ConfigOpt {
 	}
 }
 
+// WithGuildCreateFlags sets the GuildCreateFlags of the Config.
+// Only the given nested entities of a gateway.EventTypeGuildCreate event are cached. By default, all of them are cached.
+func WithGuildCreateFlags(flags ...GuildCreateFlags) ConfigOpt {
+	return func(config *Config) {
+		config.GuildCreateFlags = GuildCreateFlagsNone.Add(flags...)
+	}
+}
+
 // WithGuildCachePolicy sets the Policy[discord.Guild] of the Config.
 func WithGuildCachePolicy(policy Policy[discord.Guild]) ConfigOpt {
 	return func(config *Config) {
@@ -141,3 +190,230 @@ func WithStickerCachePolicy(policy Policy[discord.Sticker]) ConfigOpt {
 		config.StickerCachePolicy = policy
 	}
 }
+
+// WithGroupedCachePolicy sets the GroupedPolicy which is checked for every entity put into one of the grouped caches in addition to their Policy.
+// Use a type switch on the entity to only restrict specific caches, for example to only cache members of some guilds:
+//
+//	cache.WithGroupedCachePolicy(func(groupID snowflake.ID, entity any) bool {
+//		if _, ok := entity.(discord.Member); ok {
+//			return groupID == guildID
+//		}
+//		return true
+//	})
+func WithGroupedCachePolicy(policy GroupedPolicy[any]) ConfigOpt {
+	return func(config *Config) {
+		config.GroupedCachePolicy = policy
+	}
+}
+
+// WithMaxSize sets the maximum number of entities the guild and channel caches hold.
+// If a cache is full, the least recently used entity is evicted. A size of 0 disables the limit.
+func WithMaxSize(size int) ConfigOpt {
+	return func(config *Config) {
+		config.MaxSize = size
+	}
+}
+
+// WithMaxGroupSize sets the maximum number of entities each group of the grouped caches holds.
+// If a group is full, the least recently used entity of that group is evicted. A size of 0 disables the limit.
+func WithMaxGroupSize(size int) ConfigOpt {
+	return func(config *Config) {
+		config.MaxGroupSize = size
+	}
+}
+
+// WithEvictFunc sets the EvictFunc which is called with every entity evicted because of WithMaxSize or WithMaxGroupSize.
+// Evicted entities are passed to the Listener set with WithRemoveListener as well, so the EvictFunc is only needed to tell evictions apart.
+func WithEvictFunc(evictFunc EvictFunc[any]) ConfigOpt {
+	return func(config *Config) {
+		config.EvictFunc = evictFunc
+	}
+}
+
+// WithExpiration lets the entities of the grouped caches matching the given Flags expire after the TTL, for example:
+//
+//	cache.WithExpiration(cache.FlagMembers, 30*time.Minute, cache.ExpirationSliding)
+//
+// only keeps members which were updated or accessed via Get within the last 30 minutes. Expired entities are passed to the Listener set with WithRemoveListener.
+func WithExpiration(flags Flags, ttl time.Duration, mode ExpirationMode) ConfigOpt {
+	return func(config *Config) {
+		config.Expirations = append(config.Expirations, Expiration{
+			Flags: flags,
+			TTL:   ttl,
+			Mode:  mode,
+		})
+	}
+}
+
+// WithIndex adds a secondary index to the grouped caches matching the given Flags, so their entities can be looked up with GroupedCache.GetByIndex, for example:
+//
+//	cache.WithIndex(cache.FlagRoles, "name", func(entity any) string {
+//		return entity.(discord.Role).Name
+//	})
+//
+// lets you find a role by its name with Caches.Roles().GetByIndex(guildID, "name", "Moderator").
+func WithIndex(flags Flags, name string, keyFunc IndexFunc[any]) ConfigOpt {
+	return func(config *Config) {
+		config.Indexes = append(config.Indexes, Index{
+			Flags:   flags,
+			Name:    name,
+			KeyFunc: keyFunc,
+		})
+	}
+}
+
+// WithSizeEstimator sets the SizeFunc Caches.SizeBytes uses for the caches matching the given Flags instead of EstimateSize, for example:
+//
+//	cache.WithSizeEstimator(cache.FlagMessages, func(entity any) int {
+//		return 512 + len(entity.(discord.Message).Content)
+//	})
+func WithSizeEstimator(flags Flags, sizeFunc SizeFunc[any]) ConfigOpt {
+	return func(config *Config) {
+		config.SizeEstimators = append(config.SizeEstimators, SizeEstimator{
+			Flags:    flags,
+			SizeFunc: sizeFunc,
+		})
+	}
+}
+
+// WithPutListener sets the Listener which is called with every entity put into a cache.
+// It is called synchronously after the entity was stored, so it should not block. Use a type switch on the entity to find out which cache it belongs to.
+// Like the EvictFunc & the remove Listener it is only called by the caches created by New, not by caches injected with the With...Cache ConfigOpt(s) or WithBackend.
+func WithPutListener(listener Listener[any]) ConfigOpt {
+	return func(config *Config) {
+		config.PutListener = listener
+	}
+}
+
+// WithRemoveListener sets the Listener which is called with every entity removed from a cache via Remove, RemoveAll or RemoveIf,
+// expired because of WithExpiration or evicted because of WithMaxSize or WithMaxGroupSize. Injected caches don't call it, see WithPutListener.
+func WithRemoveListener(listener Listener[any]) ConfigOpt {
+	return func(config *Config) {
+		config.RemoveListener = listener
+	}
+}
+
+// WithGuildCache lets you inject your own Cache[discord.Guild].
+// The unready & unavailable guilds are still tracked in memory.
+func WithGuildCache(guildCache Cache[discord.Guild]) ConfigOpt {
+	return func(config *Config) {
+		config.GuildCache = guildCache
+	}
+}
+
+// WithChannelCache lets you inject your own Cache[discord.Channel].
+func WithChannelCache(channelCache Cache[discord.Channel]) ConfigOpt {
+	return func(config *Config) {
+		config.ChannelCache = channelCache
+	}
+}
+
+// WithStageInstanceCache lets you inject your own GroupedCache[discord.StageInstance].
+func WithStageInstanceCache(stageInstanceCache GroupedCache[discord.StageInstance]) ConfigOpt {
+	return func(config *Config) {
+		config.StageInstanceCache = stageInstanceCache
+	}
+}
+
+// WithGuildScheduledEventCache lets you inject your own GroupedCache[discord.GuildScheduledEvent].
+func WithGuildScheduledEventCache(guildScheduledEventCache GroupedCache[discord.GuildScheduledEvent]) ConfigOpt {
+	return func(config *Config) {
+		config.GuildScheduledEventCache = guildScheduledEventCache
+	}
+}
+
+// WithRoleCache lets you inject your own GroupedCache[discord.Role].
+func WithRoleCache(roleCache GroupedCache[discord.Role]) ConfigOpt {
+	return func(config *Config) {
+		config.RoleCache = roleCache
+	}
+}
+
+// WithMemberCache lets you inject your own GroupedCache[discord.Member].
+func WithMemberCache(memberCache GroupedCache[discord.Member]) ConfigOpt {
+	return func(config *Config) {
+		config.MemberCache = memberCache
+	}
+}
+
+// WithThreadMemberCache lets you inject your own GroupedCache[discord.ThreadMember].
+func WithThreadMemberCache(threadMemberCache GroupedCache[discord.ThreadMember]) ConfigOpt {
+	return func(config *Config) {
+		config.ThreadMemberCache = threadMemberCache
+	}
+}
+
+// WithPresenceCache lets you inject your own GroupedCache[discord.Presence].
+func WithPresenceCache(presenceCache GroupedCache[discord.Presence]) ConfigOpt {
+	return func(config *Config) {
+		config.PresenceCache = presenceCache
+	}
+}
+
+// WithVoiceStateCache lets you inject your own GroupedCache[discord.VoiceState].
+func WithVoiceStateCache(voiceStateCache GroupedCache[discord.VoiceState]) ConfigOpt {
+	return func(config *Config) {
+		config.VoiceStateCache = voiceStateCache
+	}
+}
+
+// WithMessageCache lets you inject your own GroupedCache[discord.Message].
+func WithMessageCache(messageCache GroupedCache[discord.Message]) ConfigOpt {
+	return func(config *Config) {
+		config.MessageCache = messageCache
+	}
+}
+
+// WithEmojiCache lets you inject your own GroupedCache[discord.Emoji].
+func WithEmojiCache(emojiCache GroupedCache[discord.Emoji]) ConfigOpt {
+	return func(config *Config) {
+		config.EmojiCache = emojiCache
+	}
+}
+
+// WithStickerCache lets you inject your own GroupedCache[discord.Sticker].
+func WithStickerCache(stickerCache GroupedCache[discord.Sticker]) ConfigOpt {
+	return func(config *Config) {
+		config.StickerCache = stickerCache
+	}
+}
+
+// WithBackend injects all caches of the given Backend, which replaces the whole storage layer of the Caches.
+func WithBackend(backend Backend) ConfigOpt {
+	return func(config *Config) {
+		config.GuildCache = backend.Guilds()
+		config.ChannelCache = backend.Channels()
+		config.StageInstanceCache = backend.StageInstances()
+		config.GuildScheduledEventCache = backend.GuildScheduledEvents()
+		config.RoleCache = backend.Roles()
+		config.MemberCache = backend.Members()
+		config.ThreadMemberCache = backend.ThreadMembers()
+		config.PresenceCache = backend.Presences()
+		config.VoiceStateCache = backend.VoiceStates()
+		config.MessageCache = backend.Messages()
+		config.EmojiCache = backend.Emojis()
+		config.StickerCache = backend.Stickers()
+	}
+}
+
+// WithStatsRecorder sets the StatsRecorder which receives the statistics of all caches.
+func WithStatsRecorder(statsRecorder StatsRecorder) ConfigOpt {
+	return func(config *Config) {
+		config.StatsRecorder = statsRecorder
+	}
+}
+
+// WithInvalidator sets the Invalidator used to keep the Caches of multiple processes consistent.
+// Every change is published to the other processes, which then drop the entity from their Caches.
+func WithInvalidator(invalidator Invalidator) ConfigOpt {
+	return func(config *Config) {
+		config.Invalidator = invalidator
+	}
+}
+
+// WithClock sets the clock.Clock the Caches use for time based checks like Caches.CheckSendPreconditions.
+func WithClock(clock clock.Clock) ConfigOpt {
+	return func(config *Config) {
+		config.Clock = clock
+	}
+}
@@ -1,6 +1,9 @@
 package cache
 
 import (
+	"time"
+
+	"github.com/disgoorg/disgo/clock"
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/snowflake/v2"
 	"golang.org/x/exp/slices"
@@ -35,6 +38,47 @@ func PolicyMembersInVoice(caches Caches) Policy[discord.Member] {
 	}
 }
 
+// PolicyMembersWithRoles returns a policy that will only cache members which have at least one of the given roles.
+// Without any roles given, it caches all members which have at least one role.
+func PolicyMembersWithRoles(roleIDs ...snowflake.ID) Policy[discord.Member] {
+	return func(member discord.Member) bool {
+		if len(roleIDs) == 0 {
+			return len(member.RoleIDs) > 0
+		}
+		for _, roleID := range member.RoleIDs {
+			if slices.Contains(roleIDs, roleID) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// PolicyMembersBots is a policy that will only cache members that are bots.
+func PolicyMembersBots(member discord.Member) bool {
+	return member.User.Bot
+}
+
+// PolicyMessagesInclude returns a policy that will only cache messages of the given channels.
+func PolicyMessagesInclude(channelIDs ...snowflake.ID) Policy[discord.Message] {
+	return func(message discord.Message) bool {
+		return slices.Contains(channelIDs, message.ChannelID)
+	}
+}
+
+// PolicyMessagesPinned is a policy that will only cache pinned messages.
+func PolicyMessagesPinned(message discord.Message) bool {
+	return message.Pinned
+}
+
+// PolicyMessagesNewerThan returns a policy that will only cache messages which were created within the given duration according to the clock.Clock.
+// It only checks the age when a message is put into the cache, use WithExpiration to also remove messages once they are too old.
+func PolicyMessagesNewerThan(clock clock.Clock, maxAge time.Duration) Policy[discord.Message] {
+	return func(message discord.Message) bool {
+		return clock.Now().Sub(message.CreatedAt) < maxAge
+	}
+}
+
 // PolicyChannelInclude returns a policy that will only cache channels of the given types.
 func PolicyChannelInclude(channelTypes ...discord.ChannelType) Policy[discord.Channel] {
 	return func(channel discord.Channel) bool {
@@ -49,6 +93,13 @@ func PolicyChannelExclude(channelTypes ...discord.ChannelType) Policy[discord.Ch
 	}
 }
 
+// PolicyGroupsInclude returns a GroupedPolicy that will only cache entities of the given groups, like members of the given guilds.
+func PolicyGroupsInclude[T any](groupIDs ...snowflake.ID) GroupedPolicy[T] {
+	return func(groupID snowflake.ID, _ T) bool {
+		return slices.Contains(groupIDs, groupID)
+	}
+}
+
 // Policy can be used to define your own policy for when entities should be cached.
 type Policy[T any] func(entity T) bool
 
@@ -66,7 +117,53 @@ func (p Policy[T]) And(policy Policy[T]) Policy[T] {
 	}
 }
 
+// GroupedPolicy can be used to define your own policy for when entities of a GroupedCache should be cached depending on their group.
+// It is checked in addition to the Policy of the GroupedCache.
+type GroupedPolicy[T any] func(groupID snowflake.ID, entity T) bool
+
+func groupedPolicyOf[T any](policy GroupedPolicy[any]) GroupedPolicy[T] {
+	if policy == nil {
+		return nil
+	}
+	return func(groupID snowflake.ID, entity T) bool {
+		return policy(groupID, entity)
+	}
+}
+
+// PolicyAllOf returns a policy that will only cache entities which pass all given policies. Without any policies given, it caches all entities.
+func PolicyAllOf[T any](policies ...Policy[T]) Policy[T] {
+	return func(entity T) bool {
+		for _, policy := range policies {
+			if !policy(entity) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// PolicyAnyOf returns a policy that will cache entities which pass at least one of the given policies. Without any policies given, it caches nothing.
+func PolicyAnyOf[T any](policies ...Policy[T]) Policy[T] {
+	return func(entity T) bool {
+		for _, policy := range policies {
+			if policy(entity) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// PolicyNot returns a policy that will only cache entities which don't pass the given policy.
+func PolicyNot[T any](policy Policy[T]) Policy[T] {
+	return func(entity T) bool {
+		return !policy(entity)
+	}
+}
+
 // AnyPolicy is a shorthand for CachePolicy.Or(CachePolicy).Or(CachePolicy) etc.
+//
+// Deprecated: use PolicyAnyOf instead, which doesn't return a nil Policy for an empty list.
 func AnyPolicy[T any](policies ...Policy[T]) Policy[T] {
 	var policy Policy[T]
 	for _, p := range policies {
@@ -80,6 +177,8 @@ func AnyPolicy[T any](policies ...Policy[T]) Policy[T] {
 }
 
 // AllPolicies is a shorthand for CachePolicy.And(CachePolicy).And(CachePolicy) etc.
+//
+// Deprecated: use PolicyAllOf instead, which doesn't return a nil Policy for an empty list.
 func AllPolicies[T any](policies ...Policy[T]) Policy[T] {
 	var policy Policy[T]
 	for _, p := range policies {
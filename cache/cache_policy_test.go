@@ -0,0 +1,34 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/clock"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyCombinators(t *testing.T) {
+	policy := PolicyAllOf(
+		PolicyAnyOf(PolicyMembersWithRoles(1), PolicyMembersPending),
+		PolicyNot[discord.Member](PolicyMembersBots),
+	)
+
+	assert.True(t, policy(discord.Member{RoleIDs: []snowflake.ID{2, 1}}))
+	assert.True(t, policy(discord.Member{Pending: true}))
+	assert.False(t, policy(discord.Member{RoleIDs: []snowflake.ID{2}}))
+	assert.False(t, policy(discord.Member{RoleIDs: []snowflake.ID{1}, User: discord.User{Bot: true}}))
+
+	assert.True(t, PolicyAllOf[discord.Member]()(discord.Member{}))
+	assert.False(t, PolicyAnyOf[discord.Member]()(discord.Member{}))
+}
+
+func TestPolicyMessagesNewerThan(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	policy := PolicyMessagesNewerThan(clock.NewMock(now), time.Hour)
+
+	assert.True(t, policy(discord.Message{CreatedAt: now.Add(-time.Hour + time.Second)}))
+	assert.False(t, policy(discord.Message{CreatedAt: now.Add(-time.Hour)}))
+}
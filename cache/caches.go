@@ -2,6 +2,7 @@ package cache
 
 import (
 	"sync"
+	"sync/atomic"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/snowflake/v2"
@@ -9,9 +10,28 @@ import (
 
 // Caches combines all different entity caches into one with some utility methods.
 type Caches interface {
-	// CacheFlags returns the current configured FLags of the caches.
+	Snapshotter
+
+	// CacheFlags returns the currently enabled Flags of the caches.
 	CacheFlags() Flags
 
+	// SetFlags replaces the enabled Flags of the caches. This takes effect for all following puts.
+	// Entities already in the caches of disabled Flags are kept until PurgeDisabled is called.
+	// Enabling Flags doesn't fill the caches with the entities missed while they were disabled, and the required gateway.Intents must have been set when building the client.
+	SetFlags(flags Flags)
+
+	// EnableFlags enables the given Flags in addition to the current ones, see SetFlags.
+	EnableFlags(flags ...Flags)
+
+	// DisableFlags disables the given Flags, see SetFlags.
+	DisableFlags(flags ...Flags)
+
+	// PurgeDisabled removes all entities from the caches whose Flags are disabled, for example to free memory after disabling FlagPresences.
+	PurgeDisabled()
+
+	// GuildCreateFlags returns which nested entities of a gateway.EventTypeGuildCreate event should be cached.
+	GuildCreateFlags() GuildCreateFlags
+
 	// GetMemberPermissions returns the calculated permissions of the given member.
 	// This requires the FlagRoles to be set.
 	GetMemberPermissions(member discord.Member) discord.Permissions
@@ -24,6 +44,36 @@ type Caches interface {
 	// This requires the FlagRoles to be set.
 	MemberRoles(member discord.Member) []discord.Role
 
+	// IsChannelSyncedWithCategory returns whether the PermissionOverwrites of the given channel match the ones of its parent category.
+	// Channels without a parent or an uncached parent are never synced.
+	// This requires the FlagChannels to be set.
+	IsChannelSyncedWithCategory(channel discord.GuildChannel) bool
+
+	// CheckSendPreconditions checks whether the given member can send a message in the given channel and returns a *SendPreconditionError if not.
+	// It checks timeouts, pending membership screening, channel permissions, the guild verification level & slowmode, so bots can inform users instead of hitting opaque 403s.
+	// Slowmode is checked against the cached messages of the member in the channel and is skipped for bots, as Discord exempts them from it.
+	// This requires the FlagRoles, FlagChannels and FlagGuilds to be set.
+	CheckSendPreconditions(channel discord.GuildMessageChannel, member discord.Member) error
+
+	// GuildMemberCount returns the approximate GuildMemberCount of the given guild and a bool indicating if it is tracked.
+	GuildMemberCount(guildID snowflake.ID) (GuildMemberCount, bool)
+
+	// PutGuildMemberCount sets the GuildMemberCount of the given guild.
+	PutGuildMemberCount(guildID snowflake.ID, count GuildMemberCount)
+
+	// AddGuildMemberCount adds the given delta to the member count of the given guild if it is tracked.
+	AddGuildMemberCount(guildID snowflake.ID, delta int)
+
+	// RemoveGuildMemberCount stops tracking the GuildMemberCount of the given guild.
+	RemoveGuildMemberCount(guildID snowflake.ID)
+
+	// Stats returns the Stats of all caches by their name.
+	Stats() map[string]Stats
+
+	// SizeBytes returns the approximate memory used by the entities of all caches by their name, using the SizeFunc(s) configured with WithSizeEstimator.
+	// It iterates all cached entities, so it should only be called periodically, for example to export it as metric.
+	SizeBytes() map[string]int
+
 	// AudioChannelMembers returns all members which are in the given audio channel.
 	// This requires the FlagVoiceStates to be set.
 	AudioChannelMembers(channel discord.GuildAudioChannel) []discord.Member
@@ -58,10 +108,10 @@ type Caches interface {
 	Messages() GroupedCache[discord.Message]
 
 	// Emojis returns the emoji cache.
-	Emojis() GroupedCache[discord.Emoji]
+	Emojis() EmojiCache
 
 	// Stickers returns the sticker cache.
-	Stickers() GroupedCache[discord.Sticker]
+	Stickers() StickerCache
 
 	// Guilds returns the guild cache.
 	Guilds() GuildCache
@@ -81,27 +131,149 @@ func New(opts ...ConfigOpt) Caches {
 	config := DefaultConfig()
 	config.Apply(opts)
 
-	return &cachesImpl{
-		config: *config,
-
-		guildCache:               NewGuildCache(config.CacheFlags, config.GuildCachePolicy),
-		channelCache:             NewChannelCache(config.CacheFlags, config.ChannelCachePolicy),
-		stageInstanceCache:       NewGroupedCache[discord.StageInstance](config.CacheFlags, FlagStageInstances, config.StageInstanceCachePolicy),
-		guildScheduledEventCache: NewGroupedCache[discord.GuildScheduledEvent](config.CacheFlags, FlagGuildScheduledEvents, config.GuildScheduledEventCachePolicy),
-		roleCache:                NewGroupedCache[discord.Role](config.CacheFlags, FlagRoles, config.RoleCachePolicy),
-		memberCache:              NewGroupedCache[discord.Member](config.CacheFlags, FlagMembers, config.MemberCachePolicy),
-		threadMemberCache:        NewGroupedCache[discord.ThreadMember](config.CacheFlags, FlagThreadMembers, config.ThreadMemberCachePolicy),
-		presenceCache:            NewGroupedCache[discord.Presence](config.CacheFlags, FlagPresences, config.PresenceCachePolicy),
-		voiceStateCache:          NewGroupedCache[discord.VoiceState](config.CacheFlags, FlagVoiceStates, config.VoiceStateCachePolicy),
-		messageCache:             NewGroupedCache[discord.Message](config.CacheFlags, FlagMessages, config.MessageCachePolicy),
-		emojiCache:               NewGroupedCache[discord.Emoji](config.CacheFlags, FlagEmojis, config.EmojiCachePolicy),
-		stickerCache:             NewGroupedCache[discord.Sticker](config.CacheFlags, FlagStickers, config.StickerCachePolicy),
+	c := &cachesImpl{
+		config:            *config,
+		stats:             map[string]*cacheStats{},
+		guildMemberCounts: map[snowflake.ID]GuildMemberCount{},
+		invalidators:      map[string]func(invalidation Invalidation){},
+		sizers:            map[string]func() int{},
+	}
+	c.flags.Store(config.CacheFlags)
+
+	c.guildCache = newGuildCache(newCache(c, "guilds", config.GuildCache, FlagGuilds, config.GuildCachePolicy))
+	c.channelCache = &channelCacheImpl{Cache: newCache(c, "channels", config.ChannelCache, FlagChannels, config.ChannelCachePolicy)}
+	c.stageInstanceCache = newGroupedCache(c, "stage_instances", config.StageInstanceCache, FlagStageInstances, config.StageInstanceCachePolicy)
+	c.guildScheduledEventCache = newGroupedCache(c, "guild_scheduled_events", config.GuildScheduledEventCache, FlagGuildScheduledEvents, config.GuildScheduledEventCachePolicy)
+	c.roleCache = newGroupedCache(c, "roles", config.RoleCache, FlagRoles, config.RoleCachePolicy)
+	c.memberCache = newGroupedCache(c, "members", config.MemberCache, FlagMembers, config.MemberCachePolicy)
+	c.threadMemberCache = newGroupedCache(c, "thread_members", config.ThreadMemberCache, FlagThreadMembers, config.ThreadMemberCachePolicy)
+	c.presenceCache = newGroupedCache(c, "presences", config.PresenceCache, FlagPresences, config.PresenceCachePolicy)
+	c.voiceStateCache = newGroupedCache(c, "voice_states", config.VoiceStateCache, FlagVoiceStates, config.VoiceStateCachePolicy)
+	c.messageCache = newGroupedCache(c, "messages", config.MessageCache, FlagMessages, config.MessageCachePolicy)
+	c.emojiCache = &emojiCacheImpl{GroupedCache: newGroupedCache(c, "emojis", config.EmojiCache, FlagEmojis, config.EmojiCachePolicy)}
+	c.stickerCache = &stickerCacheImpl{GroupedCache: newGroupedCache(c, "stickers", config.StickerCache, FlagStickers, config.StickerCachePolicy)}
+
+	if config.Invalidator != nil {
+		config.Invalidator.Subscribe(c.invalidate)
+	}
+	return c
+}
+
+// newCache returns the given Cache or a new one limited to the Config.MaxSize which calls the listeners of the Config if it is nil.
+// The returned Cache records its statistics under the given name.
+func newCache[T any](c *cachesImpl, name string, cache Cache[T], neededFlags Flags, policy Policy[T]) Cache[T] {
+	if cache == nil {
+		// the flags are checked by the flagsCache, so they can be changed at runtime
+		defaultCache := newLRUCache[T](FlagsNone, FlagsNone, policy, c.config.MaxSize, evictFuncOf[T](c.config.EvictFunc))
+		defaultCache.putListener = listenerOf[T](c.config.PutListener)
+		defaultCache.removeListener = listenerOf[T](c.config.RemoveListener)
+		cache = defaultCache
+	}
+
+	newCacheSizer[T](c, name, neededFlags, cache.Iter())
+
+	c.purgers = append(c.purgers, flagsPurger{
+		neededFlags: neededFlags,
+		purge: func() {
+			cache.RemoveIf(func(T) bool { return true })
+		},
+	})
+
+	var wrapped Cache[T] = cache
+	if c.config.Invalidator != nil {
+		// invalidations of other processes are applied to the unwrapped cache, so they are not published again
+		c.invalidators[name] = func(invalidation Invalidation) {
+			for _, id := range invalidation.EntityIDs() {
+				cache.Remove(id)
+			}
+		}
+		wrapped = &invalidatingCache[T]{
+			Cache:       cache,
+			name:        name,
+			invalidator: c.config.Invalidator,
+		}
+	}
+	return &statsCache[T]{
+		Cache: &flagsCache[T]{
+			Cache:       wrapped,
+			caches:      c,
+			neededFlags: neededFlags,
+		},
+		stats: c.newStats(name),
+	}
+}
+
+// newGroupedCache returns the given GroupedCache or a new one limited to the Config.MaxGroupSize which calls the listeners of the Config if it is nil.
+// The returned GroupedCache records its statistics under the given name.
+func newGroupedCache[T any](c *cachesImpl, name string, groupedCache GroupedCache[T], neededFlags Flags, policy Policy[T]) GroupedCache[T] {
+	if groupedCache == nil {
+		// the flags are checked by the flagsGroupedCache, so they can be changed at runtime
+		defaultCache := newDefaultGroupedCache[T](FlagsNone, FlagsNone, policy, c.config.MaxGroupSize, evictFuncOf[T](c.config.EvictFunc), groupedCacheStripes)
+		defaultCache.putListener = listenerOf[T](c.config.PutListener)
+		defaultCache.removeListener = listenerOf[T](c.config.RemoveListener)
+		defaultCache.groupedPolicy = groupedPolicyOf[T](c.config.GroupedCachePolicy)
+		groupedCache = defaultCache
+		for _, expiration := range c.config.Expirations {
+			if expiration.Flags.Has(neededFlags) {
+				groupedCache = NewExpiringGroupedCache[T](groupedCache, expiration.TTL, expiration.Mode, c.config.Clock)
+				break
+			}
+		}
+	}
+	for _, index := range c.config.Indexes {
+		if index.Flags.Has(neededFlags) {
+			groupedCache.AddIndex(index.Name, indexFuncOf[T](index.KeyFunc))
+		}
+	}
+	sized := groupedCache
+	newCacheSizer[T](c, name, neededFlags, func(yield func(id snowflake.ID, entity T) bool) {
+		sized.Iter()(func(_ snowflake.ID, entity T) bool {
+			return yield(0, entity)
+		})
+	})
+	purged := groupedCache
+	c.purgers = append(c.purgers, flagsPurger{
+		neededFlags: neededFlags,
+		purge: func() {
+			for _, groupID := range purged.GroupIDs() {
+				purged.RemoveAll(groupID)
+			}
+		},
+	})
+	if c.config.Invalidator != nil {
+		unwrapped := groupedCache
+		c.invalidators[name] = func(invalidation Invalidation) {
+			ids := invalidation.EntityIDs()
+			if len(ids) == 0 {
+				unwrapped.RemoveAll(invalidation.GroupID)
+				return
+			}
+			unwrapped.RemoveMany(invalidation.GroupID, ids)
+		}
+		groupedCache = &invalidatingGroupedCache[T]{
+			GroupedCache: groupedCache,
+			name:         name,
+			invalidator:  c.config.Invalidator,
+		}
+	}
+	return &statsGroupedCache[T]{
+		GroupedCache: &flagsGroupedCache[T]{
+			GroupedCache: groupedCache,
+			caches:       c,
+			neededFlags:  neededFlags,
+		},
+		stats: c.newStats(name),
 	}
 }
 
 type cachesImpl struct {
 	config Config
 
+	// flags holds the currently enabled Flags, flagsMu serializes their updates
+	flags   atomic.Value
+	flagsMu sync.Mutex
+	purgers []flagsPurger
+
 	selfUser   *discord.OAuth2User
 	selfUserMu sync.Mutex
 
@@ -115,12 +287,21 @@ type cachesImpl struct {
 	presenceCache            GroupedCache[discord.Presence]
 	voiceStateCache          GroupedCache[discord.VoiceState]
 	messageCache             GroupedCache[discord.Message]
-	emojiCache               GroupedCache[discord.Emoji]
-	stickerCache             GroupedCache[discord.Sticker]
+	emojiCache               EmojiCache
+	stickerCache             StickerCache
+
+	guildMemberCountsMu sync.RWMutex
+	guildMemberCounts   map[snowflake.ID]GuildMemberCount
+
+	stats map[string]*cacheStats
+
+	invalidators map[string]func(invalidation Invalidation)
+
+	sizers map[string]func() int
 }
 
-func (c *cachesImpl) CacheFlags() Flags {
-	return c.config.CacheFlags
+func (c *cachesImpl) GuildCreateFlags() GuildCreateFlags {
+	return c.config.GuildCreateFlags
 }
 
 func (c *cachesImpl) GetMemberPermissions(member discord.Member) discord.Permissions {
@@ -203,6 +384,17 @@ func (c *cachesImpl) MemberRoles(member discord.Member) []discord.Role {
 	})
 }
 
+func (c *cachesImpl) IsChannelSyncedWithCategory(channel discord.GuildChannel) bool {
+	if _, ok := channel.(discord.GuildThread); ok || channel.ParentID() == nil {
+		return false
+	}
+	parent, ok := c.Channels().GetGuildCategoryChannel(*channel.ParentID())
+	if !ok {
+		return false
+	}
+	return channel.PermissionOverwrites().Equal(parent.PermissionOverwrites())
+}
+
 func (c *cachesImpl) AudioChannelMembers(channel discord.GuildAudioChannel) []discord.Member {
 	var members []discord.Member
 	c.VoiceStates().GroupForEach(channel.GuildID(), func(state discord.VoiceState) {
@@ -262,11 +454,11 @@ func (c *cachesImpl) Messages() GroupedCache[discord.Message] {
 	return c.messageCache
 }
 
-func (c *cachesImpl) Emojis() GroupedCache[discord.Emoji] {
+func (c *cachesImpl) Emojis() EmojiCache {
 	return c.emojiCache
 }
 
-func (c *cachesImpl) Stickers() GroupedCache[discord.Sticker] {
+func (c *cachesImpl) Stickers() StickerCache {
 	return c.stickerCache
 }
 
@@ -12,6 +12,9 @@ type ChannelCache interface {
 	// GuildChannels returns all discord.GuildChannel in a guild and a bool indicating if it exists.
 	GuildChannels(guildID snowflake.ID) []discord.GuildChannel
 
+	// GuildChannelTree returns the discord.ChannelTree of all cached channels in a guild.
+	GuildChannelTree(guildID snowflake.ID) discord.ChannelTree
+
 	// GuildThreadsInChannel returns all discord.GuildThread from the ChannelCache and a bool indicating if it exists.
 	GuildThreadsInChannel(channelID snowflake.ID) []discord.GuildThread
 
@@ -84,6 +87,10 @@ func (c *channelCacheImpl) GuildChannels(guildID snowflake.ID) []discord.GuildCh
 	return guildChannels
 }
 
+func (c *channelCacheImpl) GuildChannelTree(guildID snowflake.ID) discord.ChannelTree {
+	return discord.NewChannelTree(c.GuildChannels(guildID))
+}
+
 func (c *channelCacheImpl) GuildThreadsInChannel(channelID snowflake.ID) []discord.GuildThread {
 	channels := c.FindAll(func(channel discord.Channel) bool {
 		if thread, ok := channel.(discord.GuildThread); ok {
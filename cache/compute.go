@@ -0,0 +1,31 @@
+package cache
+
+import (
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// CompareAndSwap replaces the entity with the given snowflake with the new entity if the present entity is equal to old according to the equal func.
+// It returns whether the entity was swapped. Like Cache.Compute the comparison and swap happen atomically.
+func CompareAndSwap[T any](cache Cache[T], id snowflake.ID, old T, new T, equal func(a T, b T) bool) bool {
+	var swapped bool
+	_, ok := cache.Compute(id, func(entity T, ok bool) (T, bool) {
+		if swapped = ok && equal(entity, old); swapped {
+			return new, true
+		}
+		return entity, ok
+	})
+	return swapped && ok
+}
+
+// GroupedCompareAndSwap replaces the entity with the given groupID and ID with the new entity if the present entity is equal to old according to the equal func.
+// It returns whether the entity was swapped. Like GroupedCache.Compute the comparison and swap happen atomically.
+func GroupedCompareAndSwap[T any](cache GroupedCache[T], groupID snowflake.ID, id snowflake.ID, old T, new T, equal func(a T, b T) bool) bool {
+	var swapped bool
+	_, ok := cache.Compute(groupID, id, func(entity T, ok bool) (T, bool) {
+		if swapped = ok && equal(entity, old); swapped {
+			return new, true
+		}
+		return entity, ok
+	})
+	return swapped && ok
+}
@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupedCache_Compute(t *testing.T) {
+	c := NewGroupedCache[[]int](FlagsAll, FlagsNone, nil, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c.Compute(1, 1, func(entity []int, _ bool) ([]int, bool) {
+				return append(append([]int(nil), entity...), i), true
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	entity, ok := c.Get(1, 1)
+	assert.True(t, ok)
+	assert.Len(t, entity, 100)
+
+	_, ok = c.Compute(1, 1, func(entity []int, ok bool) ([]int, bool) {
+		return nil, false
+	})
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.GroupLen(1))
+}
+
+func TestCompareAndSwap(t *testing.T) {
+	c := NewCache[int](FlagsAll, FlagsNone, func(entity int) bool { return entity > 0 })
+	equal := func(a int, b int) bool { return a == b }
+
+	assert.False(t, CompareAndSwap[int](c, 1, 0, 1, equal))
+	c.Put(1, 1)
+	assert.False(t, CompareAndSwap[int](c, 1, 2, 3, equal))
+	assert.True(t, CompareAndSwap[int](c, 1, 1, 2, equal))
+	assert.False(t, CompareAndSwap[int](c, 1, 2, -1, equal))
+
+	entity, _ := c.Get(1)
+	assert.Equal(t, 2, entity)
+
+	g := NewGroupedCache[int](FlagsAll, FlagsNone, nil, nil)
+	g.Put(1, 1, 1)
+	assert.True(t, GroupedCompareAndSwap[int](g, snowflake.ID(1), 1, 1, 2, equal))
+}
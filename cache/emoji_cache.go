@@ -0,0 +1,32 @@
+package cache
+
+import (
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// EmojiCache is a GroupedCache for discord.Emoji(s) grouped by their guild.
+type EmojiCache interface {
+	GroupedCache[discord.Emoji]
+
+	// FindByName returns the first discord.Emoji with the given name in the guild and a bool indicating if it exists.
+	FindByName(guildID snowflake.ID, name string) (discord.Emoji, bool)
+}
+
+// NewEmojiCache returns a new emojiCacheImpl with the given flags and policy.
+// emojiCacheImpl is thread safe and can be used in multiple goroutines.
+func NewEmojiCache(flags Flags, policy Policy[discord.Emoji]) EmojiCache {
+	return &emojiCacheImpl{
+		GroupedCache: NewGroupedCache[discord.Emoji](flags, FlagEmojis, policy),
+	}
+}
+
+type emojiCacheImpl struct {
+	GroupedCache[discord.Emoji]
+}
+
+func (c *emojiCacheImpl) FindByName(guildID snowflake.ID, name string) (discord.Emoji, bool) {
+	return c.GroupFindFirst(guildID, func(_ snowflake.ID, emoji discord.Emoji) bool {
+		return emoji.Name == name
+	})
+}
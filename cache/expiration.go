@@ -0,0 +1,424 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/clock"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// ExpirationMode defines when the expiry of a cached entity is set.
+type ExpirationMode int
+
+const (
+	// ExpirationAbsolute expires entities the TTL after they were last put into the cache.
+	ExpirationAbsolute ExpirationMode = iota
+	// ExpirationSliding expires entities the TTL after they were last put into the cache or returned by Get.
+	// This only keeps entities the bot actually interacts with.
+	ExpirationSliding
+)
+
+// Expiration configures after which TTL the entities of the grouped caches matching the Flags expire.
+type Expiration struct {
+	Flags Flags
+	TTL   time.Duration
+	Mode  ExpirationMode
+}
+
+var _ GroupedCache[any] = (*expiringGroupedCache[any])(nil)
+
+// NewExpiringGroupedCache wraps the given GroupedCache so its entities expire after the TTL according to the ExpirationMode.
+// Expired entities are removed from the wrapped GroupedCache lazily on the next access of the returned GroupedCache.
+func NewExpiringGroupedCache[T any](groupedCache GroupedCache[T], ttl time.Duration, mode ExpirationMode, clock clock.Clock) GroupedCache[T] {
+	return &expiringGroupedCache[T]{
+		GroupedCache: groupedCache,
+		ttl:          ttl,
+		mode:         mode,
+		clock:        clock,
+		order:        list.New(),
+		elements:     map[snowflake.ID]map[snowflake.ID]*list.Element{},
+		pending:      map[snowflake.ID]map[snowflake.ID]int{},
+	}
+}
+
+// allowedChecker is implemented by the default GroupedCache, so the expiringGroupedCache only tracks entities which pass its policies.
+type allowedChecker[T any] interface {
+	allowed(groupID snowflake.ID, entity T) bool
+}
+
+type expiringEntry struct {
+	groupID   snowflake.ID
+	id        snowflake.ID
+	expiresAt time.Time
+}
+
+// expiringGroupedCache tracks the expiry of all entities in a list ordered by expiry.
+// As the TTL is the same for all entities, putting or refreshing an entity always moves it to the back of the list.
+//
+// Entities are marked as pending while they are stored in the wrapped GroupedCache and until they are tracked.
+// expire removes entities via Compute and checks their expiry and pending state again while the wrapped GroupedCache is locked, so it never removes an entity which was just put again.
+type expiringGroupedCache[T any] struct {
+	GroupedCache[T]
+	ttl   time.Duration
+	mode  ExpirationMode
+	clock clock.Clock
+
+	mu       sync.Mutex
+	order    *list.List
+	elements map[snowflake.ID]map[snowflake.ID]*list.Element
+	pending  map[snowflake.ID]map[snowflake.ID]int
+}
+
+// allowed returns whether the wrapped GroupedCache stores the entity.
+func (c *expiringGroupedCache[T]) allowed(groupID snowflake.ID, entity T) bool {
+	if checker, ok := c.GroupedCache.(allowedChecker[T]); ok {
+		return checker.allowed(groupID, entity)
+	}
+	return true
+}
+
+// beginStore marks the entities as pending until endStore is called.
+func (c *expiringGroupedCache[T]) beginStore(groupID snowflake.ID, ids ...snowflake.ID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	groupPending, ok := c.pending[groupID]
+	if !ok {
+		groupPending = map[snowflake.ID]int{}
+		c.pending[groupID] = groupPending
+	}
+	for _, id := range ids {
+		groupPending[id]++
+	}
+}
+
+// endStore unmarks the pending entities and sets the expiry of the stored ones to now plus the TTL.
+func (c *expiringGroupedCache[T]) endStore(groupID snowflake.ID, ids []snowflake.ID, stored []snowflake.ID) {
+	expiresAt := c.clock.Now().Add(c.ttl)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	groupPending := c.pending[groupID]
+	for _, id := range ids {
+		if groupPending[id]--; groupPending[id] <= 0 {
+			delete(groupPending, id)
+		}
+	}
+	if len(groupPending) == 0 {
+		delete(c.pending, groupID)
+	}
+
+	if len(stored) == 0 {
+		return
+	}
+	groupElements, ok := c.elements[groupID]
+	if !ok {
+		groupElements = map[snowflake.ID]*list.Element{}
+		c.elements[groupID] = groupElements
+	}
+	for _, id := range stored {
+		if element, ok := groupElements[id]; ok {
+			element.Value.(*expiringEntry).expiresAt = expiresAt
+			c.order.MoveToBack(element)
+			continue
+		}
+		groupElements[id] = c.order.PushBack(&expiringEntry{groupID: groupID, id: id, expiresAt: expiresAt})
+	}
+}
+
+// refresh sets the expiry of the entity to now plus the TTL if it is still tracked.
+func (c *expiringGroupedCache[T]) refresh(groupID snowflake.ID, id snowflake.ID) {
+	expiresAt := c.clock.Now().Add(c.ttl)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.elements[groupID][id]; ok {
+		element.Value.(*expiringEntry).expiresAt = expiresAt
+		c.order.MoveToBack(element)
+	}
+}
+
+func (c *expiringGroupedCache[T]) untrack(groupID snowflake.ID, ids ...snowflake.ID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.untrackLocked(groupID, ids...)
+}
+
+func (c *expiringGroupedCache[T]) untrackLocked(groupID snowflake.ID, ids ...snowflake.ID) {
+	groupElements := c.elements[groupID]
+	for _, id := range ids {
+		if element, ok := groupElements[id]; ok {
+			c.order.Remove(element)
+			delete(groupElements, id)
+		}
+	}
+	if len(groupElements) == 0 {
+		delete(c.elements, groupID)
+	}
+}
+
+// untrackExpired untracks the entry if it is still expired at now and not pending and returns whether it did.
+func (c *expiringGroupedCache[T]) untrackExpired(entry *expiringEntry, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.elements[entry.groupID][entry.id]
+	if !ok || element.Value != entry || now.Before(entry.expiresAt) || c.pending[entry.groupID][entry.id] > 0 {
+		return false
+	}
+	c.untrackLocked(entry.groupID, entry.id)
+	return true
+}
+
+// expire removes all expired entities from the wrapped GroupedCache.
+func (c *expiringGroupedCache[T]) expire() {
+	now := c.clock.Now()
+	var expired []*expiringEntry
+	c.mu.Lock()
+	for element := c.order.Front(); element != nil; element = element.Next() {
+		entry := element.Value.(*expiringEntry)
+		if now.Before(entry.expiresAt) {
+			break
+		}
+		expired = append(expired, entry)
+	}
+	c.mu.Unlock()
+
+	for _, entry := range expired {
+		c.GroupedCache.Compute(entry.groupID, entry.id, func(entity T, exists bool) (T, bool) {
+			// the entity might have been put or refreshed since it was collected
+			if !c.untrackExpired(entry, now) {
+				return entity, exists
+			}
+			return entity, false
+		})
+	}
+}
+
+// removeIf removes the entities of the group which pass the filterFunc via Compute, so they are untracked atomically with their removal.
+func (c *expiringGroupedCache[T]) removeIf(groupID snowflake.ID, filterFunc GroupedFilterFunc[T]) {
+	var ids []snowflake.ID
+	c.GroupedCache.IterGroup(groupID)(func(id snowflake.ID, entity T) bool {
+		if filterFunc(groupID, entity) {
+			ids = append(ids, id)
+		}
+		return true
+	})
+	for _, id := range ids {
+		id := id
+		c.GroupedCache.Compute(groupID, id, func(entity T, exists bool) (T, bool) {
+			if !exists || !filterFunc(groupID, entity) {
+				return entity, exists
+			}
+			c.untrack(groupID, id)
+			return entity, false
+		})
+	}
+}
+
+func (c *expiringGroupedCache[T]) Get(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	c.expire()
+	entity, ok := c.GroupedCache.Get(groupID, id)
+	if ok && c.mode == ExpirationSliding {
+		c.refresh(groupID, id)
+	}
+	return entity, ok
+}
+
+func (c *expiringGroupedCache[T]) Put(groupID snowflake.ID, id snowflake.ID, entity T) {
+	c.expire()
+	var stored []snowflake.ID
+	if c.allowed(groupID, entity) {
+		stored = []snowflake.ID{id}
+	}
+	c.beginStore(groupID, id)
+	c.GroupedCache.Put(groupID, id, entity)
+	c.endStore(groupID, []snowflake.ID{id}, stored)
+}
+
+func (c *expiringGroupedCache[T]) GetOrPut(groupID snowflake.ID, id snowflake.ID, supplier func() T) (T, bool) {
+	c.expire()
+	var stored []snowflake.ID
+	c.beginStore(groupID, id)
+	entity, ok := c.GroupedCache.GetOrPut(groupID, id, func() T {
+		entity := supplier()
+		if c.allowed(groupID, entity) {
+			stored = []snowflake.ID{id}
+		}
+		return entity
+	})
+	c.endStore(groupID, []snowflake.ID{id}, stored)
+	if ok && c.mode == ExpirationSliding {
+		c.refresh(groupID, id)
+	}
+	return entity, ok
+}
+
+func (c *expiringGroupedCache[T]) PutIfAbsent(groupID snowflake.ID, id snowflake.ID, entity T) bool {
+	c.expire()
+	var stored []snowflake.ID
+	c.beginStore(groupID, id)
+	ok := c.GroupedCache.PutIfAbsent(groupID, id, entity)
+	if ok {
+		stored = []snowflake.ID{id}
+	}
+	c.endStore(groupID, []snowflake.ID{id}, stored)
+	return ok
+}
+
+func (c *expiringGroupedCache[T]) Compute(groupID snowflake.ID, id snowflake.ID, computeFunc ComputeFunc[T]) (T, bool) {
+	c.expire()
+	var stored []snowflake.ID
+	c.beginStore(groupID, id)
+	entity, ok := c.GroupedCache.Compute(groupID, id, func(old T, exists bool) (T, bool) {
+		entity, ok := computeFunc(old, exists)
+		if !ok {
+			c.untrack(groupID, id)
+		}
+		return entity, ok
+	})
+	if ok {
+		stored = []snowflake.ID{id}
+	}
+	c.endStore(groupID, []snowflake.ID{id}, stored)
+	return entity, ok
+}
+
+func (c *expiringGroupedCache[T]) PutAll(groupID snowflake.ID, entities map[snowflake.ID]T) {
+	c.expire()
+	ids := make([]snowflake.ID, 0, len(entities))
+	stored := make([]snowflake.ID, 0, len(entities))
+	for id, entity := range entities {
+		ids = append(ids, id)
+		if c.allowed(groupID, entity) {
+			stored = append(stored, id)
+		}
+	}
+	c.beginStore(groupID, ids...)
+	c.GroupedCache.PutAll(groupID, entities)
+	c.endStore(groupID, ids, stored)
+}
+
+func (c *expiringGroupedCache[T]) Remove(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	c.expire()
+	c.untrack(groupID, id)
+	return c.GroupedCache.Remove(groupID, id)
+}
+
+func (c *expiringGroupedCache[T]) RemoveMany(groupID snowflake.ID, ids []snowflake.ID) {
+	c.expire()
+	c.untrack(groupID, ids...)
+	c.GroupedCache.RemoveMany(groupID, ids)
+}
+
+func (c *expiringGroupedCache[T]) RemoveAll(groupID snowflake.ID) {
+	c.expire()
+	c.mu.Lock()
+	for _, element := range c.elements[groupID] {
+		c.order.Remove(element)
+	}
+	delete(c.elements, groupID)
+	c.mu.Unlock()
+	c.GroupedCache.RemoveAll(groupID)
+}
+
+func (c *expiringGroupedCache[T]) RemoveIf(filterFunc GroupedFilterFunc[T]) {
+	c.expire()
+	for _, groupID := range c.GroupedCache.GroupIDs() {
+		c.removeIf(groupID, filterFunc)
+	}
+}
+
+func (c *expiringGroupedCache[T]) GroupRemoveIf(groupID snowflake.ID, filterFunc GroupedFilterFunc[T]) {
+	c.expire()
+	c.removeIf(groupID, filterFunc)
+}
+
+func (c *expiringGroupedCache[T]) Len() int {
+	c.expire()
+	return c.GroupedCache.Len()
+}
+
+func (c *expiringGroupedCache[T]) GroupLen(groupID snowflake.ID) int {
+	c.expire()
+	return c.GroupedCache.GroupLen(groupID)
+}
+
+func (c *expiringGroupedCache[T]) GroupIDs() []snowflake.ID {
+	c.expire()
+	return c.GroupedCache.GroupIDs()
+}
+
+func (c *expiringGroupedCache[T]) All() map[snowflake.ID][]T {
+	c.expire()
+	return c.GroupedCache.All()
+}
+
+func (c *expiringGroupedCache[T]) GroupAll(groupID snowflake.ID) []T {
+	c.expire()
+	return c.GroupedCache.GroupAll(groupID)
+}
+
+func (c *expiringGroupedCache[T]) MapAll() map[snowflake.ID]map[snowflake.ID]T {
+	c.expire()
+	return c.GroupedCache.MapAll()
+}
+
+func (c *expiringGroupedCache[T]) MapGroupAll(groupID snowflake.ID) map[snowflake.ID]T {
+	c.expire()
+	return c.GroupedCache.MapGroupAll(groupID)
+}
+
+func (c *expiringGroupedCache[T]) FindFirst(cacheFindFunc GroupedFilterFunc[T]) (T, bool) {
+	c.expire()
+	return c.GroupedCache.FindFirst(cacheFindFunc)
+}
+
+func (c *expiringGroupedCache[T]) GroupFindFirst(groupID snowflake.ID, cacheFindFunc GroupedFilterFunc[T]) (T, bool) {
+	c.expire()
+	return c.GroupedCache.GroupFindFirst(groupID, cacheFindFunc)
+}
+
+func (c *expiringGroupedCache[T]) FindAll(cacheFindFunc GroupedFilterFunc[T]) []T {
+	c.expire()
+	return c.GroupedCache.FindAll(cacheFindFunc)
+}
+
+func (c *expiringGroupedCache[T]) GroupFindAll(groupID snowflake.ID, cacheFindFunc GroupedFilterFunc[T]) []T {
+	c.expire()
+	return c.GroupedCache.GroupFindAll(groupID, cacheFindFunc)
+}
+
+func (c *expiringGroupedCache[T]) ForEach(forEachFunc func(groupID snowflake.ID, entity T)) {
+	c.expire()
+	c.GroupedCache.ForEach(forEachFunc)
+}
+
+func (c *expiringGroupedCache[T]) GroupForEach(groupID snowflake.ID, forEachFunc func(entity T)) {
+	c.expire()
+	c.GroupedCache.GroupForEach(groupID, forEachFunc)
+}
+
+// GetByIndex doesn't know the ID of the returned entity, so it doesn't refresh the expiry in ExpirationSliding mode.
+func (c *expiringGroupedCache[T]) GetByIndex(groupID snowflake.ID, index string, key string) (T, bool) {
+	c.expire()
+	return c.GroupedCache.GetByIndex(groupID, index, key)
+}
+
+func (c *expiringGroupedCache[T]) Iter() func(yield func(groupID snowflake.ID, entity T) bool) {
+	c.expire()
+	return c.GroupedCache.Iter()
+}
+
+func (c *expiringGroupedCache[T]) IterGroup(groupID snowflake.ID) func(yield func(id snowflake.ID, entity T) bool) {
+	c.expire()
+	return c.GroupedCache.IterGroup(groupID)
+}
+
+func (c *expiringGroupedCache[T]) Snapshot() GroupedView[T] {
+	c.expire()
+	return c.GroupedCache.Snapshot()
+}
+
+func (c *expiringGroupedCache[T]) GroupSnapshot(groupID snowflake.ID) View[T] {
+	c.expire()
+	return c.GroupedCache.GroupSnapshot(groupID)
+}
@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/clock"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiringGroupedCache(t *testing.T) {
+	for _, mode := range []ExpirationMode{ExpirationAbsolute, ExpirationSliding} {
+		mock := clock.NewMock(time.Now())
+		c := NewExpiringGroupedCache[int](NewGroupedCache[int](FlagsAll, FlagsNone, nil), time.Minute, mode, mock)
+
+		c.Put(1, 1, 1)
+		c.Put(1, 2, 2)
+		mock.Advance(30 * time.Second)
+		c.Put(2, 1, 1)
+		_, ok := c.Get(1, 1)
+		assert.True(t, ok)
+
+		mock.Advance(30 * time.Second)
+		_, ok = c.Get(1, 1)
+		assert.Equal(t, mode == ExpirationSliding, ok)
+		_, ok = c.Get(1, 2)
+		assert.False(t, ok)
+		assert.Equal(t, 1, c.GroupLen(2))
+
+		mock.Advance(time.Minute)
+		assert.Equal(t, 0, c.Len())
+	}
+}
+
+func TestExpiringGroupedCache_TracksStoredOnly(t *testing.T) {
+	mock := clock.NewMock(time.Now())
+	even := func(entity int) bool { return entity%2 == 0 }
+	c := NewExpiringGroupedCache[int](NewGroupedCache[int](FlagsAll, FlagsNone, even), time.Minute, ExpirationAbsolute, mock).(*expiringGroupedCache[int])
+
+	c.Put(1, 1, 1)
+	c.PutAll(1, map[snowflake.ID]int{2: 2, 3: 3})
+	c.PutIfAbsent(1, 5, 5)
+	c.GetOrPut(1, 7, func() int { return 7 })
+	c.Compute(1, 9, func(_ int, _ bool) (int, bool) { return 9, true })
+	assert.Len(t, c.elements[1], 1)
+	assert.Contains(t, c.elements[1], snowflake.ID(2))
+	assert.Empty(t, c.pending)
+
+	c.Put(1, 4, 4)
+	c.Put(2, 2, 2)
+	c.RemoveIf(func(_ snowflake.ID, entity int) bool { return entity == 2 })
+	assert.Equal(t, 1, c.order.Len())
+	assert.Contains(t, c.elements[1], snowflake.ID(4))
+	assert.NotContains(t, c.elements, snowflake.ID(2))
+}
+
+func TestExpiringGroupedCache_ConcurrentPutExpire(t *testing.T) {
+	mock := clock.NewMock(time.Now())
+	c := NewExpiringGroupedCache[int](NewGroupedCache[int](FlagsAll, FlagsNone, nil), time.Minute, ExpirationAbsolute, mock).(*expiringGroupedCache[int])
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				id := snowflake.ID(i % 10)
+				c.Put(snowflake.ID(w), id, i)
+				if i%100 == 0 {
+					mock.Advance(time.Minute)
+				}
+				c.Len()
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	// every entity in the wrapped cache is tracked and every tracked entity is in the wrapped cache
+	assert.Empty(t, c.pending)
+	assert.Equal(t, c.order.Len(), c.GroupedCache.Len())
+	for groupID, groupElements := range c.elements {
+		for id := range groupElements {
+			_, ok := c.GroupedCache.Get(groupID, id)
+			assert.True(t, ok, "tracked entity %d/%d is not cached", groupID, id)
+		}
+	}
+
+	// the last put entities are not expired yet
+	for w := 0; w < 4; w++ {
+		c.Put(snowflake.ID(w), 1, 1)
+		_, ok := c.Get(snowflake.ID(w), 1)
+		assert.True(t, ok)
+	}
+}
@@ -0,0 +1,137 @@
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/clock"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// concurrently runs fn in n goroutines at the same time and waits for all of them to return.
+func concurrently(n int, fn func(i int)) {
+	var (
+		start sync.WaitGroup
+		done  sync.WaitGroup
+	)
+	start.Add(1)
+	for i := 0; i < n; i++ {
+		done.Add(1)
+		go func(i int) {
+			defer done.Done()
+			start.Wait()
+			fn(i)
+		}(i)
+	}
+	start.Done()
+	done.Wait()
+}
+
+// testGetOrPut calls getOrPut concurrently for the same entity and checks that only one caller stored its entity.
+func testGetOrPut(t *testing.T, getOrPut func(supplier func() int) (int, bool)) {
+	var (
+		calls    int32
+		inserted int32
+		values   = make([]int, 16)
+	)
+	concurrently(len(values), func(i int) {
+		entity, ok := getOrPut(func() int {
+			atomic.AddInt32(&calls, 1)
+			return i + 1
+		})
+		if !ok {
+			atomic.AddInt32(&inserted, 1)
+		}
+		values[i] = entity
+	})
+
+	assert.Equal(t, int32(1), calls)
+	assert.Equal(t, int32(1), inserted)
+	for _, value := range values {
+		assert.Equal(t, values[0], value)
+	}
+}
+
+// testPutIfAbsent calls putIfAbsent concurrently for the same entity and checks that only one caller stored its entity.
+func testPutIfAbsent(t *testing.T, putIfAbsent func(entity int) bool, get func() (int, bool)) {
+	var (
+		inserted int32
+		winner   int32
+	)
+	concurrently(16, func(i int) {
+		if putIfAbsent(i + 1) {
+			atomic.AddInt32(&inserted, 1)
+			atomic.StoreInt32(&winner, int32(i+1))
+		}
+	})
+
+	assert.Equal(t, int32(1), inserted)
+	entity, ok := get()
+	assert.True(t, ok)
+	assert.Equal(t, int(winner), entity)
+}
+
+func TestCache_GetOrPutAtomic(t *testing.T) {
+	c := NewCache[int](FlagsAll, FlagsNone, nil)
+	testGetOrPut(t, func(supplier func() int) (int, bool) {
+		return c.GetOrPut(1, supplier)
+	})
+	testPutIfAbsent(t, func(entity int) bool {
+		return c.PutIfAbsent(2, entity)
+	}, func() (int, bool) {
+		return c.Get(2)
+	})
+}
+
+func TestGroupedCache_GetOrPutAtomic(t *testing.T) {
+	c := NewGroupedCache[int](FlagsAll, FlagsNone, nil)
+	testGetOrPut(t, func(supplier func() int) (int, bool) {
+		return c.GetOrPut(1, 1, supplier)
+	})
+	testPutIfAbsent(t, func(entity int) bool {
+		return c.PutIfAbsent(1, 2, entity)
+	}, func() (int, bool) {
+		return c.Get(1, 2)
+	})
+}
+
+func TestCaches_GetOrPutAtomic(t *testing.T) {
+	mock := clock.NewMock(time.Now())
+	caches := New(
+		WithCacheFlags(FlagGuilds, FlagRoles),
+		WithClock(mock),
+		WithExpiration(FlagRoles, time.Minute, ExpirationAbsolute),
+	)
+
+	testGetOrPut(t, func(supplier func() int) (int, bool) {
+		guild, ok := caches.Guilds().GetOrPut(1, func() discord.Guild {
+			return discord.Guild{ID: 1, MemberCount: supplier()}
+		})
+		return guild.MemberCount, ok
+	})
+
+	for round := 0; round < 2; round++ {
+		// the second round stores the entities again after they expired
+		id := snowflake.ID(1)
+		testGetOrPut(t, func(supplier func() int) (int, bool) {
+			role, ok := caches.Roles().GetOrPut(1, id, func() discord.Role {
+				return discord.Role{ID: id, Position: supplier()}
+			})
+			return role.Position, ok
+		})
+		testPutIfAbsent(t, func(entity int) bool {
+			return caches.Roles().PutIfAbsent(1, 2, discord.Role{ID: 2, Position: entity})
+		}, func() (int, bool) {
+			role, ok := caches.Roles().Get(1, 2)
+			return role.Position, ok
+		})
+		mock.Advance(time.Minute)
+		assert.Equal(t, 0, caches.Roles().GroupLen(1))
+	}
+	// every round stored exactly two roles
+	assert.Equal(t, uint64(4), caches.Stats()["roles"].Puts)
+}
@@ -18,21 +18,44 @@ type GroupedCache[T any] interface {
 	// Put stores the given entity with the given groupID and ID as key. If the entity is already present, it will be overwritten.
 	Put(groupID snowflake.ID, id snowflake.ID, entity T)
 
+	// GetOrPut returns the entity with the given groupID and ID if present, else it stores and returns the entity created by the supplier.
+	// The bool reports whether the entity was already present. The check and insert happen atomically, so the supplier must not access the cache.
+	GetOrPut(groupID snowflake.ID, id snowflake.ID, supplier func() T) (T, bool)
+
+	// PutIfAbsent stores the given entity with the given groupID and ID as key if no entity is present and returns whether it was stored.
+	PutIfAbsent(groupID snowflake.ID, id snowflake.ID, entity T) bool
+
+	// Compute updates the entity with the given groupID and ID with the entity returned by the ComputeFunc. If the ComputeFunc returns false, the entity is removed instead.
+	// It returns the computed entity and whether it was stored. The read and write happen atomically, so the ComputeFunc must not access the cache.
+	Compute(groupID snowflake.ID, id snowflake.ID, computeFunc ComputeFunc[T]) (T, bool)
+
+	// PutAll stores all given entities in the groupID. It is the same as calling Put for each entity, but only acquires the lock once.
+	PutAll(groupID snowflake.ID, entities map[snowflake.ID]T)
+
 	// Remove removes the entity with the given groupID and ID as key and returns a copy of the entity and a bool whether it was removed or not.
 	Remove(groupID snowflake.ID, id snowflake.ID) (T, bool)
 
+	// RemoveMany removes the entities with the given IDs from the groupID. It is the same as calling Remove for each ID, but only acquires the lock once.
+	RemoveMany(groupID snowflake.ID, ids []snowflake.ID)
+
 	// RemoveAll removes all entities in the given groupID.
 	RemoveAll(groupID snowflake.ID)
 
 	// RemoveIf removes all entities that pass the given GroupedFilterFunc
 	RemoveIf(filterFunc GroupedFilterFunc[T])
 
+	// GroupRemoveIf removes all entities within the groupID that pass the given GroupedFilterFunc. Unlike RemoveIf it doesn't scan the other groups.
+	GroupRemoveIf(groupID snowflake.ID, filterFunc GroupedFilterFunc[T])
+
 	// Len returns the total number of entities in the cache.
 	Len() int
 
 	// GroupLen returns the number of entities in the cache within the groupID.
 	GroupLen(groupID snowflake.ID) int
 
+	// GroupIDs returns the IDs of all groups which hold at least one entity.
+	GroupIDs() []snowflake.ID
+
 	// All returns a copy of all entities in the cache.
 	All() map[snowflake.ID][]T
 
@@ -62,33 +85,148 @@ type GroupedCache[T any] interface {
 
 	// GroupForEach calls the given function for each entity in the cache within the groupID.
 	GroupForEach(groupID snowflake.ID, forEachFunc func(entity T))
+
+	// AddIndex adds a secondary index with the given name which indexes the entities of each group by the key returned by the IndexFunc.
+	// Entities already in the cache are indexed immediately. Adding an index with the same name again replaces it.
+	AddIndex(name string, indexFunc IndexFunc[T])
+
+	// GetByIndex returns the entity with the given key in the secondary index within the groupID and a bool whether it was found or not.
+	// If multiple entities have the same key, the one with the lowest ID is returned. This avoids scanning the group with GroupFindFirst, for example to find a role by its name.
+	GetByIndex(groupID snowflake.ID, index string, key string) (T, bool)
+
+	// Iter returns an iterator over all entities in the cache with their groupID without copying them. It is compatible with iter.Seq2 and can be used with range over func.
+	// The read lock is held while iterating, so the cache must not be modified from within the loop.
+	Iter() func(yield func(groupID snowflake.ID, entity T) bool)
+
+	// IterGroup returns an iterator over all entities in the cache within the groupID with their ID. It is compatible with iter.Seq2 and can be used with range over func.
+	// The read lock is held while iterating, so the cache must not be modified from within the loop.
+	IterGroup(groupID snowflake.ID) func(yield func(id snowflake.ID, entity T) bool)
+
+	// Snapshot returns an immutable GroupedView of all entities in the cache, which can be iterated without holding the locks of the cache.
+	// Each group is consistent in itself, but the groups may be taken at slightly different times.
+	Snapshot() GroupedView[T]
+
+	// GroupSnapshot returns an immutable View of all entities in the cache within the groupID, which can be iterated without holding the locks of the cache.
+	GroupSnapshot(groupID snowflake.ID) View[T]
 }
 
 var _ GroupedCache[any] = (*defaultGroupedCache[any])(nil)
 
+// groupedCacheStripes is the number of stripes the default GroupedCache distributes its groups across.
+// Each stripe has its own lock, so writes to groups in different stripes don't contend. It must be a power of two.
+const groupedCacheStripes = 64
+
 // NewGroupedCache returns a new default GroupedCache with the provided flags, neededFlags and policy.
-func NewGroupedCache[T any](flags Flags, neededFlags Flags, policy Policy[T]) GroupedCache[T] {
-	return &defaultGroupedCache[T]{
-		flags:       flags,
-		neededFlags: neededFlags,
-		policy:      policy,
-		cache:       make(map[snowflake.ID]map[snowflake.ID]T),
+// Entities are only cached if they also pass all given GroupedPolicy(s), which lets you decide per group what to cache.
+func NewGroupedCache[T any](flags Flags, neededFlags Flags, policy Policy[T], groupedPolicies ...GroupedPolicy[T]) GroupedCache[T] {
+	c := newDefaultGroupedCache[T](flags, neededFlags, policy, 0, nil, groupedCacheStripes)
+	c.groupedPolicy = allGroupedPolicies(groupedPolicies)
+	return c
+}
+
+// NewLRUGroupedCache returns a new default GroupedCache like NewGroupedCache which holds at most maxGroupSize entities per group.
+// If a group is full, Put evicts the least recently used entity of that group and calls the given EvictFunc with it.
+// A maxGroupSize of 0 disables the limit.
+func NewLRUGroupedCache[T any](flags Flags, neededFlags Flags, policy Policy[T], maxGroupSize int, evictFunc EvictFunc[T], groupedPolicies ...GroupedPolicy[T]) GroupedCache[T] {
+	c := newDefaultGroupedCache[T](flags, neededFlags, policy, maxGroupSize, evictFunc, groupedCacheStripes)
+	c.groupedPolicy = allGroupedPolicies(groupedPolicies)
+	return c
+}
+
+// allGroupedPolicies combines the given GroupedPolicy(s) into one which requires all of them to pass. It returns nil if none are given.
+func allGroupedPolicies[T any](policies []GroupedPolicy[T]) GroupedPolicy[T] {
+	switch len(policies) {
+	case 0:
+		return nil
+	case 1:
+		return policies[0]
+	}
+	return func(groupID snowflake.ID, entity T) bool {
+		for _, policy := range policies {
+			if !policy(groupID, entity) {
+				return false
+			}
+		}
+		return true
 	}
 }
 
+func newDefaultGroupedCache[T any](flags Flags, neededFlags Flags, policy Policy[T], maxGroupSize int, evictFunc EvictFunc[T], stripes int) *defaultGroupedCache[T] {
+	c := &defaultGroupedCache[T]{
+		flags:        flags,
+		neededFlags:  neededFlags,
+		policy:       policy,
+		stripes:      make([]*groupedCacheStripe[T], stripes),
+		maxGroupSize: maxGroupSize,
+		evictFunc:    evictFunc,
+	}
+	for i := range c.stripes {
+		s := &groupedCacheStripe[T]{
+			cache:      make(map[snowflake.ID]map[snowflake.ID]T),
+			indexFuncs: make(map[string]IndexFunc[T]),
+			indexes:    make(map[snowflake.ID]map[string]groupIndex),
+			shared:     make(map[snowflake.ID]struct{}),
+		}
+		if maxGroupSize > 0 {
+			s.orders = make(map[snowflake.ID]*lruOrder)
+		}
+		c.stripes[i] = s
+	}
+	return c
+}
+
+// groupedCacheStripe holds a subset of the groups of a defaultGroupedCache guarded by its own lock.
+type groupedCacheStripe[T any] struct {
+	mu     sync.RWMutex
+	cache  map[snowflake.ID]map[snowflake.ID]T
+	orders map[snowflake.ID]*lruOrder
+
+	indexFuncs map[string]IndexFunc[T]
+	indexes    map[snowflake.ID]map[string]groupIndex
+
+	// shared holds the groups whose maps are referenced by a GroupedView and need to be copied before they are modified
+	shared map[snowflake.ID]struct{}
+}
+
+// defaultGroupedCache distributes its groups across multiple stripes, so operations on a single group only lock the stripe of that group.
+// Operations over all groups lock one stripe after another and therefore don't see a consistent snapshot of the whole cache.
 type defaultGroupedCache[T any] struct {
-	mu          sync.RWMutex
 	flags       Flags
 	neededFlags Flags
 	policy      Policy[T]
-	cache       map[snowflake.ID]map[snowflake.ID]T
+	stripes     []*groupedCacheStripe[T]
+
+	groupedPolicy GroupedPolicy[T]
+
+	maxGroupSize int
+	evictFunc    EvictFunc[T]
+
+	putListener    Listener[T]
+	removeListener Listener[T]
+}
+
+// stripe returns the stripe the given group belongs to.
+func (c *defaultGroupedCache[T]) stripe(groupID snowflake.ID) *groupedCacheStripe[T] {
+	// the lower bits of a snowflake are mostly 0 for guilds, so we mix all bits with the fibonacci hashing multiplier
+	return c.stripes[(uint64(groupID)*0x9E3779B97F4A7C15>>32)&uint64(len(c.stripes)-1)]
 }
 
 func (c *defaultGroupedCache[T]) Get(groupID snowflake.ID, id snowflake.ID) (T, bool) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	s := c.stripe(groupID)
+	if s.orders != nil {
+		// getting an entity changes the access order, so we need a write lock
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		entity, ok := s.cache[groupID][id]
+		if ok {
+			s.touch(groupID, id)
+		}
+		return entity, ok
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-	if groupEntities, ok := c.cache[groupID]; ok {
+	if groupEntities, ok := s.cache[groupID]; ok {
 		if entity, ok := groupEntities[id]; ok {
 			return entity, true
 		}
@@ -99,101 +237,384 @@ func (c *defaultGroupedCache[T]) Get(groupID snowflake.ID, id snowflake.ID) (T,
 }
 
 func (c *defaultGroupedCache[T]) Put(groupID snowflake.ID, id snowflake.ID, entity T) {
-	if c.neededFlags != FlagsNone && c.flags.Missing(c.neededFlags) {
+	if !c.allowed(groupID, entity) {
 		return
 	}
-	if c.policy != nil && !c.policy(entity) {
-		return
+	s := c.stripe(groupID)
+	s.mu.Lock()
+	evictedID, evicted, ok := c.put(s, groupID, id, entity)
+	s.mu.Unlock()
+	c.stored(groupID, id, entity)
+	c.evicted(groupID, evictedID, evicted, ok)
+}
+
+func (c *defaultGroupedCache[T]) GetOrPut(groupID snowflake.ID, id snowflake.ID, supplier func() T) (T, bool) {
+	s := c.stripe(groupID)
+	s.mu.Lock()
+	if entity, ok := s.cache[groupID][id]; ok {
+		if s.orders != nil {
+			s.touch(groupID, id)
+		}
+		s.mu.Unlock()
+		return entity, true
+	}
+	entity := supplier()
+	if !c.allowed(groupID, entity) {
+		s.mu.Unlock()
+		return entity, false
+	}
+	evictedID, evicted, ok := c.put(s, groupID, id, entity)
+	s.mu.Unlock()
+	c.stored(groupID, id, entity)
+	c.evicted(groupID, evictedID, evicted, ok)
+	return entity, false
+}
+
+func (c *defaultGroupedCache[T]) PutIfAbsent(groupID snowflake.ID, id snowflake.ID, entity T) bool {
+	if !c.allowed(groupID, entity) {
+		return false
+	}
+	s := c.stripe(groupID)
+	s.mu.Lock()
+	if _, ok := s.cache[groupID][id]; ok {
+		s.mu.Unlock()
+		return false
+	}
+	evictedID, evicted, ok := c.put(s, groupID, id, entity)
+	s.mu.Unlock()
+	c.stored(groupID, id, entity)
+	c.evicted(groupID, evictedID, evicted, ok)
+	return true
+}
+
+func (c *defaultGroupedCache[T]) Compute(groupID snowflake.ID, id snowflake.ID, computeFunc ComputeFunc[T]) (T, bool) {
+	s := c.stripe(groupID)
+	s.mu.Lock()
+	old, exists := s.cache[groupID][id]
+	entity, ok := computeFunc(old, exists)
+	if !ok {
+		if exists {
+			delete(s.writable(groupID), id)
+			s.untrack(groupID, id)
+			s.unindex(groupID, id, old)
+		}
+		s.mu.Unlock()
+		if exists && c.removeListener != nil {
+			c.removeListener(groupID, id, old)
+		}
+		return entity, false
+	}
+	if !c.allowed(groupID, entity) {
+		s.mu.Unlock()
+		return entity, false
 	}
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	evictedID, evicted, evictedOk := c.put(s, groupID, id, entity)
+	s.mu.Unlock()
+	c.stored(groupID, id, entity)
+	c.evicted(groupID, evictedID, evicted, evictedOk)
+	return entity, true
+}
+
+// evictedEntity is an entity which was evicted to make room for another one.
+type evictedEntity[T any] struct {
+	id     snowflake.ID
+	entity T
+}
 
-	if c.cache == nil {
-		c.cache = make(map[snowflake.ID]map[snowflake.ID]T)
+func (c *defaultGroupedCache[T]) PutAll(groupID snowflake.ID, entities map[snowflake.ID]T) {
+	stored := make(map[snowflake.ID]T, len(entities))
+	var evicted []evictedEntity[T]
+	s := c.stripe(groupID)
+	s.mu.Lock()
+	for id, entity := range entities {
+		if !c.allowed(groupID, entity) {
+			continue
+		}
+		stored[id] = entity
+		if evictedID, e, ok := c.put(s, groupID, id, entity); ok {
+			evicted = append(evicted, evictedEntity[T]{id: evictedID, entity: e})
+		}
 	}
+	s.mu.Unlock()
 
-	if groupEntities, ok := c.cache[groupID]; ok {
+	for id, entity := range stored {
+		c.stored(groupID, id, entity)
+	}
+	for _, e := range evicted {
+		c.evicted(groupID, e.id, e.entity, true)
+	}
+}
+
+// allowed returns whether the entity passes the Flags, Policy and GroupedPolicy of the cache.
+func (c *defaultGroupedCache[T]) allowed(groupID snowflake.ID, entity T) bool {
+	if c.neededFlags != FlagsNone && c.flags.Missing(c.neededFlags) {
+		return false
+	}
+	if c.policy != nil && !c.policy(entity) {
+		return false
+	}
+	return c.groupedPolicy == nil || c.groupedPolicy(groupID, entity)
+}
+
+// put stores the entity and evicts the least recently used entity of the group if it is full. It needs to be called with the write lock of the stripe held.
+func (c *defaultGroupedCache[T]) put(s *groupedCacheStripe[T], groupID snowflake.ID, id snowflake.ID, entity T) (snowflake.ID, T, bool) {
+	if _, ok := s.cache[groupID]; ok {
+		groupEntities := s.writable(groupID)
+		if old, ok := groupEntities[id]; ok {
+			s.unindex(groupID, id, old)
+		}
 		groupEntities[id] = entity
 	} else {
-		groupEntities = make(map[snowflake.ID]T)
+		groupEntities := make(map[snowflake.ID]T)
 		groupEntities[id] = entity
-		c.cache[groupID] = groupEntities
+		s.cache[groupID] = groupEntities
 	}
+	s.index(groupID, id, entity)
+
+	if s.orders == nil {
+		var zero T
+		return 0, zero, false
+	}
+	s.touch(groupID, id)
+	return s.evict(groupID, c.maxGroupSize)
 }
 
-func (c *defaultGroupedCache[T]) Remove(groupID snowflake.ID, id snowflake.ID) (entity T, ok bool) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+// stored calls the put Listener with the stored entity. It needs to be called without holding the lock.
+func (c *defaultGroupedCache[T]) stored(groupID snowflake.ID, id snowflake.ID, entity T) {
+	if c.putListener != nil {
+		c.putListener(groupID, id, entity)
+	}
+}
 
-	if groupEntities, ok := c.cache[groupID]; ok {
-		if entity, ok := groupEntities[id]; ok {
-			delete(groupEntities, id)
-			return entity, ok
+// evicted calls the EvictFunc & the remove Listener with the evicted entity. It needs to be called without holding the lock.
+func (c *defaultGroupedCache[T]) evicted(groupID snowflake.ID, id snowflake.ID, entity T, ok bool) {
+	if !ok {
+		return
+	}
+	if c.evictFunc != nil {
+		c.evictFunc(groupID, id, entity)
+	}
+	if c.removeListener != nil {
+		c.removeListener(groupID, id, entity)
+	}
+}
+
+// touch marks the given entity as most recently used within its group.
+func (s *groupedCacheStripe[T]) touch(groupID snowflake.ID, id snowflake.ID) {
+	order, ok := s.orders[groupID]
+	if !ok {
+		order = newLRUOrder()
+		s.orders[groupID] = order
+	}
+	order.touch(id)
+}
+
+// untrack removes the given entity from the access order of its group.
+func (s *groupedCacheStripe[T]) untrack(groupID snowflake.ID, id snowflake.ID) {
+	if s.orders == nil {
+		return
+	}
+	if order, ok := s.orders[groupID]; ok {
+		order.remove(id)
+	}
+}
+
+// evict removes the least recently used entity of the group if it exceeds the maximum size.
+func (s *groupedCacheStripe[T]) evict(groupID snowflake.ID, maxGroupSize int) (snowflake.ID, T, bool) {
+	var entity T
+	groupEntities := s.cache[groupID]
+	if len(groupEntities) <= maxGroupSize {
+		return 0, entity, false
+	}
+	id, ok := s.orders[groupID].oldest()
+	if !ok {
+		return 0, entity, false
+	}
+	entity = groupEntities[id]
+	delete(s.writable(groupID), id)
+	s.untrack(groupID, id)
+	s.unindex(groupID, id, entity)
+	return id, entity, true
+}
+
+// writable returns the map of the group for modification and copies it first if it is shared with a GroupedView. It needs to be called with the write lock of the stripe held.
+func (s *groupedCacheStripe[T]) writable(groupID snowflake.ID) map[snowflake.ID]T {
+	groupEntities := s.cache[groupID]
+	if _, ok := s.shared[groupID]; ok {
+		groupEntities = copyMap(groupEntities)
+		s.cache[groupID] = groupEntities
+		delete(s.shared, groupID)
+	}
+	return groupEntities
+}
+
+func (c *defaultGroupedCache[T]) Remove(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	s := c.stripe(groupID)
+	s.mu.Lock()
+	entity, ok := s.cache[groupID][id]
+	if ok {
+		delete(s.writable(groupID), id)
+		s.untrack(groupID, id)
+		s.unindex(groupID, id, entity)
+	}
+	s.mu.Unlock()
+	if ok && c.removeListener != nil {
+		c.removeListener(groupID, id, entity)
+	}
+	return entity, ok
+}
+
+func (c *defaultGroupedCache[T]) RemoveMany(groupID snowflake.ID, ids []snowflake.ID) {
+	removed := make(map[snowflake.ID]T, len(ids))
+	s := c.stripe(groupID)
+	s.mu.Lock()
+	for _, id := range ids {
+		if entity, ok := s.cache[groupID][id]; ok {
+			delete(s.writable(groupID), id)
+			s.untrack(groupID, id)
+			s.unindex(groupID, id, entity)
+			removed[id] = entity
+		}
+	}
+	s.mu.Unlock()
+	if c.removeListener != nil {
+		for id, entity := range removed {
+			c.removeListener(groupID, id, entity)
 		}
 	}
-	ok = false
-	return
 }
 
 func (c *defaultGroupedCache[T]) RemoveAll(groupID snowflake.ID) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	delete(c.cache, groupID)
+	s := c.stripe(groupID)
+	s.mu.Lock()
+	// the group map is not referenced by the cache anymore, so it can be read without holding the lock
+	groupEntities := s.cache[groupID]
+	delete(s.cache, groupID)
+	if s.orders != nil {
+		delete(s.orders, groupID)
+	}
+	delete(s.indexes, groupID)
+	delete(s.shared, groupID)
+	s.mu.Unlock()
+	if c.removeListener != nil {
+		for id, entity := range groupEntities {
+			c.removeListener(groupID, id, entity)
+		}
+	}
 }
 
 func (c *defaultGroupedCache[T]) RemoveIf(filterFunc GroupedFilterFunc[T]) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	for _, s := range c.stripes {
+		var removed map[snowflake.ID]map[snowflake.ID]T
+		s.mu.Lock()
+		for groupID := range s.cache {
+			for id, entity := range s.cache[groupID] {
+				if filterFunc(groupID, entity) {
+					delete(s.writable(groupID), id)
+					s.untrack(groupID, id)
+					s.unindex(groupID, id, entity)
+					if c.removeListener != nil {
+						if removed == nil {
+							removed = map[snowflake.ID]map[snowflake.ID]T{}
+						}
+						if removed[groupID] == nil {
+							removed[groupID] = map[snowflake.ID]T{}
+						}
+						removed[groupID][id] = entity
+					}
+				}
+			}
+		}
+		s.mu.Unlock()
+		for groupID, groupEntities := range removed {
+			for id, entity := range groupEntities {
+				c.removeListener(groupID, id, entity)
+			}
+		}
+	}
+}
 
-	for groupID := range c.cache {
-		for id, entity := range c.cache[groupID] {
-			if filterFunc(groupID, entity) {
-				delete(c.cache[groupID], id)
+func (c *defaultGroupedCache[T]) GroupRemoveIf(groupID snowflake.ID, filterFunc GroupedFilterFunc[T]) {
+	var removed map[snowflake.ID]T
+	s := c.stripe(groupID)
+	s.mu.Lock()
+	for id, entity := range s.cache[groupID] {
+		if filterFunc(groupID, entity) {
+			delete(s.writable(groupID), id)
+			s.untrack(groupID, id)
+			s.unindex(groupID, id, entity)
+			if c.removeListener != nil {
+				if removed == nil {
+					removed = map[snowflake.ID]T{}
+				}
+				removed[id] = entity
 			}
 		}
 	}
+	s.mu.Unlock()
+	for id, entity := range removed {
+		c.removeListener(groupID, id, entity)
+	}
 }
 
 func (c *defaultGroupedCache[T]) Len() int {
 	var totalLen int
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-	for _, groupEntities := range c.cache {
-		totalLen += len(groupEntities)
+	for _, s := range c.stripes {
+		s.mu.RLock()
+		for _, groupEntities := range s.cache {
+			totalLen += len(groupEntities)
+		}
+		s.mu.RUnlock()
 	}
 	return totalLen
 }
 
 func (c *defaultGroupedCache[T]) GroupLen(groupID snowflake.ID) int {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-	if groupEntities, ok := c.cache[groupID]; ok {
+	s := c.stripe(groupID)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if groupEntities, ok := s.cache[groupID]; ok {
 		return len(groupEntities)
 	}
 	return 0
 }
 
-func (c *defaultGroupedCache[T]) All() map[snowflake.ID][]T {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+func (c *defaultGroupedCache[T]) GroupIDs() []snowflake.ID {
+	var groupIDs []snowflake.ID
+	for _, s := range c.stripes {
+		s.mu.RLock()
+		for groupID, groupEntities := range s.cache {
+			if len(groupEntities) > 0 {
+				groupIDs = append(groupIDs, groupID)
+			}
+		}
+		s.mu.RUnlock()
+	}
+	return groupIDs
+}
 
+func (c *defaultGroupedCache[T]) All() map[snowflake.ID][]T {
 	all := make(map[snowflake.ID][]T)
-	for groupID, groupEntities := range c.cache {
-		all[groupID] = make([]T, 0, len(groupEntities))
-		for _, entity := range groupEntities {
-			all[groupID] = append(all[groupID], entity)
+	for _, s := range c.stripes {
+		s.mu.RLock()
+		for groupID, groupEntities := range s.cache {
+			all[groupID] = make([]T, 0, len(groupEntities))
+			for _, entity := range groupEntities {
+				all[groupID] = append(all[groupID], entity)
+			}
 		}
+		s.mu.RUnlock()
 	}
 
 	return all
 }
 
 func (c *defaultGroupedCache[T]) GroupAll(groupID snowflake.ID) []T {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	s := c.stripe(groupID)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-	groupEntities, ok := c.cache[groupID]
+	groupEntities, ok := s.cache[groupID]
 	if !ok {
 		return nil
 	}
@@ -206,25 +627,27 @@ func (c *defaultGroupedCache[T]) GroupAll(groupID snowflake.ID) []T {
 }
 
 func (c *defaultGroupedCache[T]) MapAll() map[snowflake.ID]map[snowflake.ID]T {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	all := make(map[snowflake.ID]map[snowflake.ID]T, len(c.cache))
-	for groupID, groupEntities := range c.cache {
-		all[groupID] = make(map[snowflake.ID]T, len(groupEntities))
-		for entityID, entity := range groupEntities {
-			all[groupID][entityID] = entity
+	all := make(map[snowflake.ID]map[snowflake.ID]T)
+	for _, s := range c.stripes {
+		s.mu.RLock()
+		for groupID, groupEntities := range s.cache {
+			all[groupID] = make(map[snowflake.ID]T, len(groupEntities))
+			for entityID, entity := range groupEntities {
+				all[groupID][entityID] = entity
+			}
 		}
+		s.mu.RUnlock()
 	}
 
 	return all
 }
 
 func (c *defaultGroupedCache[T]) MapGroupAll(groupID snowflake.ID) map[snowflake.ID]T {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	s := c.stripe(groupID)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-	groupEntities, ok := c.cache[groupID]
+	groupEntities, ok := s.cache[groupID]
 	if !ok {
 		return nil
 	}
@@ -237,26 +660,24 @@ func (c *defaultGroupedCache[T]) MapGroupAll(groupID snowflake.ID) map[snowflake
 }
 
 func (c *defaultGroupedCache[T]) FindFirst(cacheFindFunc GroupedFilterFunc[T]) (T, bool) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	for groupID, groupEntities := range c.cache {
-		for _, entity := range groupEntities {
-			if cacheFindFunc(groupID, entity) {
-				return entity, true
-			}
+	var found T
+	var ok bool
+	c.Iter()(func(groupID snowflake.ID, entity T) bool {
+		if cacheFindFunc(groupID, entity) {
+			found, ok = entity, true
+			return false
 		}
-	}
-
-	var entity T
-	return entity, false
+		return true
+	})
+	return found, ok
 }
 
 func (c *defaultGroupedCache[T]) GroupFindFirst(groupID snowflake.ID, cacheFindFunc GroupedFilterFunc[T]) (T, bool) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	s := c.stripe(groupID)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-	for _, entity := range c.cache[groupID] {
+	for _, entity := range s.cache[groupID] {
 		if cacheFindFunc(groupID, entity) {
 			return entity, true
 		}
@@ -267,26 +688,22 @@ func (c *defaultGroupedCache[T]) GroupFindFirst(groupID snowflake.ID, cacheFindF
 }
 
 func (c *defaultGroupedCache[T]) FindAll(cacheFindFunc GroupedFilterFunc[T]) []T {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
 	all := make([]T, 0)
-	for groupID, groupEntities := range c.cache {
-		for _, entity := range groupEntities {
-			if cacheFindFunc(groupID, entity) {
-				all = append(all, entity)
-			}
+	c.ForEach(func(groupID snowflake.ID, entity T) {
+		if cacheFindFunc(groupID, entity) {
+			all = append(all, entity)
 		}
-	}
+	})
 	return all
 }
 
 func (c *defaultGroupedCache[T]) GroupFindAll(groupID snowflake.ID, cacheFindFunc GroupedFilterFunc[T]) []T {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	s := c.stripe(groupID)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
 	all := make([]T, 0)
-	for _, entity := range c.cache[groupID] {
+	for _, entity := range s.cache[groupID] {
 		if cacheFindFunc(groupID, entity) {
 			all = append(all, entity)
 		}
@@ -295,20 +712,85 @@ func (c *defaultGroupedCache[T]) GroupFindAll(groupID snowflake.ID, cacheFindFun
 }
 
 func (c *defaultGroupedCache[T]) ForEach(forEachFunc func(groupID snowflake.ID, entity T)) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	c.Iter()(func(groupID snowflake.ID, entity T) bool {
+		forEachFunc(groupID, entity)
+		return true
+	})
+}
+
+func (c *defaultGroupedCache[T]) GroupForEach(groupID snowflake.ID, forEachFunc func(entity T)) {
+	s := c.stripe(groupID)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, entity := range s.cache[groupID] {
+		forEachFunc(entity)
+	}
+}
 
-	for groupID, groupEntities := range c.cache {
+func (c *defaultGroupedCache[T]) Iter() func(yield func(groupID snowflake.ID, entity T) bool) {
+	return func(yield func(groupID snowflake.ID, entity T) bool) {
+		for _, s := range c.stripes {
+			if !s.iter(yield) {
+				return
+			}
+		}
+	}
+}
+
+// iter calls yield for all entities of the stripe while holding its read lock and returns false if yield stopped the iteration.
+func (s *groupedCacheStripe[T]) iter(yield func(groupID snowflake.ID, entity T) bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for groupID, groupEntities := range s.cache {
 		for _, entity := range groupEntities {
-			forEachFunc(groupID, entity)
+			if !yield(groupID, entity) {
+				return false
+			}
 		}
 	}
+	return true
 }
-func (c *defaultGroupedCache[T]) GroupForEach(groupID snowflake.ID, forEachFunc func(entity T)) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
 
-	for _, entity := range c.cache[groupID] {
-		forEachFunc(entity)
+func (c *defaultGroupedCache[T]) IterGroup(groupID snowflake.ID) func(yield func(id snowflake.ID, entity T) bool) {
+	return func(yield func(id snowflake.ID, entity T) bool) {
+		s := c.stripe(groupID)
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+
+		for id, entity := range s.cache[groupID] {
+			if !yield(id, entity) {
+				return
+			}
+		}
+	}
+}
+
+func (c *defaultGroupedCache[T]) Snapshot() GroupedView[T] {
+	groups := make(map[snowflake.ID]map[snowflake.ID]T)
+	for _, s := range c.stripes {
+		s.mu.Lock()
+		for groupID, groupEntities := range s.cache {
+			if len(groupEntities) == 0 {
+				continue
+			}
+			groups[groupID] = groupEntities
+			s.shared[groupID] = struct{}{}
+		}
+		s.mu.Unlock()
+	}
+	return GroupedView[T]{groups: groups}
+}
+
+func (c *defaultGroupedCache[T]) GroupSnapshot(groupID snowflake.ID) View[T] {
+	s := c.stripe(groupID)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	groupEntities, ok := s.cache[groupID]
+	if !ok {
+		return View[T]{}
 	}
+	s.shared[groupID] = struct{}{}
+	return View[T]{entities: groupEntities}
 }
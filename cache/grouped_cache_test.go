@@ -0,0 +1,187 @@
+package cache
+
+import (
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupedCache_GroupedPolicy(t *testing.T) {
+	c := NewGroupedCache[int](FlagsAll, FlagsNone, func(entity int) bool { return entity > 0 }, PolicyGroupsInclude[int](1))
+
+	c.Put(1, 1, 1)
+	c.Put(1, 2, -1)
+	c.Put(2, 1, 1)
+	assert.True(t, c.PutIfAbsent(1, 3, 3))
+	assert.False(t, c.PutIfAbsent(2, 3, 3))
+
+	assert.Equal(t, 2, c.GroupLen(1))
+	assert.Equal(t, 0, c.GroupLen(2))
+}
+
+func TestGroupedCache_PutAllRemoveMany(t *testing.T) {
+	var evicted int
+	c := NewLRUGroupedCache[int](FlagsAll, FlagsNone, nil, 2, func(_ snowflake.ID, _ snowflake.ID, _ int) { evicted++ })
+
+	c.PutAll(1, map[snowflake.ID]int{1: 1, 2: 2, 3: 3})
+	assert.Equal(t, 2, c.GroupLen(1))
+	assert.Equal(t, 1, evicted)
+
+	c.PutAll(2, map[snowflake.ID]int{1: 1, 2: 2})
+	c.RemoveMany(2, []snowflake.ID{1, 3})
+	assert.Equal(t, map[snowflake.ID]int{2: 2}, c.MapGroupAll(2))
+}
+
+func TestGroupedCache_GroupRemoveIf(t *testing.T) {
+	c := NewGroupedCache[int](FlagsAll, FlagsNone, nil)
+	c.PutAll(1, map[snowflake.ID]int{1: 1, 2: 2, 3: 3})
+	c.PutAll(2, map[snowflake.ID]int{1: 1, 2: 2})
+
+	c.GroupRemoveIf(1, func(_ snowflake.ID, entity int) bool { return entity > 1 })
+
+	assert.Equal(t, map[snowflake.ID]int{1: 1}, c.MapGroupAll(1))
+	assert.Equal(t, 2, c.GroupLen(2))
+}
+
+func TestGroupedCache_GroupIDs(t *testing.T) {
+	c := NewGroupedCache[int](FlagsAll, FlagsNone, nil)
+	c.Put(1, 1, 1)
+	c.Put(1, 2, 2)
+	c.Put(2, 1, 1)
+	c.Remove(2, 1)
+	c.Put(3, 1, 1)
+
+	assert.Equal(t, 3, c.Len())
+	assert.ElementsMatch(t, []snowflake.ID{1, 3}, c.GroupIDs())
+}
+
+func TestGroupedCache_Index(t *testing.T) {
+	c := NewGroupedCache[string](FlagsAll, FlagsNone, nil)
+	c.Put(1, 1, "admin")
+	c.AddIndex("name", func(entity string) string { return entity })
+	c.Put(1, 3, "mod")
+	c.Put(1, 2, "mod")
+	c.Put(2, 1, "mod")
+
+	entity, ok := c.GetByIndex(1, "name", "admin")
+	assert.True(t, ok)
+	assert.Equal(t, "admin", entity)
+
+	_, ok = c.GetByIndex(1, "other", "admin")
+	assert.False(t, ok)
+
+	c.Put(1, 1, "owner")
+	_, ok = c.GetByIndex(1, "name", "admin")
+	assert.False(t, ok)
+
+	c.Remove(1, 2)
+	c.Put(1, 3, "member")
+	_, ok = c.GetByIndex(1, "name", "mod")
+	assert.False(t, ok)
+
+	c.RemoveAll(2)
+	_, ok = c.GetByIndex(2, "name", "mod")
+	assert.False(t, ok)
+}
+
+const benchmarkGroups = 1000
+
+func benchmarkGroupedCache(b *testing.B, name string, run func(b *testing.B, c GroupedCache[int])) {
+	// a single stripe behaves like a single lock over the whole cache
+	for _, stripes := range []int{1, groupedCacheStripes} {
+		b.Run(name+"/stripes="+strconv.Itoa(stripes), func(b *testing.B) {
+			c := newDefaultGroupedCache[int](FlagsAll, FlagsNone, nil, 0, nil, stripes)
+			for i := 0; i < benchmarkGroups; i++ {
+				c.Put(snowflake.ID(i<<22), 1, i)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			run(b, c)
+		})
+	}
+}
+
+func BenchmarkGroupedCache(b *testing.B) {
+	benchmarkGroupedCache(b, "PutParallel", func(b *testing.B, c GroupedCache[int]) {
+		var worker uint64
+		b.RunParallel(func(pb *testing.PB) {
+			groupID := snowflake.ID(atomic.AddUint64(&worker, 1) << 22)
+			var i int
+			for pb.Next() {
+				c.Put(groupID, snowflake.ID(i%100), i)
+				i++
+			}
+		})
+	})
+
+	benchmarkGroupedCache(b, "MixedParallel", func(b *testing.B, c GroupedCache[int]) {
+		var worker uint64
+		b.RunParallel(func(pb *testing.PB) {
+			groupID := snowflake.ID(atomic.AddUint64(&worker, 1) << 22)
+			var i int
+			for pb.Next() {
+				if i%4 == 0 {
+					c.Put(groupID, snowflake.ID(i%100), i)
+				} else {
+					c.Get(groupID, snowflake.ID(i%100))
+				}
+				i++
+			}
+		})
+	})
+}
+
+func TestGroupedCache_Snapshot(t *testing.T) {
+	c := NewGroupedCache[int](FlagsAll, FlagsNone, nil)
+	c.PutAll(1, map[snowflake.ID]int{1: 1, 2: 2})
+	c.Put(2, 1, 1)
+
+	view := c.Snapshot()
+	group := c.GroupSnapshot(1)
+
+	c.Put(1, 3, 3)
+	c.Remove(1, 1)
+	c.RemoveAll(2)
+
+	assert.Equal(t, 3, view.Len())
+	assert.Equal(t, 2, view.GroupLen(1))
+	_, ok := view.Get(2, 1)
+	assert.True(t, ok)
+	assert.Equal(t, map[snowflake.ID]int{1: 1, 2: 2}, group.MapAll())
+	assert.Equal(t, map[snowflake.ID]int{2: 2, 3: 3}, c.MapGroupAll(1))
+
+	// modifying the cache while iterating a snapshot must not deadlock
+	view.Iter()(func(groupID snowflake.ID, entity int) bool {
+		c.Put(groupID, snowflake.ID(entity+10), entity)
+		return true
+	})
+	assert.Equal(t, 4, c.GroupLen(1))
+}
+
+func TestCaches_EvictionListeners(t *testing.T) {
+	var removed, evicted []snowflake.ID
+	caches := New(
+		WithCacheFlags(FlagGuilds, FlagRoles),
+		WithMaxSize(1),
+		WithMaxGroupSize(1),
+		WithRemoveListener(func(_ snowflake.ID, id snowflake.ID, _ any) {
+			removed = append(removed, id)
+		}),
+		WithEvictFunc(func(_ snowflake.ID, id snowflake.ID, _ any) {
+			evicted = append(evicted, id)
+		}),
+	)
+
+	caches.Guilds().Put(1, discord.Guild{ID: 1})
+	caches.Guilds().Put(2, discord.Guild{ID: 2})
+	caches.Roles().Put(1, 3, discord.Role{ID: 3})
+	caches.Roles().Put(1, 4, discord.Role{ID: 4})
+	caches.Roles().Remove(1, 4)
+
+	assert.Equal(t, []snowflake.ID{1, 3}, evicted)
+	assert.Equal(t, []snowflake.ID{1, 3, 4}, removed, "evicted entities were not passed to the remove listener")
+}
@@ -40,8 +40,12 @@ type GuildCache interface {
 // NewGuildCache a new guildCacheImpl with the given flags and policy.
 // guildCacheImpl is thread safe and can be used in multiple goroutines.
 func NewGuildCache(flags Flags, policy Policy[discord.Guild]) GuildCache {
+	return newGuildCache(NewCache[discord.Guild](flags, FlagGuilds, policy))
+}
+
+func newGuildCache(cache Cache[discord.Guild]) GuildCache {
 	return &guildCacheImpl{
-		Cache:             NewCache[discord.Guild](flags, FlagGuilds, policy),
+		Cache:             cache,
 		unreadyGuilds:     map[int]map[snowflake.ID]struct{}{},
 		unavailableGuilds: map[snowflake.ID]struct{}{},
 	}
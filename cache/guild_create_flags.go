@@ -0,0 +1,67 @@
+package cache
+
+// GuildCreateFlags are used to choose which nested entities of a gateway.EventTypeGuildCreate event are put into the caches.
+// Entities are still only cached if their cache is enabled via Flags.
+type GuildCreateFlags int
+
+// values for GuildCreateFlags
+const (
+	GuildCreateFlagChannels GuildCreateFlags = 1 << iota
+	GuildCreateFlagThreads
+	GuildCreateFlagRoles
+	GuildCreateFlagMembers
+	GuildCreateFlagVoiceStates
+	GuildCreateFlagPresences
+	GuildCreateFlagEmojis
+	GuildCreateFlagStickers
+	GuildCreateFlagStageInstances
+	GuildCreateFlagGuildScheduledEvents
+	GuildCreateFlagsNone GuildCreateFlags = 0
+
+	GuildCreateFlagsAll = GuildCreateFlagChannels |
+		GuildCreateFlagThreads |
+		GuildCreateFlagRoles |
+		GuildCreateFlagMembers |
+		GuildCreateFlagVoiceStates |
+		GuildCreateFlagPresences |
+		GuildCreateFlagEmojis |
+		GuildCreateFlagStickers |
+		GuildCreateFlagStageInstances |
+		GuildCreateFlagGuildScheduledEvents
+)
+
+// Add allows you to add multiple bits together, producing a new bit
+func (f GuildCreateFlags) Add(bits ...GuildCreateFlags) GuildCreateFlags {
+	for _, bit := range bits {
+		f |= bit
+	}
+	return f
+}
+
+// Remove allows you to subtract multiple bits from the first, producing a new bit
+func (f GuildCreateFlags) Remove(bits ...GuildCreateFlags) GuildCreateFlags {
+	for _, bit := range bits {
+		f &^= bit
+	}
+	return f
+}
+
+// Has will ensure that the bit includes all the bits entered
+func (f GuildCreateFlags) Has(bits ...GuildCreateFlags) bool {
+	for _, bit := range bits {
+		if (f & bit) != bit {
+			return false
+		}
+	}
+	return true
+}
+
+// Missing will check whether the bit is missing any one of the bits
+func (f GuildCreateFlags) Missing(bits ...GuildCreateFlags) bool {
+	for _, bit := range bits {
+		if (f & bit) != bit {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// GuildMemberCount is the approximate number of members and online members of a guild.
+// It is initialized from the gateway.EventTypeGuildCreate event, updated with every member join or leave and periodically reconciled via the rest API if configured.
+type GuildMemberCount struct {
+	MemberCount   int
+	PresenceCount int
+	// ReconciledAt is the time the counts were last set from a gateway.EventTypeGuildCreate event or the rest API.
+	ReconciledAt time.Time
+}
+
+func (c *cachesImpl) GuildMemberCount(guildID snowflake.ID) (GuildMemberCount, bool) {
+	c.guildMemberCountsMu.RLock()
+	defer c.guildMemberCountsMu.RUnlock()
+	count, ok := c.guildMemberCounts[guildID]
+	return count, ok
+}
+
+func (c *cachesImpl) PutGuildMemberCount(guildID snowflake.ID, count GuildMemberCount) {
+	c.guildMemberCountsMu.Lock()
+	defer c.guildMemberCountsMu.Unlock()
+	c.guildMemberCounts[guildID] = count
+}
+
+func (c *cachesImpl) AddGuildMemberCount(guildID snowflake.ID, delta int) {
+	c.guildMemberCountsMu.Lock()
+	defer c.guildMemberCountsMu.Unlock()
+	count, ok := c.guildMemberCounts[guildID]
+	if !ok {
+		return
+	}
+	count.MemberCount += delta
+	if count.MemberCount < 0 {
+		count.MemberCount = 0
+	}
+	c.guildMemberCounts[guildID] = count
+}
+
+func (c *cachesImpl) RemoveGuildMemberCount(guildID snowflake.ID) {
+	c.guildMemberCountsMu.Lock()
+	defer c.guildMemberCountsMu.Unlock()
+	delete(c.guildMemberCounts, guildID)
+}
@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaches_GuildMemberCount(t *testing.T) {
+	guildID := snowflake.ID(1)
+	reconciledAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		init     *GuildMemberCount
+		update   func(caches Caches)
+		expected *GuildMemberCount
+	}{
+		{
+			name: "untracked",
+			update: func(caches Caches) {
+				caches.AddGuildMemberCount(guildID, 1)
+			},
+		},
+		{
+			name: "guild create",
+			update: func(caches Caches) {
+				caches.PutGuildMemberCount(guildID, GuildMemberCount{MemberCount: 10, PresenceCount: 4, ReconciledAt: reconciledAt})
+			},
+			expected: &GuildMemberCount{MemberCount: 10, PresenceCount: 4, ReconciledAt: reconciledAt},
+		},
+		{
+			name: "member add",
+			init: &GuildMemberCount{MemberCount: 10, PresenceCount: 4, ReconciledAt: reconciledAt},
+			update: func(caches Caches) {
+				caches.AddGuildMemberCount(guildID, 1)
+			},
+			expected: &GuildMemberCount{MemberCount: 11, PresenceCount: 4, ReconciledAt: reconciledAt},
+		},
+		{
+			name: "member remove",
+			init: &GuildMemberCount{MemberCount: 10, PresenceCount: 4, ReconciledAt: reconciledAt},
+			update: func(caches Caches) {
+				caches.AddGuildMemberCount(guildID, -1)
+			},
+			expected: &GuildMemberCount{MemberCount: 9, PresenceCount: 4, ReconciledAt: reconciledAt},
+		},
+		{
+			name: "clamped at zero",
+			init: &GuildMemberCount{MemberCount: 1},
+			update: func(caches Caches) {
+				caches.AddGuildMemberCount(guildID, -1)
+				caches.AddGuildMemberCount(guildID, -1)
+			},
+			expected: &GuildMemberCount{MemberCount: 0},
+		},
+		{
+			name: "reconcile",
+			init: &GuildMemberCount{MemberCount: 9, PresenceCount: 4},
+			update: func(caches Caches) {
+				caches.PutGuildMemberCount(guildID, GuildMemberCount{MemberCount: 12, PresenceCount: 5, ReconciledAt: reconciledAt})
+			},
+			expected: &GuildMemberCount{MemberCount: 12, PresenceCount: 5, ReconciledAt: reconciledAt},
+		},
+		{
+			name: "guild delete",
+			init: &GuildMemberCount{MemberCount: 10},
+			update: func(caches Caches) {
+				caches.RemoveGuildMemberCount(guildID)
+				caches.AddGuildMemberCount(guildID, 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caches := New()
+			if tt.init != nil {
+				caches.PutGuildMemberCount(guildID, *tt.init)
+			}
+			tt.update(caches)
+
+			count, ok := caches.GuildMemberCount(guildID)
+			if tt.expected == nil {
+				assert.False(t, ok)
+				return
+			}
+			assert.True(t, ok)
+			assert.Equal(t, *tt.expected, count)
+		})
+	}
+}
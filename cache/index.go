@@ -0,0 +1,141 @@
+package cache
+
+import (
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// IndexFunc returns the key an entity is indexed under in a secondary index of a GroupedCache. Entities with an empty key are not indexed.
+type IndexFunc[T any] func(entity T) string
+
+// Index configures a secondary index which is added to the grouped caches matching the Flags.
+type Index struct {
+	Flags   Flags
+	Name    string
+	KeyFunc IndexFunc[any]
+}
+
+func indexFuncOf[T any](indexFunc IndexFunc[any]) IndexFunc[T] {
+	return func(entity T) string {
+		return indexFunc(entity)
+	}
+}
+
+// groupIndex maps the keys of one secondary index of a group to the IDs of the entities with that key.
+// Keys are not unique, for example multiple roles can have the same name.
+type groupIndex map[string]map[snowflake.ID]struct{}
+
+func (i groupIndex) add(key string, id snowflake.ID) {
+	if key == "" {
+		return
+	}
+	ids, ok := i[key]
+	if !ok {
+		ids = make(map[snowflake.ID]struct{})
+		i[key] = ids
+	}
+	ids[id] = struct{}{}
+}
+
+func (i groupIndex) remove(key string, id snowflake.ID) {
+	ids, ok := i[key]
+	if !ok {
+		return
+	}
+	delete(ids, id)
+	if len(ids) == 0 {
+		delete(i, key)
+	}
+}
+
+// oldest returns the lowest and therefore oldest ID with the given key, so lookups of duplicate keys are deterministic.
+func (i groupIndex) oldest(key string) (snowflake.ID, bool) {
+	var oldest snowflake.ID
+	for id := range i[key] {
+		if oldest == 0 || id < oldest {
+			oldest = id
+		}
+	}
+	return oldest, oldest != 0
+}
+
+// index adds the entity to all secondary indexes of its group. It needs to be called with the write lock of the stripe held.
+func (s *groupedCacheStripe[T]) index(groupID snowflake.ID, id snowflake.ID, entity T) {
+	if len(s.indexFuncs) == 0 {
+		return
+	}
+	groupIndexes, ok := s.indexes[groupID]
+	if !ok {
+		groupIndexes = make(map[string]groupIndex, len(s.indexFuncs))
+		s.indexes[groupID] = groupIndexes
+	}
+	for name, indexFunc := range s.indexFuncs {
+		index, ok := groupIndexes[name]
+		if !ok {
+			index = make(groupIndex)
+			groupIndexes[name] = index
+		}
+		index.add(indexFunc(entity), id)
+	}
+}
+
+// unindex removes the entity from all secondary indexes of its group. It needs to be called with the write lock of the stripe held.
+func (s *groupedCacheStripe[T]) unindex(groupID snowflake.ID, id snowflake.ID, entity T) {
+	if len(s.indexFuncs) == 0 {
+		return
+	}
+	groupIndexes := s.indexes[groupID]
+	for name, indexFunc := range s.indexFuncs {
+		if index, ok := groupIndexes[name]; ok {
+			index.remove(indexFunc(entity), id)
+		}
+	}
+}
+
+// lookup returns the ID of the entity with the given key in the secondary index of the group. It needs to be called with the lock of the stripe held.
+func (s *groupedCacheStripe[T]) lookup(groupID snowflake.ID, index string, key string) (snowflake.ID, bool) {
+	return s.indexes[groupID][index].oldest(key)
+}
+
+func (c *defaultGroupedCache[T]) AddIndex(name string, indexFunc IndexFunc[T]) {
+	for _, s := range c.stripes {
+		s.mu.Lock()
+		s.indexFuncs[name] = indexFunc
+		for groupID, groupEntities := range s.cache {
+			index := make(groupIndex, len(groupEntities))
+			for id, entity := range groupEntities {
+				index.add(indexFunc(entity), id)
+			}
+			groupIndexes, ok := s.indexes[groupID]
+			if !ok {
+				groupIndexes = make(map[string]groupIndex)
+				s.indexes[groupID] = groupIndexes
+			}
+			groupIndexes[name] = index
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (c *defaultGroupedCache[T]) GetByIndex(groupID snowflake.ID, index string, key string) (T, bool) {
+	s := c.stripe(groupID)
+	if s.orders != nil {
+		// getting an entity changes the access order, so we need a write lock
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		id, ok := s.lookup(groupID, index, key)
+		if !ok {
+			var entity T
+			return entity, false
+		}
+		s.touch(groupID, id)
+		return s.cache[groupID][id], true
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	id, ok := s.lookup(groupID, index, key)
+	if !ok {
+		var entity T
+		return entity, false
+	}
+	return s.cache[groupID][id], true
+}
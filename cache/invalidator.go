@@ -0,0 +1,210 @@
+package cache
+
+import (
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// Invalidation tells other processes to drop an entity from their local cache because it was changed or removed.
+type Invalidation struct {
+	// Cache is the name of the cache, e.g. "guilds" or "members". These are the same names as used by Caches.Stats.
+	Cache string `json:"cache"`
+	// GroupID is the group of the entity. It is 0 for caches which are not grouped.
+	GroupID snowflake.ID `json:"group_id"`
+	// ID is the ID of the entity. If it and IDs are empty, the whole group is dropped.
+	ID snowflake.ID `json:"id"`
+	// IDs are the IDs of multiple entities of the group which are dropped at once, e.g. after GroupedCache.PutAll.
+	IDs []snowflake.ID `json:"ids,omitempty"`
+}
+
+// EntityIDs returns the IDs of all entities dropped by the Invalidation. It is empty if the whole group is dropped.
+func (i Invalidation) EntityIDs() []snowflake.ID {
+	if i.ID == 0 {
+		return i.IDs
+	}
+	return append([]snowflake.ID{i.ID}, i.IDs...)
+}
+
+// Invalidator distributes Invalidation(s) between multiple processes running their own Caches, e.g. via redis pub/sub or NATS.
+// Every change of the local Caches is published, and Invalidation(s) received from other processes remove the entity from the local Caches.
+type Invalidator interface {
+	// Publish sends the Invalidation to all other processes.
+	// It is called from within the cache operations, so it should not block. Errors need to be handled by the implementation.
+	Publish(invalidation Invalidation)
+
+	// Subscribe registers the handler which is called with every Invalidation published by other processes.
+	// Invalidation(s) published by the same Invalidator must not be passed to the handler.
+	Subscribe(handler func(invalidation Invalidation))
+}
+
+// invalidatingCache publishes an Invalidation for every change of the wrapped Cache.
+type invalidatingCache[T any] struct {
+	Cache[T]
+	name        string
+	invalidator Invalidator
+}
+
+func (c *invalidatingCache[T]) publish(id snowflake.ID) {
+	c.invalidator.Publish(Invalidation{Cache: c.name, ID: id})
+}
+
+func (c *invalidatingCache[T]) Put(id snowflake.ID, entity T) {
+	c.Cache.Put(id, entity)
+	c.publish(id)
+}
+
+func (c *invalidatingCache[T]) GetOrPut(id snowflake.ID, supplier func() T) (T, bool) {
+	entity, ok := c.Cache.GetOrPut(id, supplier)
+	if !ok {
+		c.publish(id)
+	}
+	return entity, ok
+}
+
+func (c *invalidatingCache[T]) PutIfAbsent(id snowflake.ID, entity T) bool {
+	ok := c.Cache.PutIfAbsent(id, entity)
+	if ok {
+		c.publish(id)
+	}
+	return ok
+}
+
+func (c *invalidatingCache[T]) Compute(id snowflake.ID, computeFunc ComputeFunc[T]) (T, bool) {
+	var exists, store bool
+	entity, ok := c.Cache.Compute(id, func(entity T, ok bool) (T, bool) {
+		exists = ok
+		entity, store = computeFunc(entity, ok)
+		return entity, store
+	})
+	if ok || exists && !store {
+		c.publish(id)
+	}
+	return entity, ok
+}
+
+func (c *invalidatingCache[T]) Remove(id snowflake.ID) (T, bool) {
+	entity, ok := c.Cache.Remove(id)
+	if ok {
+		c.publish(id)
+	}
+	return entity, ok
+}
+
+func (c *invalidatingCache[T]) RemoveIf(filterFunc FilterFunc[T]) {
+	// the FilterFunc doesn't know the IDs of the entities, so we look them up first
+	var ids []snowflake.ID
+	c.Cache.Iter()(func(id snowflake.ID, entity T) bool {
+		if filterFunc(entity) {
+			ids = append(ids, id)
+		}
+		return true
+	})
+	for _, id := range ids {
+		c.Remove(id)
+	}
+}
+
+// invalidatingGroupedCache publishes an Invalidation for every change of the wrapped GroupedCache.
+type invalidatingGroupedCache[T any] struct {
+	GroupedCache[T]
+	name        string
+	invalidator Invalidator
+}
+
+func (c *invalidatingGroupedCache[T]) publish(groupID snowflake.ID, id snowflake.ID) {
+	c.invalidator.Publish(Invalidation{Cache: c.name, GroupID: groupID, ID: id})
+}
+
+func (c *invalidatingGroupedCache[T]) Put(groupID snowflake.ID, id snowflake.ID, entity T) {
+	c.GroupedCache.Put(groupID, id, entity)
+	c.publish(groupID, id)
+}
+
+func (c *invalidatingGroupedCache[T]) GetOrPut(groupID snowflake.ID, id snowflake.ID, supplier func() T) (T, bool) {
+	entity, ok := c.GroupedCache.GetOrPut(groupID, id, supplier)
+	if !ok {
+		c.publish(groupID, id)
+	}
+	return entity, ok
+}
+
+func (c *invalidatingGroupedCache[T]) PutIfAbsent(groupID snowflake.ID, id snowflake.ID, entity T) bool {
+	ok := c.GroupedCache.PutIfAbsent(groupID, id, entity)
+	if ok {
+		c.publish(groupID, id)
+	}
+	return ok
+}
+
+func (c *invalidatingGroupedCache[T]) Compute(groupID snowflake.ID, id snowflake.ID, computeFunc ComputeFunc[T]) (T, bool) {
+	var exists, store bool
+	entity, ok := c.GroupedCache.Compute(groupID, id, func(entity T, ok bool) (T, bool) {
+		exists = ok
+		entity, store = computeFunc(entity, ok)
+		return entity, store
+	})
+	if ok || exists && !store {
+		c.publish(groupID, id)
+	}
+	return entity, ok
+}
+
+func (c *invalidatingGroupedCache[T]) Remove(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	entity, ok := c.GroupedCache.Remove(groupID, id)
+	if ok {
+		c.publish(groupID, id)
+	}
+	return entity, ok
+}
+
+// publishMany publishes a single Invalidation for all given IDs of the group.
+func (c *invalidatingGroupedCache[T]) publishMany(groupID snowflake.ID, ids []snowflake.ID) {
+	if len(ids) == 0 {
+		return
+	}
+	c.invalidator.Publish(Invalidation{Cache: c.name, GroupID: groupID, IDs: ids})
+}
+
+func (c *invalidatingGroupedCache[T]) PutAll(groupID snowflake.ID, entities map[snowflake.ID]T) {
+	c.GroupedCache.PutAll(groupID, entities)
+	ids := make([]snowflake.ID, 0, len(entities))
+	for id := range entities {
+		ids = append(ids, id)
+	}
+	c.publishMany(groupID, ids)
+}
+
+func (c *invalidatingGroupedCache[T]) RemoveMany(groupID snowflake.ID, ids []snowflake.ID) {
+	c.GroupedCache.RemoveMany(groupID, ids)
+	c.publishMany(groupID, ids)
+}
+
+func (c *invalidatingGroupedCache[T]) RemoveAll(groupID snowflake.ID) {
+	c.GroupedCache.RemoveAll(groupID)
+	c.publish(groupID, 0)
+}
+
+func (c *invalidatingGroupedCache[T]) RemoveIf(filterFunc GroupedFilterFunc[T]) {
+	// the GroupedFilterFunc doesn't know the IDs of the entities, so we look them up first
+	for groupID, groupEntities := range c.GroupedCache.MapAll() {
+		for id, entity := range groupEntities {
+			if filterFunc(groupID, entity) {
+				c.Remove(groupID, id)
+			}
+		}
+	}
+}
+
+func (c *invalidatingGroupedCache[T]) GroupRemoveIf(groupID snowflake.ID, filterFunc GroupedFilterFunc[T]) {
+	for id, entity := range c.GroupedCache.MapGroupAll(groupID) {
+		if filterFunc(groupID, entity) {
+			c.Remove(groupID, id)
+		}
+	}
+}
+
+// invalidate applies an Invalidation received from another process to the local Caches.
+func (c *cachesImpl) invalidate(invalidation Invalidation) {
+	if invalidate, ok := c.invalidators[invalidation.Cache]; ok {
+		invalidate(invalidation)
+	}
+}
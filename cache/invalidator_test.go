@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// testChannel forwards the Invalidation(s) of a publishing process to a subscribing process in memory.
+type testChannel struct {
+	published []Invalidation
+	handler   func(invalidation Invalidation)
+}
+
+// testPublisher is the Invalidator of the publishing process.
+type testPublisher struct {
+	*testChannel
+}
+
+func (p testPublisher) Publish(invalidation Invalidation) {
+	p.published = append(p.published, invalidation)
+	if p.handler != nil {
+		p.handler(invalidation)
+	}
+}
+
+func (testPublisher) Subscribe(func(invalidation Invalidation)) {}
+
+// testSubscriber is the Invalidator of the subscribing process.
+type testSubscriber struct {
+	*testChannel
+}
+
+func (testSubscriber) Publish(Invalidation) {}
+
+func (s testSubscriber) Subscribe(handler func(invalidation Invalidation)) {
+	s.handler = handler
+}
+
+func TestInvalidatingGroupedCache_Batches(t *testing.T) {
+	channel := &testChannel{}
+	publisher := New(WithCacheFlags(FlagRoles), WithInvalidator(testPublisher{channel}))
+	subscriber := New(WithCacheFlags(FlagRoles), WithInvalidator(testSubscriber{channel}))
+
+	guildID := snowflake.ID(1)
+	roles := map[snowflake.ID]discord.Role{2: {ID: 2}, 3: {ID: 3}, 4: {ID: 4}}
+
+	subscriber.Roles().PutAll(guildID, roles)
+	publisher.Roles().PutAll(guildID, roles)
+	if assert.Len(t, channel.published, 1, "PutAll published more than one invalidation") {
+		assert.ElementsMatch(t, []snowflake.ID{2, 3, 4}, channel.published[0].EntityIDs())
+	}
+	assert.Equal(t, 0, subscriber.Roles().GroupLen(guildID))
+
+	subscriber.Roles().PutAll(guildID, roles)
+	publisher.Roles().RemoveMany(guildID, []snowflake.ID{2, 3})
+	assert.Len(t, channel.published, 2, "RemoveMany published more than one invalidation")
+	assert.Equal(t, 1, subscriber.Roles().GroupLen(guildID))
+
+	publisher.Roles().RemoveAll(guildID)
+	assert.Empty(t, channel.published[len(channel.published)-1].EntityIDs())
+	assert.Equal(t, 0, subscriber.Roles().GroupLen(guildID))
+}
+
+func TestInvalidation_EntityIDs(t *testing.T) {
+	assert.Equal(t, []snowflake.ID{1}, Invalidation{ID: 1}.EntityIDs())
+	assert.Equal(t, []snowflake.ID{1, 2, 3}, Invalidation{ID: 1, IDs: []snowflake.ID{2, 3}}.EntityIDs())
+	assert.Equal(t, []snowflake.ID{2, 3}, Invalidation{IDs: []snowflake.ID{2, 3}}.EntityIDs())
+	assert.Empty(t, Invalidation{GroupID: 1}.EntityIDs())
+}
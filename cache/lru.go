@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"container/list"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// EvictFunc is called with every entity which got evicted from a Cache or GroupedCache because it reached its maximum size.
+// For a Cache the groupID is always 0.
+type EvictFunc[T any] func(groupID snowflake.ID, id snowflake.ID, entity T)
+
+// evictFuncOf adapts an EvictFunc[any] to an EvictFunc[T].
+func evictFuncOf[T any](evictFunc EvictFunc[any]) EvictFunc[T] {
+	if evictFunc == nil {
+		return nil
+	}
+	return func(groupID snowflake.ID, id snowflake.ID, entity T) {
+		evictFunc(groupID, id, entity)
+	}
+}
+
+// Listener is called with an entity which was put into or removed from a Cache or GroupedCache.
+// For a Cache the groupID is always 0.
+type Listener[T any] func(groupID snowflake.ID, id snowflake.ID, entity T)
+
+// listenerOf adapts a Listener[any] to a Listener[T].
+func listenerOf[T any](listener Listener[any]) Listener[T] {
+	if listener == nil {
+		return nil
+	}
+	return func(groupID snowflake.ID, id snowflake.ID, entity T) {
+		listener(groupID, id, entity)
+	}
+}
+
+// lruOrder keeps track of the access order of the IDs in a cache.
+type lruOrder struct {
+	list     *list.List
+	elements map[snowflake.ID]*list.Element
+}
+
+func newLRUOrder() *lruOrder {
+	return &lruOrder{
+		list:     list.New(),
+		elements: map[snowflake.ID]*list.Element{},
+	}
+}
+
+// touch marks the given ID as most recently used.
+func (o *lruOrder) touch(id snowflake.ID) {
+	if element, ok := o.elements[id]; ok {
+		o.list.MoveToFront(element)
+		return
+	}
+	o.elements[id] = o.list.PushFront(id)
+}
+
+func (o *lruOrder) remove(id snowflake.ID) {
+	if element, ok := o.elements[id]; ok {
+		o.list.Remove(element)
+		delete(o.elements, id)
+	}
+}
+
+// oldest returns the least recently used ID.
+func (o *lruOrder) oldest() (snowflake.ID, bool) {
+	element := o.list.Back()
+	if element == nil {
+		return 0, false
+	}
+	return element.Value.(snowflake.ID), true
+}
@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRUCache(t *testing.T) {
+	var evicted []int
+	c := NewLRUCache[int](FlagsAll, FlagsNone, nil, 2, func(_ snowflake.ID, _ snowflake.ID, entity int) {
+		evicted = append(evicted, entity)
+	})
+
+	c.Put(1, 1)
+	c.Put(2, 2)
+	// getting 1 makes 2 the least recently used entity
+	_, ok := c.Get(1)
+	assert.True(t, ok)
+	c.Put(3, 3)
+	assert.Equal(t, []int{2}, evicted)
+	assert.Equal(t, 2, c.Len())
+
+	// updating an entity doesn't evict, but marks it as recently used
+	c.Put(1, 10)
+	c.Put(4, 4)
+	assert.Equal(t, []int{2, 3}, evicted)
+
+	// removed entities free their slot
+	c.Remove(1)
+	c.Put(5, 5)
+	assert.Equal(t, []int{2, 3}, evicted)
+	assert.ElementsMatch(t, []int{4, 5}, c.All())
+}
+
+func TestLRUGroupedCache(t *testing.T) {
+	var evicted []snowflake.ID
+	c := NewLRUGroupedCache[int](FlagsAll, FlagsNone, nil, 2, func(groupID snowflake.ID, id snowflake.ID, _ int) {
+		assert.Equal(t, snowflake.ID(1), groupID)
+		evicted = append(evicted, id)
+	})
+
+	// the limit applies to each group separately
+	c.Put(1, 1, 1)
+	c.Put(1, 2, 2)
+	c.Put(2, 1, 1)
+	c.Put(2, 2, 2)
+	assert.Empty(t, evicted)
+	assert.Equal(t, 4, c.Len())
+
+	_, ok := c.Get(1, 1)
+	assert.True(t, ok)
+	c.Put(1, 3, 3)
+	assert.Equal(t, []snowflake.ID{2}, evicted)
+	assert.Equal(t, 2, c.GroupLen(1))
+	assert.Equal(t, 2, c.GroupLen(2))
+
+	// GetOrPut & PutIfAbsent of existing entities mark them as recently used as well
+	c.GetOrPut(1, 1, func() int { return 0 })
+	assert.False(t, c.PutIfAbsent(1, 1, 0))
+	c.Put(1, 4, 4)
+	assert.Equal(t, []snowflake.ID{2, 3}, evicted)
+
+	c.RemoveAll(1)
+	c.Put(1, 5, 5)
+	c.Put(1, 6, 6)
+	assert.Equal(t, []snowflake.ID{2, 3}, evicted)
+}
+
+func TestCaches_MaxSize(t *testing.T) {
+	caches := New(WithCacheFlags(FlagGuilds, FlagRoles), WithMaxSize(2), WithMaxGroupSize(1))
+
+	for id := snowflake.ID(1); id <= 3; id++ {
+		caches.Guilds().Put(id, discord.Guild{ID: id})
+		caches.Roles().Put(1, id, discord.Role{ID: id})
+		caches.Roles().Put(id, id, discord.Role{ID: id})
+	}
+	assert.Equal(t, 2, caches.Guilds().Len())
+	_, ok := caches.Guilds().Get(1)
+	assert.False(t, ok)
+
+	// every guild holds one role
+	assert.Equal(t, 1, caches.Roles().GroupLen(1))
+	assert.Equal(t, 3, caches.Roles().Len())
+	_, ok = caches.Roles().Get(1, 3)
+	assert.True(t, ok)
+}
@@ -0,0 +1,77 @@
+package rediscache
+
+import (
+	"time"
+
+	"github.com/disgoorg/log"
+
+	"github.com/disgoorg/disgo/json"
+)
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() *Config {
+	return &Config{
+		Logger:  log.Default(),
+		Codec:   JSONCodec{},
+		Timeout: 5 * time.Second,
+	}
+}
+
+// Config lets you configure your GroupedCache instance.
+type Config struct {
+	Logger  log.Logger
+	Codec   Codec
+	Timeout time.Duration
+}
+
+// ConfigOpt is a type alias for a function that takes a Config and is used to configure your GroupedCache.
+type ConfigOpt func(config *Config)
+
+// Apply applies the given ConfigOpt(s) to the Config
+func (c *Config) Apply(opts []ConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithLogger lets you inject your own logger implementing log.Logger.
+// It is used to log redis errors as the cache.GroupedCache interface can't return them.
+func WithLogger(logger log.Logger) ConfigOpt {
+	return func(config *Config) {
+		config.Logger = logger
+	}
+}
+
+// WithCodec sets the Codec used to (de)serialize the cached entities.
+func WithCodec(codec Codec) ConfigOpt {
+	return func(config *Config) {
+		config.Codec = codec
+	}
+}
+
+// WithTimeout sets the timeout of every redis operation.
+func WithTimeout(timeout time.Duration) ConfigOpt {
+	return func(config *Config) {
+		config.Timeout = timeout
+	}
+}
+
+// Codec is used to (de)serialize the entities stored in redis.
+type Codec interface {
+	// Marshal serializes the given value.
+	Marshal(v any) ([]byte, error)
+
+	// Unmarshal deserializes the given data into v.
+	Unmarshal(data []byte, v any) error
+}
+
+// JSONCodec is a Codec using the json package.
+type JSONCodec struct{}
+
+func (JSONCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (JSONCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,614 @@
+// Package rediscache provides a cache.GroupedCache implementation backed by redis, so the cache can be shared by multiple processes,
+// and a cache.Invalidator using redis pub/sub to keep the local caches of multiple processes consistent.
+package rediscache
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/disgoorg/disgo/cache"
+)
+
+var _ cache.GroupedCache[any] = (*RedisGroupedCache[any])(nil)
+
+// NewGroupedCache returns a new RedisGroupedCache which stores each group as a redis hash under the key "<prefix>:<groupID>".
+// The IDs of all groups are tracked in the set "<prefix>:groups". Like cache.NewGroupedCache the entities are filtered after the given cache.Flags and cache.Policy.
+// Closing the RedisGroupedCache is not needed and does not close the redis.UniversalClient.
+func NewGroupedCache[T any](client redis.UniversalClient, prefix string, flags cache.Flags, neededFlags cache.Flags, policy cache.Policy[T], opts ...ConfigOpt) *RedisGroupedCache[T] {
+	config := DefaultConfig()
+	config.Apply(opts)
+
+	return &RedisGroupedCache[T]{
+		config:      *config,
+		client:      client,
+		prefix:      prefix,
+		flags:       flags,
+		neededFlags: neededFlags,
+		policy:      policy,
+		indexFuncs:  map[string]cache.IndexFunc[T]{},
+	}
+}
+
+// RedisGroupedCache is a cache.GroupedCache storing its entities in redis hashes.
+// As the cache.GroupedCache interface can't return errors, redis errors are logged and the cache behaves as if the entity was not found.
+// As an injected cache it is not observed by the cache.Listener(s) & cache.EvictFunc of the cache.Config, and it never evicts entities.
+type RedisGroupedCache[T any] struct {
+	config      Config
+	client      redis.UniversalClient
+	prefix      string
+	flags       cache.Flags
+	neededFlags cache.Flags
+	policy      cache.Policy[T]
+
+	indexMu    sync.RWMutex
+	indexFuncs map[string]cache.IndexFunc[T]
+}
+
+func (c *RedisGroupedCache[T]) groupsKey() string {
+	return c.prefix + ":groups"
+}
+
+func (c *RedisGroupedCache[T]) groupKey(groupID snowflake.ID) string {
+	return c.prefix + ":" + groupID.String()
+}
+
+func (c *RedisGroupedCache[T]) ctx() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), c.config.Timeout)
+}
+
+func (c *RedisGroupedCache[T]) decode(data string) (T, bool) {
+	var entity T
+	if err := c.config.Codec.Unmarshal([]byte(data), &entity); err != nil {
+		c.config.Logger.Errorf("failed to decode cached entity in %s: %s", c.prefix, err)
+		return entity, false
+	}
+	return entity, true
+}
+
+func (c *RedisGroupedCache[T]) groupIDs(ctx context.Context) []snowflake.ID {
+	members, err := c.client.SMembers(ctx, c.groupsKey()).Result()
+	if err != nil {
+		c.config.Logger.Errorf("failed to get groups of %s: %s", c.prefix, err)
+		return nil
+	}
+	groupIDs := make([]snowflake.ID, 0, len(members))
+	for _, member := range members {
+		groupID, err := snowflake.Parse(member)
+		if err != nil {
+			continue
+		}
+		groupIDs = append(groupIDs, groupID)
+	}
+	return groupIDs
+}
+
+func (c *RedisGroupedCache[T]) group(ctx context.Context, groupID snowflake.ID) map[snowflake.ID]T {
+	values, err := c.client.HGetAll(ctx, c.groupKey(groupID)).Result()
+	if err != nil {
+		c.config.Logger.Errorf("failed to get group %s of %s: %s", groupID, c.prefix, err)
+		return nil
+	}
+	entities := make(map[snowflake.ID]T, len(values))
+	for field, value := range values {
+		id, err := snowflake.Parse(field)
+		if err != nil {
+			continue
+		}
+		if entity, ok := c.decode(value); ok {
+			entities[id] = entity
+		}
+	}
+	return entities
+}
+
+func (c *RedisGroupedCache[T]) Get(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	value, err := c.client.HGet(ctx, c.groupKey(groupID), id.String()).Result()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			c.config.Logger.Errorf("failed to get %s from group %s of %s: %s", id, groupID, c.prefix, err)
+		}
+		var entity T
+		return entity, false
+	}
+	return c.decode(value)
+}
+
+func (c *RedisGroupedCache[T]) Put(groupID snowflake.ID, id snowflake.ID, entity T) {
+	if !c.allowed(entity) {
+		return
+	}
+	data, err := c.config.Codec.Marshal(entity)
+	if err != nil {
+		c.config.Logger.Errorf("failed to encode entity %s of %s: %s", id, c.prefix, err)
+		return
+	}
+
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	if _, err = c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, c.groupKey(groupID), id.String(), data)
+		pipe.SAdd(ctx, c.groupsKey(), groupID.String())
+		return nil
+	}); err != nil {
+		c.config.Logger.Errorf("failed to put %s into group %s of %s: %s", id, groupID, c.prefix, err)
+	}
+}
+
+func (c *RedisGroupedCache[T]) PutAll(groupID snowflake.ID, entities map[snowflake.ID]T) {
+	values := make([]any, 0, len(entities)*2)
+	for id, entity := range entities {
+		if !c.allowed(entity) {
+			continue
+		}
+		data, err := c.config.Codec.Marshal(entity)
+		if err != nil {
+			c.config.Logger.Errorf("failed to encode entity %s of %s: %s", id, c.prefix, err)
+			continue
+		}
+		values = append(values, id.String(), data)
+	}
+	if len(values) == 0 {
+		return
+	}
+
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	if _, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, c.groupKey(groupID), values...)
+		pipe.SAdd(ctx, c.groupsKey(), groupID.String())
+		return nil
+	}); err != nil {
+		c.config.Logger.Errorf("failed to put %d entities into group %s of %s: %s", len(values)/2, groupID, c.prefix, err)
+	}
+}
+
+func (c *RedisGroupedCache[T]) GetOrPut(groupID snowflake.ID, id snowflake.ID, supplier func() T) (T, bool) {
+	if entity, ok := c.Get(groupID, id); ok {
+		return entity, true
+	}
+	entity := supplier()
+	if c.PutIfAbsent(groupID, id, entity) {
+		return entity, false
+	}
+	// another process stored the entity in the meantime
+	if existing, ok := c.Get(groupID, id); ok {
+		return existing, true
+	}
+	return entity, false
+}
+
+func (c *RedisGroupedCache[T]) PutIfAbsent(groupID snowflake.ID, id snowflake.ID, entity T) bool {
+	if !c.allowed(entity) {
+		return false
+	}
+	data, err := c.config.Codec.Marshal(entity)
+	if err != nil {
+		c.config.Logger.Errorf("failed to encode entity %s of %s: %s", id, c.prefix, err)
+		return false
+	}
+
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	var set *redis.BoolCmd
+	if _, err = c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		set = pipe.HSetNX(ctx, c.groupKey(groupID), id.String(), data)
+		pipe.SAdd(ctx, c.groupsKey(), groupID.String())
+		return nil
+	}); err != nil {
+		c.config.Logger.Errorf("failed to put %s into group %s of %s: %s", id, groupID, c.prefix, err)
+		return false
+	}
+	return set.Val()
+}
+
+func (c *RedisGroupedCache[T]) allowed(entity T) bool {
+	if c.neededFlags != cache.FlagsNone && c.flags.Missing(c.neededFlags) {
+		return false
+	}
+	return c.policy == nil || c.policy(entity)
+}
+
+// maxComputeTries is the number of times Compute retries the optimistic transaction if the entity was modified concurrently.
+const maxComputeTries = 10
+
+// Compute updates the entity in an optimistic transaction which is retried if another process modified the group in the meantime.
+// This means the cache.ComputeFunc may be called more than once.
+func (c *RedisGroupedCache[T]) Compute(groupID snowflake.ID, id snowflake.ID, computeFunc cache.ComputeFunc[T]) (T, bool) {
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	key := c.groupKey(groupID)
+	var (
+		entity T
+		stored bool
+	)
+	for i := 0; i < maxComputeTries; i++ {
+		err := c.client.Watch(ctx, func(tx *redis.Tx) error {
+			var (
+				old    T
+				exists bool
+			)
+			value, err := tx.HGet(ctx, key, id.String()).Result()
+			if err == nil {
+				old, exists = c.decode(value)
+			} else if !errors.Is(err, redis.Nil) {
+				return err
+			}
+
+			entity, stored = computeFunc(old, exists)
+			if stored && !c.allowed(entity) {
+				stored = false
+				return nil
+			}
+			var data []byte
+			if stored {
+				if data, err = c.config.Codec.Marshal(entity); err != nil {
+					stored = false
+					return err
+				}
+			} else if !exists {
+				return nil
+			}
+
+			_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				if stored {
+					pipe.HSet(ctx, key, id.String(), data)
+					pipe.SAdd(ctx, c.groupsKey(), groupID.String())
+				} else {
+					pipe.Eval(ctx, removeManySource, []string{key, c.groupsKey()}, groupID.String(), id.String())
+				}
+				return nil
+			})
+			return err
+		}, key)
+		if errors.Is(err, redis.TxFailedErr) {
+			continue
+		}
+		if err != nil {
+			c.config.Logger.Errorf("failed to compute %s in group %s of %s: %s", id, groupID, c.prefix, err)
+			return entity, false
+		}
+		return entity, stored
+	}
+	c.config.Logger.Errorf("failed to compute %s in group %s of %s: too many concurrent modifications", id, groupID, c.prefix)
+	return entity, false
+}
+
+// removeScript removes the field ARGV[2] from the group hash KEYS[1] and returns its value or nil if it was not present.
+// The group ARGV[1] is removed from the groups set KEYS[2] once its hash is empty.
+var removeScript = redis.NewScript(`
+local value = redis.call('HGET', KEYS[1], ARGV[2])
+if not value then
+	return false
+end
+redis.call('HDEL', KEYS[1], ARGV[2])
+if redis.call('HLEN', KEYS[1]) == 0 then
+	redis.call('SREM', KEYS[2], ARGV[1])
+end
+return value
+`)
+
+// removeManySource removes the fields ARGV[2..n] from the group hash KEYS[1] and returns the number of removed fields.
+// The group ARGV[1] is removed from the groups set KEYS[2] once its hash is empty.
+const removeManySource = `
+local removed = 0
+for i = 2, #ARGV do
+	removed = removed + redis.call('HDEL', KEYS[1], ARGV[i])
+end
+if redis.call('HLEN', KEYS[1]) == 0 then
+	redis.call('SREM', KEYS[2], ARGV[1])
+end
+return removed
+`
+
+var removeManyScript = redis.NewScript(removeManySource)
+
+func (c *RedisGroupedCache[T]) Remove(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	value, err := removeScript.Run(ctx, c.client, []string{c.groupKey(groupID), c.groupsKey()}, groupID.String(), id.String()).Text()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			c.config.Logger.Errorf("failed to remove %s from group %s of %s: %s", id, groupID, c.prefix, err)
+		}
+		var entity T
+		return entity, false
+	}
+	// the entity was removed even if it can't be decoded anymore
+	entity, _ := c.decode(value)
+	return entity, true
+}
+
+func (c *RedisGroupedCache[T]) RemoveMany(groupID snowflake.ID, ids []snowflake.ID) {
+	if len(ids) == 0 {
+		return
+	}
+	fields := make([]string, len(ids))
+	for i, id := range ids {
+		fields[i] = id.String()
+	}
+
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	c.removeFields(ctx, groupID, fields)
+}
+
+// removeFields removes the given fields from the group and the group from the groups set once it is empty.
+func (c *RedisGroupedCache[T]) removeFields(ctx context.Context, groupID snowflake.ID, fields []string) {
+	args := make([]any, 0, len(fields)+1)
+	args = append(args, groupID.String())
+	for _, field := range fields {
+		args = append(args, field)
+	}
+	if err := removeManyScript.Run(ctx, c.client, []string{c.groupKey(groupID), c.groupsKey()}, args...).Err(); err != nil {
+		c.config.Logger.Errorf("failed to remove %d entities from group %s of %s: %s", len(fields), groupID, c.prefix, err)
+	}
+}
+
+func (c *RedisGroupedCache[T]) RemoveAll(groupID snowflake.ID) {
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	if _, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, c.groupKey(groupID))
+		pipe.SRem(ctx, c.groupsKey(), groupID.String())
+		return nil
+	}); err != nil {
+		c.config.Logger.Errorf("failed to remove group %s of %s: %s", groupID, c.prefix, err)
+	}
+}
+
+func (c *RedisGroupedCache[T]) RemoveIf(filterFunc cache.GroupedFilterFunc[T]) {
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	for _, groupID := range c.groupIDs(ctx) {
+		c.groupRemoveIf(ctx, groupID, filterFunc)
+	}
+}
+
+func (c *RedisGroupedCache[T]) GroupRemoveIf(groupID snowflake.ID, filterFunc cache.GroupedFilterFunc[T]) {
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	c.groupRemoveIf(ctx, groupID, filterFunc)
+}
+
+func (c *RedisGroupedCache[T]) groupRemoveIf(ctx context.Context, groupID snowflake.ID, filterFunc cache.GroupedFilterFunc[T]) {
+	var fields []string
+	for id, entity := range c.group(ctx, groupID) {
+		if filterFunc(groupID, entity) {
+			fields = append(fields, id.String())
+		}
+	}
+	if len(fields) == 0 {
+		return
+	}
+	c.removeFields(ctx, groupID, fields)
+}
+
+func (c *RedisGroupedCache[T]) Len() int {
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	groupIDs := c.groupIDs(ctx)
+	cmds := make([]*redis.IntCmd, len(groupIDs))
+	if _, err := c.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, groupID := range groupIDs {
+			cmds[i] = pipe.HLen(ctx, c.groupKey(groupID))
+		}
+		return nil
+	}); err != nil {
+		c.config.Logger.Errorf("failed to get length of %s: %s", c.prefix, err)
+		return 0
+	}
+
+	var totalLen int
+	for _, cmd := range cmds {
+		totalLen += int(cmd.Val())
+	}
+	return totalLen
+}
+
+func (c *RedisGroupedCache[T]) GroupLen(groupID snowflake.ID) int {
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	groupLen, err := c.client.HLen(ctx, c.groupKey(groupID)).Result()
+	if err != nil {
+		c.config.Logger.Errorf("failed to get length of group %s of %s: %s", groupID, c.prefix, err)
+		return 0
+	}
+	return int(groupLen)
+}
+
+func (c *RedisGroupedCache[T]) GroupIDs() []snowflake.ID {
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	return c.groupIDs(ctx)
+}
+
+func (c *RedisGroupedCache[T]) All() map[snowflake.ID][]T {
+	all := make(map[snowflake.ID][]T)
+	for groupID, groupEntities := range c.MapAll() {
+		all[groupID] = make([]T, 0, len(groupEntities))
+		for _, entity := range groupEntities {
+			all[groupID] = append(all[groupID], entity)
+		}
+	}
+	return all
+}
+
+func (c *RedisGroupedCache[T]) GroupAll(groupID snowflake.ID) []T {
+	groupEntities := c.MapGroupAll(groupID)
+	if groupEntities == nil {
+		return nil
+	}
+	all := make([]T, 0, len(groupEntities))
+	for _, entity := range groupEntities {
+		all = append(all, entity)
+	}
+	return all
+}
+
+func (c *RedisGroupedCache[T]) MapAll() map[snowflake.ID]map[snowflake.ID]T {
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	all := make(map[snowflake.ID]map[snowflake.ID]T)
+	for _, groupID := range c.groupIDs(ctx) {
+		if groupEntities := c.group(ctx, groupID); len(groupEntities) > 0 {
+			all[groupID] = groupEntities
+		}
+	}
+	return all
+}
+
+func (c *RedisGroupedCache[T]) MapGroupAll(groupID snowflake.ID) map[snowflake.ID]T {
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	groupEntities := c.group(ctx, groupID)
+	if len(groupEntities) == 0 {
+		return nil
+	}
+	return groupEntities
+}
+
+func (c *RedisGroupedCache[T]) FindFirst(cacheFindFunc cache.GroupedFilterFunc[T]) (T, bool) {
+	for groupID, groupEntities := range c.MapAll() {
+		for _, entity := range groupEntities {
+			if cacheFindFunc(groupID, entity) {
+				return entity, true
+			}
+		}
+	}
+	var entity T
+	return entity, false
+}
+
+func (c *RedisGroupedCache[T]) GroupFindFirst(groupID snowflake.ID, cacheFindFunc cache.GroupedFilterFunc[T]) (T, bool) {
+	for _, entity := range c.MapGroupAll(groupID) {
+		if cacheFindFunc(groupID, entity) {
+			return entity, true
+		}
+	}
+	var entity T
+	return entity, false
+}
+
+func (c *RedisGroupedCache[T]) FindAll(cacheFindFunc cache.GroupedFilterFunc[T]) []T {
+	all := make([]T, 0)
+	for groupID, groupEntities := range c.MapAll() {
+		for _, entity := range groupEntities {
+			if cacheFindFunc(groupID, entity) {
+				all = append(all, entity)
+			}
+		}
+	}
+	return all
+}
+
+func (c *RedisGroupedCache[T]) GroupFindAll(groupID snowflake.ID, cacheFindFunc cache.GroupedFilterFunc[T]) []T {
+	all := make([]T, 0)
+	for _, entity := range c.MapGroupAll(groupID) {
+		if cacheFindFunc(groupID, entity) {
+			all = append(all, entity)
+		}
+	}
+	return all
+}
+
+func (c *RedisGroupedCache[T]) ForEach(forEachFunc func(groupID snowflake.ID, entity T)) {
+	for groupID, groupEntities := range c.MapAll() {
+		for _, entity := range groupEntities {
+			forEachFunc(groupID, entity)
+		}
+	}
+}
+
+func (c *RedisGroupedCache[T]) GroupForEach(groupID snowflake.ID, forEachFunc func(entity T)) {
+	for _, entity := range c.MapGroupAll(groupID) {
+		forEachFunc(entity)
+	}
+}
+
+// AddIndex registers the secondary index locally. The index is not stored in redis, so GetByIndex scans the group instead.
+func (c *RedisGroupedCache[T]) AddIndex(name string, indexFunc cache.IndexFunc[T]) {
+	c.indexMu.Lock()
+	defer c.indexMu.Unlock()
+	c.indexFuncs[name] = indexFunc
+}
+
+func (c *RedisGroupedCache[T]) GetByIndex(groupID snowflake.ID, index string, key string) (T, bool) {
+	c.indexMu.RLock()
+	indexFunc, ok := c.indexFuncs[index]
+	c.indexMu.RUnlock()
+
+	var (
+		entity T
+		found  snowflake.ID
+	)
+	if !ok || key == "" {
+		return entity, false
+	}
+	for id, e := range c.MapGroupAll(groupID) {
+		if (found == 0 || id < found) && indexFunc(e) == key {
+			entity = e
+			found = id
+		}
+	}
+	return entity, found != 0
+}
+
+// Iter returns an iterator over all entities in the cache with their groupID.
+// Each group is fetched from redis once the iteration reaches it, so no lock is held while iterating.
+func (c *RedisGroupedCache[T]) Iter() func(yield func(groupID snowflake.ID, entity T) bool) {
+	return func(yield func(groupID snowflake.ID, entity T) bool) {
+		ctx, cancel := c.ctx()
+		groupIDs := c.groupIDs(ctx)
+		cancel()
+
+		for _, groupID := range groupIDs {
+			for _, entity := range c.MapGroupAll(groupID) {
+				if !yield(groupID, entity) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// IterGroup returns an iterator over all entities in the cache within the groupID with their ID.
+// The group is fetched from redis once the iteration starts, so no lock is held while iterating.
+func (c *RedisGroupedCache[T]) IterGroup(groupID snowflake.ID) func(yield func(id snowflake.ID, entity T) bool) {
+	return func(yield func(id snowflake.ID, entity T) bool) {
+		for id, entity := range c.MapGroupAll(groupID) {
+			if !yield(id, entity) {
+				return
+			}
+		}
+	}
+}
+
+// Snapshot fetches all groups from redis, so the returned cache.GroupedView is a copy independent of the cache.
+func (c *RedisGroupedCache[T]) Snapshot() cache.GroupedView[T] {
+	return cache.NewGroupedView(c.MapAll())
+}
+
+func (c *RedisGroupedCache[T]) GroupSnapshot(groupID snowflake.ID) cache.View[T] {
+	return cache.NewView(c.MapGroupAll(groupID))
+}
@@ -0,0 +1,157 @@
+package rediscache
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo/cache"
+)
+
+type testEntity struct {
+	ID   snowflake.ID `json:"id"`
+	Name string       `json:"name"`
+}
+
+func newTestClient(t *testing.T) (*miniredis.Miniredis, redis.UniversalClient) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return server, client
+}
+
+func newTestCache(t *testing.T, policy cache.Policy[testEntity]) (*miniredis.Miniredis, *RedisGroupedCache[testEntity]) {
+	server, client := newTestClient(t)
+	return server, NewGroupedCache[testEntity](client, "test", cache.FlagsNone, cache.FlagsNone, policy)
+}
+
+func TestRedisGroupedCache_PutGetRemove(t *testing.T) {
+	server, c := newTestCache(t, nil)
+
+	c.Put(1, 2, testEntity{ID: 2, Name: "a"})
+	entity, ok := c.Get(1, 2)
+	assert.True(t, ok)
+	assert.Equal(t, testEntity{ID: 2, Name: "a"}, entity)
+	assert.True(t, server.Exists("test:1"))
+	assert.Equal(t, []snowflake.ID{1}, c.GroupIDs())
+
+	_, ok = c.Get(1, 3)
+	assert.False(t, ok)
+
+	entity, ok = c.Remove(1, 2)
+	assert.True(t, ok)
+	assert.Equal(t, "a", entity.Name)
+	_, ok = c.Remove(1, 2)
+	assert.False(t, ok)
+	// empty groups are removed from the groups set
+	assert.Empty(t, c.GroupIDs())
+}
+
+func TestRedisGroupedCache_Policy(t *testing.T) {
+	_, c := newTestCache(t, func(entity testEntity) bool {
+		return entity.Name != "ignored"
+	})
+
+	c.Put(1, 2, testEntity{ID: 2, Name: "ignored"})
+	assert.False(t, c.PutIfAbsent(1, 3, testEntity{ID: 3, Name: "ignored"}))
+	c.PutAll(1, map[snowflake.ID]testEntity{4: {ID: 4, Name: "ignored"}, 5: {ID: 5}})
+	assert.Equal(t, 1, c.Len())
+	_, ok := c.Get(1, 5)
+	assert.True(t, ok)
+}
+
+func TestRedisGroupedCache_PutIfAbsent(t *testing.T) {
+	_, c := newTestCache(t, nil)
+
+	assert.True(t, c.PutIfAbsent(1, 2, testEntity{ID: 2, Name: "a"}))
+	assert.False(t, c.PutIfAbsent(1, 2, testEntity{ID: 2, Name: "b"}))
+
+	entity, ok := c.GetOrPut(1, 2, func() testEntity {
+		t.Fatal("supplier called for an existing entity")
+		return testEntity{}
+	})
+	assert.True(t, ok)
+	assert.Equal(t, "a", entity.Name)
+
+	entity, ok = c.GetOrPut(1, 3, func() testEntity {
+		return testEntity{ID: 3, Name: "c"}
+	})
+	assert.False(t, ok)
+	assert.Equal(t, "c", entity.Name)
+	assert.Equal(t, 2, c.GroupLen(1))
+}
+
+func TestRedisGroupedCache_Compute(t *testing.T) {
+	_, c := newTestCache(t, nil)
+
+	entity, ok := c.Compute(1, 2, func(entity testEntity, exists bool) (testEntity, bool) {
+		assert.False(t, exists)
+		return testEntity{ID: 2, Name: "a"}, true
+	})
+	assert.True(t, ok)
+	assert.Equal(t, "a", entity.Name)
+
+	entity, ok = c.Compute(1, 2, func(entity testEntity, exists bool) (testEntity, bool) {
+		assert.True(t, exists)
+		entity.Name += "b"
+		return entity, true
+	})
+	assert.True(t, ok)
+	assert.Equal(t, "ab", entity.Name)
+
+	_, ok = c.Compute(1, 2, func(entity testEntity, exists bool) (testEntity, bool) {
+		return entity, false
+	})
+	assert.False(t, ok)
+	_, ok = c.Get(1, 2)
+	assert.False(t, ok)
+	assert.Empty(t, c.GroupIDs())
+}
+
+func TestRedisGroupedCache_RemoveMany(t *testing.T) {
+	_, c := newTestCache(t, nil)
+
+	c.PutAll(1, map[snowflake.ID]testEntity{2: {ID: 2}, 3: {ID: 3}, 4: {ID: 4}})
+	c.PutAll(5, map[snowflake.ID]testEntity{6: {ID: 6, Name: "removed"}, 7: {ID: 7}})
+	c.Put(8, 9, testEntity{ID: 9})
+	assert.Equal(t, 6, c.Len())
+	assert.ElementsMatch(t, []snowflake.ID{1, 5, 8}, c.GroupIDs())
+
+	c.RemoveMany(1, []snowflake.ID{2, 3})
+	assert.Equal(t, 1, c.GroupLen(1))
+
+	c.RemoveIf(func(_ snowflake.ID, entity testEntity) bool {
+		return entity.Name == "removed"
+	})
+	assert.Equal(t, 1, c.GroupLen(5))
+
+	c.GroupRemoveIf(5, func(snowflake.ID, testEntity) bool {
+		return true
+	})
+	c.RemoveAll(8)
+	assert.Equal(t, []snowflake.ID{1}, c.GroupIDs())
+	assert.Equal(t, map[snowflake.ID]map[snowflake.ID]testEntity{1: {4: {ID: 4}}}, c.MapAll())
+}
+
+func TestRedisGroupedCache_Index(t *testing.T) {
+	_, c := newTestCache(t, nil)
+	c.AddIndex("name", func(entity testEntity) string {
+		return entity.Name
+	})
+
+	c.PutAll(1, map[snowflake.ID]testEntity{2: {ID: 2, Name: "a"}, 3: {ID: 3, Name: "a"}, 4: {ID: 4, Name: "b"}})
+	// the entity with the lowest ID wins
+	entity, ok := c.GetByIndex(1, "name", "a")
+	assert.True(t, ok)
+	assert.Equal(t, snowflake.ID(2), entity.ID)
+
+	_, ok = c.GetByIndex(1, "name", "c")
+	assert.False(t, ok)
+	_, ok = c.GetByIndex(1, "unknown", "a")
+	assert.False(t, ok)
+}
@@ -0,0 +1,205 @@
+package rediscache
+
+import (
+	"context"
+	"sync"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/internal/insecurerandstr"
+	"github.com/disgoorg/disgo/json"
+)
+
+// invalidationQueueSize is the number of Invalidation(s) which can be queued before Publish coalesces the queued Invalidation(s) of each group.
+const invalidationQueueSize = 1024
+
+var _ cache.Invalidator = (*RedisInvalidator)(nil)
+
+// NewInvalidator returns a new RedisInvalidator which distributes cache.Invalidation(s) via the given redis pub/sub channel.
+// Close needs to be called to stop publishing and receiving. It does not close the redis.UniversalClient.
+func NewInvalidator(client redis.UniversalClient, channel string, opts ...ConfigOpt) *RedisInvalidator {
+	config := DefaultConfig()
+	config.Apply(opts)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	i := &RedisInvalidator{
+		config:  *config,
+		client:  client,
+		channel: channel,
+		origin:  insecurerandstr.RandStr(16),
+		notify:  make(chan struct{}, 1),
+		cancel:  cancel,
+	}
+	i.wg.Add(1)
+	go i.publishLoop(ctx)
+	return i
+}
+
+// RedisInvalidator is a cache.Invalidator using redis pub/sub.
+// Invalidation(s) are published asynchronously in the order they were passed to Publish.
+// If publishing falls behind, the queued Invalidation(s) of the same cache & group are coalesced into one, so none are dropped.
+type RedisInvalidator struct {
+	config  Config
+	client  redis.UniversalClient
+	channel string
+	// origin identifies this RedisInvalidator, so it can ignore its own messages
+	origin string
+
+	queueMu sync.Mutex
+	queue   []cache.Invalidation
+	notify  chan struct{}
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+
+	pubSubMu sync.Mutex
+	pubSub   *redis.PubSub
+}
+
+type invalidationMessage struct {
+	Origin string `json:"origin"`
+	cache.Invalidation
+}
+
+// Publish queues the Invalidation and never blocks.
+func (i *RedisInvalidator) Publish(invalidation cache.Invalidation) {
+	i.queueMu.Lock()
+	i.queue = append(i.queue, invalidation)
+	if len(i.queue) > invalidationQueueSize {
+		i.queue = coalesce(i.queue)
+	}
+	i.queueMu.Unlock()
+
+	select {
+	case i.notify <- struct{}{}:
+	default:
+	}
+}
+
+// coalesce merges all Invalidation(s) of the same cache & group into the position of the first one.
+// A merged Invalidation drops the whole group if any of its Invalidation(s) did.
+func coalesce(invalidations []cache.Invalidation) []cache.Invalidation {
+	type groupKey struct {
+		cache   string
+		groupID snowflake.ID
+	}
+	type group struct {
+		index int
+		all   bool
+		ids   map[snowflake.ID]struct{}
+	}
+
+	var (
+		coalesced = make([]cache.Invalidation, 0, len(invalidations))
+		groups    = make(map[groupKey]*group)
+	)
+	for _, invalidation := range invalidations {
+		key := groupKey{cache: invalidation.Cache, groupID: invalidation.GroupID}
+		g, ok := groups[key]
+		if !ok {
+			g = &group{index: len(coalesced), ids: make(map[snowflake.ID]struct{})}
+			groups[key] = g
+			coalesced = append(coalesced, cache.Invalidation{Cache: invalidation.Cache, GroupID: invalidation.GroupID})
+		}
+		ids := invalidation.EntityIDs()
+		if len(ids) == 0 {
+			g.all = true
+		}
+		for _, id := range ids {
+			g.ids[id] = struct{}{}
+		}
+	}
+	for _, g := range groups {
+		if g.all {
+			continue
+		}
+		ids := make([]snowflake.ID, 0, len(g.ids))
+		for id := range g.ids {
+			ids = append(ids, id)
+		}
+		coalesced[g.index].IDs = ids
+	}
+	return coalesced
+}
+
+func (i *RedisInvalidator) publishLoop(ctx context.Context) {
+	defer i.wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-i.notify:
+			i.queueMu.Lock()
+			invalidations := i.queue
+			i.queue = nil
+			i.queueMu.Unlock()
+
+			i.publish(ctx, invalidations)
+		}
+	}
+}
+
+// publish publishes the given Invalidation(s) in order using a single pipeline.
+func (i *RedisInvalidator) publish(ctx context.Context, invalidations []cache.Invalidation) {
+	if len(invalidations) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, i.config.Timeout)
+	defer cancel()
+
+	if _, err := i.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, invalidation := range invalidations {
+			data, err := json.Marshal(invalidationMessage{
+				Origin:       i.origin,
+				Invalidation: invalidation,
+			})
+			if err != nil {
+				i.config.Logger.Errorf("failed to encode invalidation for %s: %s", invalidation.Cache, err)
+				continue
+			}
+			pipe.Publish(ctx, i.channel, data)
+		}
+		return nil
+	}); err != nil {
+		i.config.Logger.Errorf("failed to publish %d invalidations to %s: %s", len(invalidations), i.channel, err)
+	}
+}
+
+// Subscribe subscribes to the redis channel. It must only be called once.
+func (i *RedisInvalidator) Subscribe(handler func(invalidation cache.Invalidation)) {
+	i.pubSubMu.Lock()
+	defer i.pubSubMu.Unlock()
+	pubSub := i.client.Subscribe(context.Background(), i.channel)
+	i.pubSub = pubSub
+
+	i.wg.Add(1)
+	go func() {
+		defer i.wg.Done()
+		// the channel is closed when the PubSub is closed
+		for message := range pubSub.Channel() {
+			var v invalidationMessage
+			if err := json.Unmarshal([]byte(message.Payload), &v); err != nil {
+				i.config.Logger.Errorf("failed to decode invalidation from %s: %s", i.channel, err)
+				continue
+			}
+			if v.Origin == i.origin {
+				continue
+			}
+			handler(v.Invalidation)
+		}
+	}()
+}
+
+// Close stops publishing & receiving cache.Invalidation(s). Queued Invalidation(s) which were not published yet are dropped.
+func (i *RedisInvalidator) Close() error {
+	i.cancel()
+	i.pubSubMu.Lock()
+	var err error
+	if i.pubSub != nil {
+		err = i.pubSub.Close()
+	}
+	i.pubSubMu.Unlock()
+	i.wg.Wait()
+	return err
+}
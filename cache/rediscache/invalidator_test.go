@@ -0,0 +1,87 @@
+package rediscache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo/cache"
+)
+
+func TestRedisInvalidator(t *testing.T) {
+	_, client := newTestClient(t)
+	publisher := NewInvalidator(client, "invalidations")
+	defer publisher.Close()
+	subscriber := NewInvalidator(client, "invalidations")
+	defer subscriber.Close()
+
+	received := make(chan cache.Invalidation, 4)
+	publisher.Subscribe(func(invalidation cache.Invalidation) {
+		t.Error("invalidator received its own invalidation")
+	})
+	subscriber.Subscribe(func(invalidation cache.Invalidation) {
+		received <- invalidation
+	})
+	// wait until both subscriptions are active, as redis doesn't buffer messages
+	assert.Eventually(t, func() bool {
+		channels, err := client.PubSubNumSub(context.Background(), "invalidations").Result()
+		return err == nil && channels["invalidations"] == 2
+	}, time.Second, 10*time.Millisecond)
+
+	invalidations := []cache.Invalidation{
+		{Cache: "members", GroupID: 1, ID: 2},
+		{Cache: "members", GroupID: 1, IDs: []snowflake.ID{3, 4}},
+		{Cache: "members", GroupID: 1},
+	}
+	for _, invalidation := range invalidations {
+		publisher.Publish(invalidation)
+	}
+	for _, expected := range invalidations {
+		select {
+		case invalidation := <-received:
+			assert.Equal(t, expected, invalidation)
+		case <-time.After(time.Second):
+			t.Fatal("invalidation was not received")
+		}
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	coalesced := coalesce([]cache.Invalidation{
+		{Cache: "members", GroupID: 1, ID: 2},
+		{Cache: "guilds", ID: 1},
+		{Cache: "members", GroupID: 1, IDs: []snowflake.ID{2, 3}},
+		{Cache: "roles", GroupID: 1, ID: 2},
+		{Cache: "guilds", ID: 4},
+		{Cache: "roles", GroupID: 1},
+	})
+	if !assert.Len(t, coalesced, 3) {
+		return
+	}
+	assert.Equal(t, "members", coalesced[0].Cache)
+	assert.ElementsMatch(t, []snowflake.ID{2, 3}, coalesced[0].EntityIDs())
+	assert.Equal(t, "guilds", coalesced[1].Cache)
+	assert.ElementsMatch(t, []snowflake.ID{1, 4}, coalesced[1].EntityIDs())
+	// the whole group was invalidated, so the single IDs are not needed anymore
+	assert.Equal(t, cache.Invalidation{Cache: "roles", GroupID: 1}, coalesced[2])
+}
+
+func TestRedisInvalidator_PublishCoalescesFullQueue(t *testing.T) {
+	_, client := newTestClient(t)
+	i := NewInvalidator(client, "invalidations")
+	// stop the publish loop, so the queue fills up
+	i.cancel()
+	i.wg.Wait()
+
+	for id := 1; id <= invalidationQueueSize+1; id++ {
+		i.Publish(cache.Invalidation{Cache: "members", GroupID: 1, ID: snowflake.ID(id)})
+	}
+	i.queueMu.Lock()
+	defer i.queueMu.Unlock()
+	if assert.Len(t, i.queue, 1) {
+		assert.Len(t, i.queue[0].EntityIDs(), invalidationQueueSize+1, "invalidations were dropped")
+	}
+}
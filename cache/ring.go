@@ -0,0 +1,521 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var _ GroupedCache[any] = (*ringGroupedCache[any])(nil)
+
+// NewRingGroupedCache returns a new GroupedCache which keeps only the last size entities put into each group in a ring buffer.
+// Once a group is full, putting a new entity overwrites the oldest one. Updating an entity which is already cached keeps its position.
+// It is meant as message cache which only keeps the last messages of each channel:
+//
+//	cache.WithMessageCache(cache.NewRingGroupedCache[discord.Message](cache.FlagMessages, cache.FlagMessages, nil, 100, nil))
+//
+// Unlike the default GroupedCache, the whole cache is guarded by a single lock and entities are iterated from the oldest to the newest.
+// The optional evictFunc is called with every overwritten entity. As an injected cache it is not observed by the Listener(s) & EvictFunc of the Config.
+func NewRingGroupedCache[T any](flags Flags, neededFlags Flags, policy Policy[T], size int, evictFunc EvictFunc[T]) GroupedCache[T] {
+	if size <= 0 {
+		panic("size must be greater than 0")
+	}
+	return &ringGroupedCache[T]{
+		flags:       flags,
+		neededFlags: neededFlags,
+		policy:      policy,
+		size:        size,
+		evictFunc:   evictFunc,
+		rings:       make(map[snowflake.ID]*ring[T]),
+		indexFuncs:  make(map[string]IndexFunc[T]),
+	}
+}
+
+type ringSlot[T any] struct {
+	id     snowflake.ID
+	entity T
+	used   bool
+}
+
+// ring holds the entities of one group. The slots grow up to the size of the cache, after that next points to the oldest slot, which is overwritten next.
+// Removed entities leave an unused slot behind until it is overwritten.
+type ring[T any] struct {
+	slots   []ringSlot[T]
+	next    int
+	ids     map[snowflake.ID]int
+	indexes map[string]groupIndex
+}
+
+func (r *ring[T]) len() int {
+	return len(r.ids)
+}
+
+// iter calls yield for all entities of the ring from the oldest to the newest and returns false if yield stopped the iteration.
+func (r *ring[T]) iter(yield func(id snowflake.ID, entity T) bool) bool {
+	for i := range r.slots {
+		slot := r.slots[(r.next+i)%len(r.slots)]
+		if slot.used && !yield(slot.id, slot.entity) {
+			return false
+		}
+	}
+	return true
+}
+
+type ringGroupedCache[T any] struct {
+	flags       Flags
+	neededFlags Flags
+	policy      Policy[T]
+	size        int
+	evictFunc   EvictFunc[T]
+
+	mu         sync.RWMutex
+	rings      map[snowflake.ID]*ring[T]
+	indexFuncs map[string]IndexFunc[T]
+}
+
+func (c *ringGroupedCache[T]) allowed(entity T) bool {
+	if c.neededFlags != FlagsNone && c.flags.Missing(c.neededFlags) {
+		return false
+	}
+	return c.policy == nil || c.policy(entity)
+}
+
+// put stores the entity in the ring of the group and returns the overwritten slot, which is only used if an entity was evicted.
+// It needs to be called with the write lock held.
+func (c *ringGroupedCache[T]) put(groupID snowflake.ID, id snowflake.ID, entity T) ringSlot[T] {
+	r, ok := c.rings[groupID]
+	if !ok {
+		r = &ring[T]{
+			ids:     make(map[snowflake.ID]int),
+			indexes: make(map[string]groupIndex),
+		}
+		c.rings[groupID] = r
+	}
+
+	if i, ok := r.ids[id]; ok {
+		c.unindex(r, id, r.slots[i].entity)
+		r.slots[i].entity = entity
+		c.index(r, id, entity)
+		return ringSlot[T]{}
+	}
+
+	var oldest ringSlot[T]
+	if len(r.slots) < c.size {
+		r.slots = append(r.slots, ringSlot[T]{id: id, entity: entity, used: true})
+		r.ids[id] = len(r.slots) - 1
+	} else {
+		if oldest = r.slots[r.next]; oldest.used {
+			delete(r.ids, oldest.id)
+			c.unindex(r, oldest.id, oldest.entity)
+		}
+		r.slots[r.next] = ringSlot[T]{id: id, entity: entity, used: true}
+		r.ids[id] = r.next
+		r.next = (r.next + 1) % c.size
+	}
+	c.index(r, id, entity)
+	return oldest
+}
+
+// evicted calls the EvictFunc with the entities of the used slots. It needs to be called without holding the lock.
+func (c *ringGroupedCache[T]) evicted(groupID snowflake.ID, slots ...ringSlot[T]) {
+	if c.evictFunc == nil {
+		return
+	}
+	for _, slot := range slots {
+		if slot.used {
+			c.evictFunc(groupID, slot.id, slot.entity)
+		}
+	}
+}
+
+// remove removes the entity from the ring of the group. It needs to be called with the write lock held.
+func (c *ringGroupedCache[T]) remove(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	var entity T
+	r, ok := c.rings[groupID]
+	if !ok {
+		return entity, false
+	}
+	i, ok := r.ids[id]
+	if !ok {
+		return entity, false
+	}
+	entity = r.slots[i].entity
+	r.slots[i] = ringSlot[T]{}
+	delete(r.ids, id)
+	c.unindex(r, id, entity)
+	if r.len() == 0 {
+		delete(c.rings, groupID)
+	}
+	return entity, true
+}
+
+func (c *ringGroupedCache[T]) index(r *ring[T], id snowflake.ID, entity T) {
+	for name, indexFunc := range c.indexFuncs {
+		index, ok := r.indexes[name]
+		if !ok {
+			index = make(groupIndex)
+			r.indexes[name] = index
+		}
+		index.add(indexFunc(entity), id)
+	}
+}
+
+func (c *ringGroupedCache[T]) unindex(r *ring[T], id snowflake.ID, entity T) {
+	for name, indexFunc := range c.indexFuncs {
+		if index, ok := r.indexes[name]; ok {
+			index.remove(indexFunc(entity), id)
+		}
+	}
+}
+
+func (c *ringGroupedCache[T]) Get(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if r, ok := c.rings[groupID]; ok {
+		if i, ok := r.ids[id]; ok {
+			return r.slots[i].entity, true
+		}
+	}
+	var entity T
+	return entity, false
+}
+
+func (c *ringGroupedCache[T]) Put(groupID snowflake.ID, id snowflake.ID, entity T) {
+	if !c.allowed(entity) {
+		return
+	}
+	c.mu.Lock()
+	evicted := c.put(groupID, id, entity)
+	c.mu.Unlock()
+	c.evicted(groupID, evicted)
+}
+
+func (c *ringGroupedCache[T]) GetOrPut(groupID snowflake.ID, id snowflake.ID, supplier func() T) (T, bool) {
+	c.mu.Lock()
+	if r, ok := c.rings[groupID]; ok {
+		if i, ok := r.ids[id]; ok {
+			entity := r.slots[i].entity
+			c.mu.Unlock()
+			return entity, true
+		}
+	}
+	entity := supplier()
+	var evicted ringSlot[T]
+	if c.allowed(entity) {
+		evicted = c.put(groupID, id, entity)
+	}
+	c.mu.Unlock()
+	c.evicted(groupID, evicted)
+	return entity, false
+}
+
+func (c *ringGroupedCache[T]) PutIfAbsent(groupID snowflake.ID, id snowflake.ID, entity T) bool {
+	if !c.allowed(entity) {
+		return false
+	}
+	c.mu.Lock()
+	if r, ok := c.rings[groupID]; ok {
+		if _, ok = r.ids[id]; ok {
+			c.mu.Unlock()
+			return false
+		}
+	}
+	evicted := c.put(groupID, id, entity)
+	c.mu.Unlock()
+	c.evicted(groupID, evicted)
+	return true
+}
+
+func (c *ringGroupedCache[T]) Compute(groupID snowflake.ID, id snowflake.ID, computeFunc ComputeFunc[T]) (T, bool) {
+	c.mu.Lock()
+	entity, ok, evicted := c.compute(groupID, id, computeFunc)
+	c.mu.Unlock()
+	c.evicted(groupID, evicted)
+	return entity, ok
+}
+
+// compute applies the ComputeFunc and returns the evicted slot like put. It needs to be called with the write lock held.
+func (c *ringGroupedCache[T]) compute(groupID snowflake.ID, id snowflake.ID, computeFunc ComputeFunc[T]) (T, bool, ringSlot[T]) {
+	var (
+		old    T
+		exists bool
+	)
+	if r, ok := c.rings[groupID]; ok {
+		if i, ok := r.ids[id]; ok {
+			old, exists = r.slots[i].entity, true
+		}
+	}
+	entity, ok := computeFunc(old, exists)
+	if !ok {
+		if exists {
+			c.remove(groupID, id)
+		}
+		return entity, false, ringSlot[T]{}
+	}
+	if !c.allowed(entity) {
+		return entity, false, ringSlot[T]{}
+	}
+	return entity, true, c.put(groupID, id, entity)
+}
+
+func (c *ringGroupedCache[T]) PutAll(groupID snowflake.ID, entities map[snowflake.ID]T) {
+	var evicted []ringSlot[T]
+	c.mu.Lock()
+	for id, entity := range entities {
+		if c.allowed(entity) {
+			if slot := c.put(groupID, id, entity); slot.used {
+				evicted = append(evicted, slot)
+			}
+		}
+	}
+	c.mu.Unlock()
+	c.evicted(groupID, evicted...)
+}
+
+func (c *ringGroupedCache[T]) Remove(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.remove(groupID, id)
+}
+
+func (c *ringGroupedCache[T]) RemoveMany(groupID snowflake.ID, ids []snowflake.ID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range ids {
+		c.remove(groupID, id)
+	}
+}
+
+func (c *ringGroupedCache[T]) RemoveAll(groupID snowflake.ID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.rings, groupID)
+}
+
+func (c *ringGroupedCache[T]) RemoveIf(filterFunc GroupedFilterFunc[T]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for groupID := range c.rings {
+		c.groupRemoveIf(groupID, filterFunc)
+	}
+}
+
+func (c *ringGroupedCache[T]) GroupRemoveIf(groupID snowflake.ID, filterFunc GroupedFilterFunc[T]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.groupRemoveIf(groupID, filterFunc)
+}
+
+// groupRemoveIf removes all entities of the group passing the GroupedFilterFunc. It needs to be called with the write lock held.
+func (c *ringGroupedCache[T]) groupRemoveIf(groupID snowflake.ID, filterFunc GroupedFilterFunc[T]) {
+	r, ok := c.rings[groupID]
+	if !ok {
+		return
+	}
+	var ids []snowflake.ID
+	r.iter(func(id snowflake.ID, entity T) bool {
+		if filterFunc(groupID, entity) {
+			ids = append(ids, id)
+		}
+		return true
+	})
+	for _, id := range ids {
+		c.remove(groupID, id)
+	}
+}
+
+func (c *ringGroupedCache[T]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var totalLen int
+	for _, r := range c.rings {
+		totalLen += r.len()
+	}
+	return totalLen
+}
+
+func (c *ringGroupedCache[T]) GroupLen(groupID snowflake.ID) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if r, ok := c.rings[groupID]; ok {
+		return r.len()
+	}
+	return 0
+}
+
+func (c *ringGroupedCache[T]) GroupIDs() []snowflake.ID {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	groupIDs := make([]snowflake.ID, 0, len(c.rings))
+	for groupID := range c.rings {
+		groupIDs = append(groupIDs, groupID)
+	}
+	return groupIDs
+}
+
+func (c *ringGroupedCache[T]) All() map[snowflake.ID][]T {
+	all := make(map[snowflake.ID][]T)
+	c.Iter()(func(groupID snowflake.ID, entity T) bool {
+		all[groupID] = append(all[groupID], entity)
+		return true
+	})
+	return all
+}
+
+func (c *ringGroupedCache[T]) GroupAll(groupID snowflake.ID) []T {
+	var all []T
+	c.IterGroup(groupID)(func(_ snowflake.ID, entity T) bool {
+		all = append(all, entity)
+		return true
+	})
+	return all
+}
+
+func (c *ringGroupedCache[T]) MapAll() map[snowflake.ID]map[snowflake.ID]T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	all := make(map[snowflake.ID]map[snowflake.ID]T, len(c.rings))
+	for groupID, r := range c.rings {
+		groupEntities := make(map[snowflake.ID]T, r.len())
+		r.iter(func(id snowflake.ID, entity T) bool {
+			groupEntities[id] = entity
+			return true
+		})
+		all[groupID] = groupEntities
+	}
+	return all
+}
+
+func (c *ringGroupedCache[T]) MapGroupAll(groupID snowflake.ID) map[snowflake.ID]T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	r, ok := c.rings[groupID]
+	if !ok {
+		return nil
+	}
+	all := make(map[snowflake.ID]T, r.len())
+	r.iter(func(id snowflake.ID, entity T) bool {
+		all[id] = entity
+		return true
+	})
+	return all
+}
+
+func (c *ringGroupedCache[T]) FindFirst(cacheFindFunc GroupedFilterFunc[T]) (T, bool) {
+	var found T
+	var ok bool
+	c.Iter()(func(groupID snowflake.ID, entity T) bool {
+		if cacheFindFunc(groupID, entity) {
+			found, ok = entity, true
+			return false
+		}
+		return true
+	})
+	return found, ok
+}
+
+func (c *ringGroupedCache[T]) GroupFindFirst(groupID snowflake.ID, cacheFindFunc GroupedFilterFunc[T]) (T, bool) {
+	var found T
+	var ok bool
+	c.IterGroup(groupID)(func(_ snowflake.ID, entity T) bool {
+		if cacheFindFunc(groupID, entity) {
+			found, ok = entity, true
+			return false
+		}
+		return true
+	})
+	return found, ok
+}
+
+func (c *ringGroupedCache[T]) FindAll(cacheFindFunc GroupedFilterFunc[T]) []T {
+	all := make([]T, 0)
+	c.ForEach(func(groupID snowflake.ID, entity T) {
+		if cacheFindFunc(groupID, entity) {
+			all = append(all, entity)
+		}
+	})
+	return all
+}
+
+func (c *ringGroupedCache[T]) GroupFindAll(groupID snowflake.ID, cacheFindFunc GroupedFilterFunc[T]) []T {
+	all := make([]T, 0)
+	c.GroupForEach(groupID, func(entity T) {
+		if cacheFindFunc(groupID, entity) {
+			all = append(all, entity)
+		}
+	})
+	return all
+}
+
+func (c *ringGroupedCache[T]) ForEach(forEachFunc func(groupID snowflake.ID, entity T)) {
+	c.Iter()(func(groupID snowflake.ID, entity T) bool {
+		forEachFunc(groupID, entity)
+		return true
+	})
+}
+
+func (c *ringGroupedCache[T]) GroupForEach(groupID snowflake.ID, forEachFunc func(entity T)) {
+	c.IterGroup(groupID)(func(_ snowflake.ID, entity T) bool {
+		forEachFunc(entity)
+		return true
+	})
+}
+
+func (c *ringGroupedCache[T]) AddIndex(name string, indexFunc IndexFunc[T]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.indexFuncs[name] = indexFunc
+	for _, r := range c.rings {
+		index := make(groupIndex, r.len())
+		r.iter(func(id snowflake.ID, entity T) bool {
+			index.add(indexFunc(entity), id)
+			return true
+		})
+		r.indexes[name] = index
+	}
+}
+
+func (c *ringGroupedCache[T]) GetByIndex(groupID snowflake.ID, index string, key string) (T, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if r, ok := c.rings[groupID]; ok {
+		if id, ok := r.indexes[index].oldest(key); ok {
+			return r.slots[r.ids[id]].entity, true
+		}
+	}
+	var entity T
+	return entity, false
+}
+
+func (c *ringGroupedCache[T]) Iter() func(yield func(groupID snowflake.ID, entity T) bool) {
+	return func(yield func(groupID snowflake.ID, entity T) bool) {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+		for groupID, r := range c.rings {
+			if !r.iter(func(_ snowflake.ID, entity T) bool {
+				return yield(groupID, entity)
+			}) {
+				return
+			}
+		}
+	}
+}
+
+func (c *ringGroupedCache[T]) IterGroup(groupID snowflake.ID) func(yield func(id snowflake.ID, entity T) bool) {
+	return func(yield func(id snowflake.ID, entity T) bool) {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+		if r, ok := c.rings[groupID]; ok {
+			r.iter(yield)
+		}
+	}
+}
+
+// Snapshot copies the entities, as the slots of the rings are reused on every Put.
+func (c *ringGroupedCache[T]) Snapshot() GroupedView[T] {
+	return NewGroupedView(c.MapAll())
+}
+
+func (c *ringGroupedCache[T]) GroupSnapshot(groupID snowflake.ID) View[T] {
+	return NewView(c.MapGroupAll(groupID))
+}
@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRingGroupedCache(t *testing.T) {
+	c := NewRingGroupedCache[int](FlagsAll, FlagsNone, nil, 3, nil)
+	for i := 1; i <= 4; i++ {
+		c.Put(1, snowflake.ID(i), i)
+	}
+	c.Put(2, 1, 1)
+
+	assert.Equal(t, []int{2, 3, 4}, c.GroupAll(1))
+	_, ok := c.Get(1, 1)
+	assert.False(t, ok)
+
+	c.Put(1, 3, 30)
+	assert.Equal(t, []int{2, 30, 4}, c.GroupAll(1))
+
+	c.Remove(1, 2)
+	c.Put(1, 5, 5)
+	assert.Equal(t, []int{30, 4, 5}, c.GroupAll(1))
+	assert.Equal(t, 4, c.Len())
+
+	c.GroupRemoveIf(1, func(_ snowflake.ID, entity int) bool { return entity > 10 })
+	assert.Equal(t, []int{4, 5}, c.GroupAll(1))
+	assert.Equal(t, 1, c.GroupLen(2))
+}
+
+func TestRingGroupedCache_EvictFunc(t *testing.T) {
+	var evicted []int
+	c := NewRingGroupedCache[int](FlagsAll, FlagsNone, nil, 2, func(_ snowflake.ID, _ snowflake.ID, entity int) {
+		evicted = append(evicted, entity)
+	})
+
+	c.Put(1, 1, 1)
+	c.Put(1, 2, 2)
+	// updates don't evict
+	c.Put(1, 2, 20)
+	assert.True(t, c.PutIfAbsent(1, 3, 3))
+	c.Compute(1, 4, func(int, bool) (int, bool) { return 4, true })
+	c.GetOrPut(1, 5, func() int { return 5 })
+	assert.Equal(t, []int{1, 20, 3}, evicted)
+
+	// removed slots are not evicted again
+	c.Remove(1, 4)
+	c.Put(1, 6, 6)
+	c.Put(1, 7, 7)
+	assert.Equal(t, []int{1, 20, 3, 5}, evicted)
+}
@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// flagsCache only stores entities while the neededFlags are enabled in the Caches, so they can be changed at runtime with Caches.SetFlags.
+type flagsCache[T any] struct {
+	Cache[T]
+	caches      *cachesImpl
+	neededFlags Flags
+}
+
+func (c *flagsCache[T]) enabled() bool {
+	return c.caches.CacheFlags().Has(c.neededFlags)
+}
+
+func (c *flagsCache[T]) Put(id snowflake.ID, entity T) {
+	if c.enabled() {
+		c.Cache.Put(id, entity)
+	}
+}
+
+func (c *flagsCache[T]) GetOrPut(id snowflake.ID, supplier func() T) (T, bool) {
+	if c.enabled() {
+		return c.Cache.GetOrPut(id, supplier)
+	}
+	if entity, ok := c.Cache.Get(id); ok {
+		return entity, true
+	}
+	return supplier(), false
+}
+
+func (c *flagsCache[T]) PutIfAbsent(id snowflake.ID, entity T) bool {
+	return c.enabled() && c.Cache.PutIfAbsent(id, entity)
+}
+
+func (c *flagsCache[T]) Compute(id snowflake.ID, computeFunc ComputeFunc[T]) (T, bool) {
+	if c.enabled() {
+		return c.Cache.Compute(id, computeFunc)
+	}
+	// nothing is stored while disabled, so only removing the present entity is applied
+	entity, ok := c.Cache.Get(id)
+	computed, store := computeFunc(entity, ok)
+	if ok && !store {
+		c.Cache.Remove(id)
+	}
+	return computed, false
+}
+
+// flagsGroupedCache only stores entities while the neededFlags are enabled in the Caches, so they can be changed at runtime with Caches.SetFlags.
+type flagsGroupedCache[T any] struct {
+	GroupedCache[T]
+	caches      *cachesImpl
+	neededFlags Flags
+}
+
+func (c *flagsGroupedCache[T]) enabled() bool {
+	return c.caches.CacheFlags().Has(c.neededFlags)
+}
+
+func (c *flagsGroupedCache[T]) Put(groupID snowflake.ID, id snowflake.ID, entity T) {
+	if c.enabled() {
+		c.GroupedCache.Put(groupID, id, entity)
+	}
+}
+
+func (c *flagsGroupedCache[T]) GetOrPut(groupID snowflake.ID, id snowflake.ID, supplier func() T) (T, bool) {
+	if c.enabled() {
+		return c.GroupedCache.GetOrPut(groupID, id, supplier)
+	}
+	if entity, ok := c.GroupedCache.Get(groupID, id); ok {
+		return entity, true
+	}
+	return supplier(), false
+}
+
+func (c *flagsGroupedCache[T]) PutIfAbsent(groupID snowflake.ID, id snowflake.ID, entity T) bool {
+	return c.enabled() && c.GroupedCache.PutIfAbsent(groupID, id, entity)
+}
+
+func (c *flagsGroupedCache[T]) Compute(groupID snowflake.ID, id snowflake.ID, computeFunc ComputeFunc[T]) (T, bool) {
+	if c.enabled() {
+		return c.GroupedCache.Compute(groupID, id, computeFunc)
+	}
+	// nothing is stored while disabled, so only removing the present entity is applied
+	entity, ok := c.GroupedCache.Get(groupID, id)
+	computed, store := computeFunc(entity, ok)
+	if ok && !store {
+		c.GroupedCache.Remove(groupID, id)
+	}
+	return computed, false
+}
+
+func (c *flagsGroupedCache[T]) PutAll(groupID snowflake.ID, entities map[snowflake.ID]T) {
+	if c.enabled() {
+		c.GroupedCache.PutAll(groupID, entities)
+	}
+}
+
+// flagsPurger removes all entities of a cache once its neededFlags are disabled.
+type flagsPurger struct {
+	neededFlags Flags
+	purge       func()
+}
+
+func (c *cachesImpl) CacheFlags() Flags {
+	return c.flags.Load().(Flags)
+}
+
+func (c *cachesImpl) SetFlags(flags Flags) {
+	c.flagsMu.Lock()
+	defer c.flagsMu.Unlock()
+	c.flags.Store(flags)
+}
+
+func (c *cachesImpl) EnableFlags(flags ...Flags) {
+	c.flagsMu.Lock()
+	defer c.flagsMu.Unlock()
+	c.flags.Store(c.CacheFlags().Add(flags...))
+}
+
+func (c *cachesImpl) DisableFlags(flags ...Flags) {
+	c.flagsMu.Lock()
+	defer c.flagsMu.Unlock()
+	c.flags.Store(c.CacheFlags().Remove(flags...))
+}
+
+func (c *cachesImpl) PurgeDisabled() {
+	flags := c.CacheFlags()
+	for _, purger := range c.purgers {
+		if flags.Missing(purger.neededFlags) {
+			purger.purge()
+		}
+	}
+}
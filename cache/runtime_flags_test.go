@@ -0,0 +1,59 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaches_SetFlags(t *testing.T) {
+	caches := New(WithCacheFlags(FlagPresences))
+
+	caches.Members().Put(1, 1, discord.Member{})
+	assert.Equal(t, 0, caches.Members().Len())
+
+	caches.EnableFlags(FlagMembers)
+	caches.Members().Put(1, 1, discord.Member{})
+	caches.Presences().Put(1, 1, discord.Presence{})
+	assert.Equal(t, 1, caches.Members().Len())
+
+	caches.DisableFlags(FlagPresences)
+	assert.Equal(t, FlagMembers, caches.CacheFlags())
+	caches.Presences().Put(1, 2, discord.Presence{})
+	assert.Equal(t, 1, caches.Presences().Len())
+
+	caches.PurgeDisabled()
+	assert.Equal(t, 0, caches.Presences().Len())
+	assert.Equal(t, 1, caches.Members().Len())
+}
+
+func TestCaches_ComputeDisabled(t *testing.T) {
+	caches := New(WithCacheFlags(FlagMembers))
+	caches.Members().Put(1, 1, discord.Member{Nick: strPtr("old")})
+	caches.DisableFlags(FlagMembers)
+
+	member, ok := caches.Members().Compute(1, 1, func(member discord.Member, ok bool) (discord.Member, bool) {
+		assert.True(t, ok)
+		member.Nick = strPtr("new")
+		return member, true
+	})
+	assert.False(t, ok)
+	assert.Equal(t, "new", *member.Nick)
+
+	member, _ = caches.Members().Get(1, 1)
+	assert.Equal(t, "old", *member.Nick)
+	stats := caches.Stats()["members"]
+	assert.Equal(t, uint64(1), stats.Puts)
+	assert.Equal(t, uint64(0), stats.Removals)
+
+	caches.Members().Compute(1, 1, func(member discord.Member, ok bool) (discord.Member, bool) {
+		return member, false
+	})
+	assert.Equal(t, 0, caches.Members().Len())
+	assert.Equal(t, uint64(1), caches.Stats()["members"].Removals)
+}
+
+func strPtr(s string) *string {
+	return &s
+}
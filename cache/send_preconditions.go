@@ -0,0 +1,145 @@
+package cache
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+// SendPrecondition is a reason why a discord.Member can't send a message in a channel.
+type SendPrecondition int
+
+// All SendPrecondition(s)
+const (
+	SendPreconditionMissingPermissions SendPrecondition = iota + 1
+	SendPreconditionTimedOut
+	SendPreconditionPending
+	SendPreconditionVerificationLevel
+	SendPreconditionSlowmode
+)
+
+func (p SendPrecondition) String() string {
+	switch p {
+	case SendPreconditionMissingPermissions:
+		return "missing permissions"
+	case SendPreconditionTimedOut:
+		return "timed out"
+	case SendPreconditionPending:
+		return "membership screening pending"
+	case SendPreconditionVerificationLevel:
+		return "verification level not met"
+	case SendPreconditionSlowmode:
+		return "slowmode active"
+	default:
+		return "unknown"
+	}
+}
+
+// SendPreconditionError is returned by Caches.CheckSendPreconditions if a discord.Member can't send a message in a channel.
+type SendPreconditionError struct {
+	Precondition SendPrecondition
+	// MissingPermissions is set for SendPreconditionMissingPermissions
+	MissingPermissions discord.Permissions
+	// RetryAfter is set if the precondition passes by waiting, like SendPreconditionSlowmode
+	RetryAfter time.Duration
+}
+
+func (e *SendPreconditionError) Error() string {
+	switch {
+	case e.MissingPermissions != 0:
+		return fmt.Sprintf("cannot send message: %s: %s", e.Precondition, e.MissingPermissions)
+	case e.RetryAfter > 0:
+		return fmt.Sprintf("cannot send message: %s, retry after %s", e.Precondition, e.RetryAfter)
+	default:
+		return fmt.Sprintf("cannot send message: %s", e.Precondition)
+	}
+}
+
+const (
+	verificationMediumAccountAge = 5 * time.Minute
+	verificationHighMemberAge    = 10 * time.Minute
+)
+
+func (c *cachesImpl) CheckSendPreconditions(channel discord.GuildMessageChannel, member discord.Member) error {
+	now := c.config.Clock.Now()
+	if member.CommunicationDisabledUntil != nil && member.CommunicationDisabledUntil.After(now) {
+		return &SendPreconditionError{
+			Precondition: SendPreconditionTimedOut,
+			RetryAfter:   member.CommunicationDisabledUntil.Sub(now),
+		}
+	}
+	if member.Pending {
+		return &SendPreconditionError{Precondition: SendPreconditionPending}
+	}
+
+	// threads inherit the permissions of their parent channel
+	var permissionChannel discord.GuildChannel = channel
+	var slowmode discord.SlowmodeDuration
+	required := discord.PermissionViewChannel | discord.PermissionSendMessages
+	slowmodeBypass := discord.PermissionManageMessages | discord.PermissionManageChannels
+	switch ch := channel.(type) {
+	case discord.GuildThread:
+		required = discord.PermissionViewChannel | discord.PermissionSendMessagesInThreads
+		slowmode = ch.RateLimitPerUser
+		slowmodeBypass |= discord.PermissionManageThreads
+		if parentID := ch.ParentID(); parentID != nil {
+			if parent, ok := c.Channels().GetGuildChannel(*parentID); ok {
+				permissionChannel = parent
+			}
+		}
+	case slowmodeChannel:
+		slowmode = ch.RateLimitPerUser()
+	}
+
+	permissions := c.GetMemberPermissionsInChannel(permissionChannel, member)
+	if missing := required.Remove(permissions); missing != 0 {
+		return &SendPreconditionError{
+			Precondition:       SendPreconditionMissingPermissions,
+			MissingPermissions: missing,
+		}
+	}
+
+	if !member.User.Bot && len(member.RoleIDs) == 0 && !permissions.Has(discord.PermissionAdministrator) {
+		if guild, ok := c.Guilds().Get(channel.GuildID()); ok {
+			var retryAfter time.Duration
+			switch guild.VerificationLevel {
+			case discord.VerificationLevelHigh, discord.VerificationLevelVeryHigh:
+				retryAfter = member.JoinedAt.Add(verificationHighMemberAge).Sub(now)
+				if accountAge := discord.TimeFromSnowflake(member.User.ID).Add(verificationMediumAccountAge).Sub(now); accountAge > retryAfter {
+					retryAfter = accountAge
+				}
+			case discord.VerificationLevelMedium:
+				retryAfter = discord.TimeFromSnowflake(member.User.ID).Add(verificationMediumAccountAge).Sub(now)
+			}
+			if retryAfter > 0 {
+				return &SendPreconditionError{
+					Precondition: SendPreconditionVerificationLevel,
+					RetryAfter:   retryAfter,
+				}
+			}
+		}
+	}
+
+	// bots are exempt from slowmode
+	if slowmode > 0 && !member.User.Bot && permissions&slowmodeBypass == 0 {
+		var lastMessageAt time.Time
+		for _, message := range c.Messages().GroupAll(channel.ID()) {
+			if message.Author.ID == member.User.ID && message.CreatedAt.After(lastMessageAt) {
+				lastMessageAt = message.CreatedAt
+			}
+		}
+		if retryAfter := lastMessageAt.Add(slowmode.Duration()).Sub(now); retryAfter > 0 {
+			return &SendPreconditionError{
+				Precondition: SendPreconditionSlowmode,
+				RetryAfter:   retryAfter,
+			}
+		}
+	}
+	return nil
+}
+
+// slowmodeChannel is implemented by all guild message channels which support slowmode.
+type slowmodeChannel interface {
+	RateLimitPerUser() discord.SlowmodeDuration
+}
@@ -0,0 +1,158 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/clock"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaches_CheckSendPreconditions(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	guildID := snowflake.ID(1)
+	modRoleID := snowflake.ID(2)
+	// snowflakes of accounts created long before now
+	userID := snowflake.ID(100)
+	newUserID := snowflake.New(now.Add(-time.Minute))
+
+	var textChannel discord.GuildTextChannel
+	if err := json.Unmarshal([]byte(`{"id":"10","type":0,"guild_id":"1","rate_limit_per_user":60}`), &textChannel); err != nil {
+		t.Fatal(err)
+	}
+	var thread discord.GuildThread
+	if err := json.Unmarshal([]byte(`{"id":"20","type":11,"guild_id":"1","parent_id":"10","rate_limit_per_user":60}`), &thread); err != nil {
+		t.Fatal(err)
+	}
+
+	newCaches := func(verificationLevel discord.VerificationLevel, everyone discord.Permissions) Caches {
+		caches := New(WithCacheFlags(FlagGuilds, FlagRoles, FlagChannels, FlagMessages), WithClock(clock.NewMock(now)))
+		caches.Guilds().Put(guildID, discord.Guild{ID: guildID, OwnerID: 999, VerificationLevel: verificationLevel})
+		caches.Roles().Put(guildID, guildID, discord.Role{ID: guildID, Permissions: everyone})
+		caches.Roles().Put(guildID, modRoleID, discord.Role{ID: modRoleID, Permissions: discord.PermissionManageMessages})
+		caches.Channels().Put(textChannel.ID(), textChannel)
+		caches.Messages().Put(textChannel.ID(), 1, discord.Message{ID: 1, ChannelID: textChannel.ID(), Author: discord.User{ID: userID}, CreatedAt: now.Add(-10 * time.Second)})
+		caches.Messages().Put(thread.ID(), 2, discord.Message{ID: 2, ChannelID: thread.ID(), Author: discord.User{ID: userID}, CreatedAt: now.Add(-20 * time.Second)})
+		return caches
+	}
+	member := func(member discord.Member) discord.Member {
+		member.GuildID = guildID
+		if member.User.ID == 0 {
+			member.User.ID = userID
+		}
+		if member.JoinedAt.IsZero() {
+			member.JoinedAt = now.Add(-time.Hour)
+		}
+		return member
+	}
+	timedOutUntil := now.Add(time.Minute)
+	everyone := discord.PermissionViewChannel | discord.PermissionSendMessages | discord.PermissionSendMessagesInThreads
+
+	tests := []struct {
+		name              string
+		verificationLevel discord.VerificationLevel
+		everyone          discord.Permissions
+		channel           discord.GuildMessageChannel
+		member            discord.Member
+		expected          *SendPreconditionError
+	}{
+		{
+			name:     "timed out",
+			everyone: everyone,
+			channel:  textChannel,
+			member:   member(discord.Member{CommunicationDisabledUntil: &timedOutUntil}),
+			expected: &SendPreconditionError{Precondition: SendPreconditionTimedOut, RetryAfter: time.Minute},
+		},
+		{
+			name:     "pending",
+			everyone: everyone,
+			channel:  textChannel,
+			member:   member(discord.Member{Pending: true}),
+			expected: &SendPreconditionError{Precondition: SendPreconditionPending},
+		},
+		{
+			name:     "missing permissions",
+			everyone: discord.PermissionViewChannel,
+			channel:  textChannel,
+			member:   member(discord.Member{}),
+			expected: &SendPreconditionError{Precondition: SendPreconditionMissingPermissions, MissingPermissions: discord.PermissionSendMessages},
+		},
+		{
+			name:     "thread permissions",
+			everyone: discord.PermissionViewChannel | discord.PermissionSendMessages,
+			channel:  thread,
+			member:   member(discord.Member{User: discord.User{Bot: true}}),
+			expected: &SendPreconditionError{Precondition: SendPreconditionMissingPermissions, MissingPermissions: discord.PermissionSendMessagesInThreads},
+		},
+		{
+			name:              "verification level medium",
+			verificationLevel: discord.VerificationLevelMedium,
+			everyone:          everyone,
+			channel:           textChannel,
+			member:            member(discord.Member{User: discord.User{ID: newUserID}}),
+			expected:          &SendPreconditionError{Precondition: SendPreconditionVerificationLevel, RetryAfter: 4 * time.Minute},
+		},
+		{
+			name:              "verification level high",
+			verificationLevel: discord.VerificationLevelHigh,
+			everyone:          everyone,
+			channel:           textChannel,
+			member:            member(discord.Member{JoinedAt: now.Add(-time.Minute)}),
+			expected:          &SendPreconditionError{Precondition: SendPreconditionVerificationLevel, RetryAfter: 9 * time.Minute},
+		},
+		{
+			name:              "verification level skipped for members with roles",
+			verificationLevel: discord.VerificationLevelHigh,
+			everyone:          everyone,
+			channel:           textChannel,
+			member:            member(discord.Member{JoinedAt: now.Add(-time.Minute), RoleIDs: []snowflake.ID{modRoleID}}),
+		},
+		{
+			name:     "slowmode",
+			everyone: everyone,
+			channel:  textChannel,
+			member:   member(discord.Member{}),
+			expected: &SendPreconditionError{Precondition: SendPreconditionSlowmode, RetryAfter: 50 * time.Second},
+		},
+		{
+			name:     "thread slowmode",
+			everyone: everyone,
+			channel:  thread,
+			member:   member(discord.Member{}),
+			expected: &SendPreconditionError{Precondition: SendPreconditionSlowmode, RetryAfter: 40 * time.Second},
+		},
+		{
+			name:     "slowmode bypassed by permissions",
+			everyone: everyone,
+			channel:  textChannel,
+			member:   member(discord.Member{RoleIDs: []snowflake.ID{modRoleID}}),
+		},
+		{
+			name:     "slowmode skipped for bots",
+			everyone: everyone,
+			channel:  textChannel,
+			member:   member(discord.Member{User: discord.User{Bot: true}}),
+		},
+		{
+			name:     "slowmode expired",
+			everyone: everyone,
+			channel:  textChannel,
+			member:   member(discord.Member{User: discord.User{ID: 101}}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caches := newCaches(tt.verificationLevel, tt.everyone)
+			err := caches.CheckSendPreconditions(tt.channel, tt.member)
+			if tt.expected == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.expected, err)
+		})
+	}
+}
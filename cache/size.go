@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"reflect"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// SizeFunc returns the approximate number of bytes an entity occupies in memory.
+type SizeFunc[T any] func(entity T) int
+
+// SizeEstimator configures the SizeFunc used by Caches.SizeBytes for the caches matching the Flags.
+type SizeEstimator struct {
+	Flags    Flags
+	SizeFunc SizeFunc[any]
+}
+
+// EstimateSize is the default SizeFunc. It walks the entity via reflection and sums up the size of its fields and everything they reference, like strings, slices, maps & pointers.
+// Memory shared between entities is counted for each of them, so the result is an upper bound. It is slow for large entities, use WithSizeEstimator to supply a cheaper SizeFunc.
+func EstimateSize(entity any) int {
+	return estimateSize(reflect.ValueOf(entity), map[uintptr]struct{}{})
+}
+
+func estimateSize(v reflect.Value, seen map[uintptr]struct{}) int {
+	if !v.IsValid() {
+		return 0
+	}
+	return int(v.Type().Size()) + referencedSize(v, seen)
+}
+
+// referencedSize returns the size of the memory referenced by the value without the value itself.
+func referencedSize(v reflect.Value, seen map[uintptr]struct{}) int {
+	switch v.Kind() {
+	case reflect.String:
+		return v.Len()
+
+	case reflect.Pointer:
+		if v.IsNil() {
+			return 0
+		}
+		if _, ok := seen[v.Pointer()]; ok {
+			return 0
+		}
+		seen[v.Pointer()] = struct{}{}
+		return estimateSize(v.Elem(), seen)
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+		return estimateSize(v.Elem(), seen)
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return 0
+		}
+		size := v.Cap() * int(v.Type().Elem().Size())
+		for i := 0; i < v.Len(); i++ {
+			size += referencedSize(v.Index(i), seen)
+		}
+		return size
+
+	case reflect.Array:
+		var size int
+		for i := 0; i < v.Len(); i++ {
+			size += referencedSize(v.Index(i), seen)
+		}
+		return size
+
+	case reflect.Struct:
+		var size int
+		for i := 0; i < v.NumField(); i++ {
+			size += referencedSize(v.Field(i), seen)
+		}
+		return size
+
+	case reflect.Map:
+		if v.IsNil() {
+			return 0
+		}
+		var size int
+		iter := v.MapRange()
+		for iter.Next() {
+			size += estimateSize(iter.Key(), seen) + estimateSize(iter.Value(), seen)
+		}
+		return size
+	}
+	return 0
+}
+
+// sizeFuncOf returns the SizeFunc of the first SizeEstimator matching the neededFlags or EstimateSize.
+func sizeFuncOf[T any](estimators []SizeEstimator, neededFlags Flags) SizeFunc[T] {
+	sizeFunc := SizeFunc[any](EstimateSize)
+	for _, estimator := range estimators {
+		if estimator.Flags.Has(neededFlags) {
+			sizeFunc = estimator.SizeFunc
+			break
+		}
+	}
+	return func(entity T) int {
+		return sizeFunc(entity)
+	}
+}
+
+// newCacheSizer registers a func which sums up the sizes of all entities yielded by the iterator under the given name.
+func newCacheSizer[T any](c *cachesImpl, name string, neededFlags Flags, iter func(yield func(id snowflake.ID, entity T) bool)) {
+	sizeFunc := sizeFuncOf[T](c.config.SizeEstimators, neededFlags)
+	c.sizers[name] = func() int {
+		var size int
+		iter(func(_ snowflake.ID, entity T) bool {
+			size += sizeFunc(entity)
+			return true
+		})
+		return size
+	}
+}
+
+func (c *cachesImpl) SizeBytes() map[string]int {
+	sizes := make(map[string]int, len(c.sizers))
+	for name, sizer := range c.sizers {
+		sizes[name] = sizer()
+	}
+	return sizes
+}
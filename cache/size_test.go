@@ -0,0 +1,34 @@
+package cache
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateSize(t *testing.T) {
+	type entity struct {
+		Name  string
+		Tags  []string
+		Inner *entity
+	}
+	e := entity{Name: "abc", Tags: []string{"de"}, Inner: &entity{Name: "f"}}
+
+	entitySize := int(unsafe.Sizeof(entity{}))
+	stringSize := int(unsafe.Sizeof(""))
+	assert.Equal(t, entitySize+3+stringSize+2+entitySize+1, EstimateSize(e))
+}
+
+func TestCaches_SizeBytes(t *testing.T) {
+	caches := New(WithCacheFlags(FlagRoles), WithSizeEstimator(FlagRoles, func(entity any) int {
+		return len(entity.(discord.Role).Name)
+	}))
+	caches.Roles().Put(1, 1, discord.Role{Name: "admin"})
+	caches.Roles().Put(1, 2, discord.Role{Name: "mod"})
+
+	sizes := caches.SizeBytes()
+	assert.Equal(t, 8, sizes["roles"])
+	assert.Equal(t, 0, sizes["members"])
+}
@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/json"
+)
+
+// snapshotVersion is the version of the snapshot format written by Snapshotter.Export.
+const snapshotVersion = 1
+
+// Snapshotter is used to persist the cache state, so it can be restored on startup instead of waiting for all gateway.EventTypeGuildCreate events again.
+type Snapshotter interface {
+	// Export writes the whole cache state as JSON to the given io.Writer.
+	Export(w io.Writer) error
+
+	// Import restores the cache state written by Export from the given io.Reader.
+	// Imported entities are added to the existing ones and are subject to the configured Flags and Policy(s).
+	Import(r io.Reader) error
+}
+
+type snapshot struct {
+	Version           int                                                           `json:"version"`
+	SelfUser          *discord.OAuth2User                                           `json:"self_user,omitempty"`
+	Guilds            []discord.Guild                                               `json:"guilds"`
+	UnavailableGuilds []snowflake.ID                                                `json:"unavailable_guilds"`
+	GuildMemberCounts map[snowflake.ID]GuildMemberCount                             `json:"guild_member_counts"`
+	Channels          []discord.Channel                                             `json:"channels"`
+	StageInstances    map[snowflake.ID]map[snowflake.ID]discord.StageInstance       `json:"stage_instances"`
+	ScheduledEvents   map[snowflake.ID]map[snowflake.ID]discord.GuildScheduledEvent `json:"guild_scheduled_events"`
+	Roles             map[snowflake.ID]map[snowflake.ID]discord.Role                `json:"roles"`
+	Members           map[snowflake.ID]map[snowflake.ID]discord.Member              `json:"members"`
+	ThreadMembers     map[snowflake.ID]map[snowflake.ID]discord.ThreadMember        `json:"thread_members"`
+	Presences         map[snowflake.ID]map[snowflake.ID]discord.Presence            `json:"presences"`
+	VoiceStates       map[snowflake.ID]map[snowflake.ID]discord.VoiceState          `json:"voice_states"`
+	Messages          map[snowflake.ID]map[snowflake.ID]discord.Message             `json:"messages"`
+	Emojis            map[snowflake.ID]map[snowflake.ID]discord.Emoji               `json:"emojis"`
+	Stickers          map[snowflake.ID]map[snowflake.ID]discord.Sticker             `json:"stickers"`
+}
+
+// unmarshalSnapshot is used to decode the channels of a snapshot.
+type unmarshalSnapshot struct {
+	snapshot
+	Channels []discord.UnmarshalChannel `json:"channels"`
+}
+
+func (c *cachesImpl) Export(w io.Writer) error {
+	s := snapshot{
+		Version:           snapshotVersion,
+		Guilds:            c.Guilds().All(),
+		UnavailableGuilds: c.Guilds().UnavailableGuilds(),
+		Channels:          c.Channels().All(),
+		StageInstances:    c.StageInstances().MapAll(),
+		ScheduledEvents:   c.GuildScheduledEvents().MapAll(),
+		Roles:             c.Roles().MapAll(),
+		Members:           c.Members().MapAll(),
+		ThreadMembers:     c.ThreadMembers().MapAll(),
+		Presences:         c.Presences().MapAll(),
+		VoiceStates:       c.VoiceStates().MapAll(),
+		Messages:          c.Messages().MapAll(),
+		Emojis:            c.Emojis().MapAll(),
+		Stickers:          c.Stickers().MapAll(),
+	}
+	if selfUser, ok := c.GetSelfUser(); ok {
+		s.SelfUser = &selfUser
+	}
+	c.guildMemberCountsMu.RLock()
+	s.GuildMemberCounts = make(map[snowflake.ID]GuildMemberCount, len(c.guildMemberCounts))
+	for guildID, count := range c.guildMemberCounts {
+		s.GuildMemberCounts[guildID] = count
+	}
+	c.guildMemberCountsMu.RUnlock()
+
+	return json.NewEncoder(w).Encode(s)
+}
+
+func (c *cachesImpl) Import(r io.Reader) error {
+	var s unmarshalSnapshot
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return err
+	}
+	if s.Version != snapshotVersion {
+		return fmt.Errorf("unsupported cache snapshot version %d", s.Version)
+	}
+
+	if s.SelfUser != nil {
+		c.PutSelfUser(*s.SelfUser)
+	}
+	for _, guild := range s.Guilds {
+		c.Guilds().Put(guild.ID, guild)
+	}
+	for _, guildID := range s.UnavailableGuilds {
+		c.Guilds().SetUnavailable(guildID)
+	}
+	for guildID, count := range s.GuildMemberCounts {
+		c.PutGuildMemberCount(guildID, count)
+	}
+	for _, channel := range s.Channels {
+		c.Channels().Put(channel.ID(), channel.Channel)
+	}
+	importGrouped(c.StageInstances(), s.StageInstances)
+	importGrouped(c.GuildScheduledEvents(), s.ScheduledEvents)
+	importGrouped(c.Roles(), s.Roles)
+	importGrouped(c.Members(), s.Members)
+	importGrouped(c.ThreadMembers(), s.ThreadMembers)
+	importGrouped(c.Presences(), s.Presences)
+	importGrouped(c.VoiceStates(), s.VoiceStates)
+	importGrouped(c.Messages(), s.Messages)
+	importGrouped[discord.Emoji](c.Emojis(), s.Emojis)
+	importGrouped[discord.Sticker](c.Stickers(), s.Stickers)
+	return nil
+}
+
+func importGrouped[T any](groupedCache GroupedCache[T], entities map[snowflake.ID]map[snowflake.ID]T) {
+	for groupID, groupEntities := range entities {
+		for id, entity := range groupEntities {
+			groupedCache.Put(groupID, id, entity)
+		}
+	}
+}
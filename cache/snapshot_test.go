@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaches_ExportImport(t *testing.T) {
+	guildID := snowflake.ID(1)
+	var channel discord.GuildTextChannel
+	if err := json.Unmarshal([]byte(`{"id":"10","type":0,"guild_id":"1","name":"general"}`), &channel); err != nil {
+		t.Fatal(err)
+	}
+	selfUser := discord.OAuth2User{User: discord.User{ID: 100, Username: "bot", Bot: true}}
+	guild := discord.Guild{ID: guildID, Name: "guild", OwnerID: 101}
+	role := discord.Role{ID: guildID, Name: "@everyone", Permissions: discord.PermissionSendMessages}
+	member := discord.Member{GuildID: guildID, User: discord.User{ID: 101}, RoleIDs: []snowflake.ID{2}}
+	message := discord.Message{ID: 20, ChannelID: channel.ID(), Content: "hello", Author: discord.User{ID: 101}}
+	count := GuildMemberCount{MemberCount: 10, PresenceCount: 5, ReconciledAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	// FlagsAll doesn't include members and messages
+	flags := FlagsAll.Add(FlagMembers, FlagMessages)
+	caches := New(WithCacheFlags(flags))
+	caches.PutSelfUser(selfUser)
+	caches.Guilds().Put(guildID, guild)
+	caches.Guilds().SetUnavailable(2)
+	caches.PutGuildMemberCount(guildID, count)
+	caches.Channels().Put(channel.ID(), channel)
+	caches.Roles().Put(guildID, role.ID, role)
+	caches.Members().Put(guildID, member.User.ID, member)
+	caches.Messages().Put(channel.ID(), message.ID, message)
+
+	var buf bytes.Buffer
+	assert.NoError(t, caches.Export(&buf))
+	exported := buf.String()
+
+	imported := New(WithCacheFlags(flags))
+	assert.NoError(t, imported.Import(strings.NewReader(exported)))
+
+	importedSelfUser, ok := imported.GetSelfUser()
+	assert.True(t, ok)
+	assert.Equal(t, selfUser, importedSelfUser)
+	importedGuild, ok := imported.Guilds().Get(guildID)
+	assert.True(t, ok)
+	assert.Equal(t, guild, importedGuild)
+	assert.True(t, imported.Guilds().IsUnavailable(2))
+	importedCount, ok := imported.GuildMemberCount(guildID)
+	assert.True(t, ok)
+	assert.Equal(t, count, importedCount)
+	importedChannel, ok := imported.Channels().Get(channel.ID())
+	assert.True(t, ok)
+	assert.Equal(t, channel, importedChannel)
+	importedRole, ok := imported.Roles().Get(guildID, role.ID)
+	assert.True(t, ok)
+	assert.Equal(t, role, importedRole)
+	importedMember, ok := imported.Members().Get(guildID, member.User.ID)
+	assert.True(t, ok)
+	assert.Equal(t, member, importedMember)
+	importedMessage, ok := imported.Messages().Get(channel.ID(), message.ID)
+	assert.True(t, ok)
+	assert.Equal(t, message, importedMessage)
+
+	// exporting the imported caches results in the same snapshot
+	buf.Reset()
+	assert.NoError(t, imported.Export(&buf))
+	assert.JSONEq(t, exported, buf.String())
+}
+
+func TestCaches_ImportRespectsFlags(t *testing.T) {
+	caches := New(WithCacheFlags(FlagGuilds, FlagMessages))
+	caches.Guilds().Put(1, discord.Guild{ID: 1})
+	caches.Messages().Put(10, 20, discord.Message{ID: 20, ChannelID: 10})
+
+	var buf bytes.Buffer
+	assert.NoError(t, caches.Export(&buf))
+
+	imported := New(WithCacheFlags(FlagGuilds))
+	assert.NoError(t, imported.Import(&buf))
+	assert.Equal(t, 1, caches.Messages().Len())
+	assert.Equal(t, 1, imported.Guilds().Len())
+	assert.Equal(t, 0, imported.Messages().Len())
+}
+
+func TestCaches_ImportUnsupportedVersion(t *testing.T) {
+	caches := New(WithCacheFlags(FlagGuilds))
+	err := caches.Import(strings.NewReader(`{"version":2,"guilds":[{"id":"1"}]}`))
+	assert.EqualError(t, err, "unsupported cache snapshot version 2")
+	assert.Equal(t, 0, caches.Guilds().Len())
+}
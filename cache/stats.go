@@ -0,0 +1,285 @@
+package cache
+
+import (
+	"sync/atomic"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// StatsRecorder is used to forward the statistics of the caches to a metrics system.
+// Each method is called with the name of the cache, e.g. "guilds" or "members". Implementations need to be thread safe.
+type StatsRecorder interface {
+	// RecordHit is called when an entity was found in the cache.
+	RecordHit(cacheName string)
+
+	// RecordMiss is called when an entity was not found in the cache.
+	RecordMiss(cacheName string)
+
+	// RecordPut is called when an entity is put into the cache.
+	RecordPut(cacheName string)
+
+	// RecordRemove is called with the number of entities removed from the cache.
+	RecordRemove(cacheName string, count int)
+}
+
+// Stats are the statistics of a single cache.
+type Stats struct {
+	Hits     uint64
+	Misses   uint64
+	Puts     uint64
+	Removals uint64
+	Size     int
+}
+
+type cacheStats struct {
+	// the counters need to be first for 64-bit alignment on 32-bit platforms
+	hits     uint64
+	misses   uint64
+	puts     uint64
+	removals uint64
+
+	name     string
+	recorder StatsRecorder
+}
+
+func (s *cacheStats) get(ok bool) {
+	if ok {
+		atomic.AddUint64(&s.hits, 1)
+		if s.recorder != nil {
+			s.recorder.RecordHit(s.name)
+		}
+		return
+	}
+	atomic.AddUint64(&s.misses, 1)
+	if s.recorder != nil {
+		s.recorder.RecordMiss(s.name)
+	}
+}
+
+func (s *cacheStats) put() {
+	atomic.AddUint64(&s.puts, 1)
+	if s.recorder != nil {
+		s.recorder.RecordPut(s.name)
+	}
+}
+
+func (s *cacheStats) remove(count int) {
+	if count <= 0 {
+		return
+	}
+	atomic.AddUint64(&s.removals, uint64(count))
+	if s.recorder != nil {
+		s.recorder.RecordRemove(s.name, count)
+	}
+}
+
+func (s *cacheStats) stats(size int) Stats {
+	return Stats{
+		Hits:     atomic.LoadUint64(&s.hits),
+		Misses:   atomic.LoadUint64(&s.misses),
+		Puts:     atomic.LoadUint64(&s.puts),
+		Removals: atomic.LoadUint64(&s.removals),
+		Size:     size,
+	}
+}
+
+// statsCache counts the operations of the wrapped Cache.
+type statsCache[T any] struct {
+	Cache[T]
+	stats *cacheStats
+}
+
+func (c *statsCache[T]) Get(id snowflake.ID) (T, bool) {
+	entity, ok := c.Cache.Get(id)
+	c.stats.get(ok)
+	return entity, ok
+}
+
+func (c *statsCache[T]) Put(id snowflake.ID, entity T) {
+	c.Cache.Put(id, entity)
+	c.stats.put()
+}
+
+func (c *statsCache[T]) GetOrPut(id snowflake.ID, supplier func() T) (T, bool) {
+	entity, ok := c.Cache.GetOrPut(id, supplier)
+	c.stats.get(ok)
+	if !ok {
+		c.stats.put()
+	}
+	return entity, ok
+}
+
+func (c *statsCache[T]) PutIfAbsent(id snowflake.ID, entity T) bool {
+	ok := c.Cache.PutIfAbsent(id, entity)
+	if ok {
+		c.stats.put()
+	}
+	return ok
+}
+
+func (c *statsCache[T]) Compute(id snowflake.ID, computeFunc ComputeFunc[T]) (T, bool) {
+	var exists, store bool
+	entity, ok := c.Cache.Compute(id, func(entity T, ok bool) (T, bool) {
+		exists = ok
+		entity, store = computeFunc(entity, ok)
+		return entity, store
+	})
+	c.stats.get(exists)
+	if ok {
+		c.stats.put()
+	} else if exists && !store {
+		c.stats.remove(1)
+	}
+	return entity, ok
+}
+
+func (c *statsCache[T]) Remove(id snowflake.ID) (T, bool) {
+	entity, ok := c.Cache.Remove(id)
+	if ok {
+		c.stats.remove(1)
+	}
+	return entity, ok
+}
+
+func (c *statsCache[T]) RemoveIf(filterFunc FilterFunc[T]) {
+	var count int
+	c.Cache.RemoveIf(func(entity T) bool {
+		if filterFunc(entity) {
+			count++
+			return true
+		}
+		return false
+	})
+	c.stats.remove(count)
+}
+
+// statsGroupedCache counts the operations of the wrapped GroupedCache.
+type statsGroupedCache[T any] struct {
+	GroupedCache[T]
+	stats *cacheStats
+}
+
+func (c *statsGroupedCache[T]) Get(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	entity, ok := c.GroupedCache.Get(groupID, id)
+	c.stats.get(ok)
+	return entity, ok
+}
+
+func (c *statsGroupedCache[T]) GetByIndex(groupID snowflake.ID, index string, key string) (T, bool) {
+	entity, ok := c.GroupedCache.GetByIndex(groupID, index, key)
+	c.stats.get(ok)
+	return entity, ok
+}
+
+func (c *statsGroupedCache[T]) Put(groupID snowflake.ID, id snowflake.ID, entity T) {
+	c.GroupedCache.Put(groupID, id, entity)
+	c.stats.put()
+}
+
+func (c *statsGroupedCache[T]) GetOrPut(groupID snowflake.ID, id snowflake.ID, supplier func() T) (T, bool) {
+	entity, ok := c.GroupedCache.GetOrPut(groupID, id, supplier)
+	c.stats.get(ok)
+	if !ok {
+		c.stats.put()
+	}
+	return entity, ok
+}
+
+func (c *statsGroupedCache[T]) PutIfAbsent(groupID snowflake.ID, id snowflake.ID, entity T) bool {
+	ok := c.GroupedCache.PutIfAbsent(groupID, id, entity)
+	if ok {
+		c.stats.put()
+	}
+	return ok
+}
+
+func (c *statsGroupedCache[T]) Compute(groupID snowflake.ID, id snowflake.ID, computeFunc ComputeFunc[T]) (T, bool) {
+	var exists, store bool
+	entity, ok := c.GroupedCache.Compute(groupID, id, func(entity T, ok bool) (T, bool) {
+		exists = ok
+		entity, store = computeFunc(entity, ok)
+		return entity, store
+	})
+	c.stats.get(exists)
+	if ok {
+		c.stats.put()
+	} else if exists && !store {
+		c.stats.remove(1)
+	}
+	return entity, ok
+}
+
+func (c *statsGroupedCache[T]) Remove(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	entity, ok := c.GroupedCache.Remove(groupID, id)
+	if ok {
+		c.stats.remove(1)
+	}
+	return entity, ok
+}
+
+func (c *statsGroupedCache[T]) PutAll(groupID snowflake.ID, entities map[snowflake.ID]T) {
+	c.GroupedCache.PutAll(groupID, entities)
+	for range entities {
+		c.stats.put()
+	}
+}
+
+func (c *statsGroupedCache[T]) RemoveMany(groupID snowflake.ID, ids []snowflake.ID) {
+	count := c.GroupedCache.GroupLen(groupID)
+	c.GroupedCache.RemoveMany(groupID, ids)
+	c.stats.remove(count - c.GroupedCache.GroupLen(groupID))
+}
+
+func (c *statsGroupedCache[T]) RemoveAll(groupID snowflake.ID) {
+	count := c.GroupedCache.GroupLen(groupID)
+	c.GroupedCache.RemoveAll(groupID)
+	c.stats.remove(count)
+}
+
+func (c *statsGroupedCache[T]) RemoveIf(filterFunc GroupedFilterFunc[T]) {
+	var count int
+	c.GroupedCache.RemoveIf(func(groupID snowflake.ID, entity T) bool {
+		if filterFunc(groupID, entity) {
+			count++
+			return true
+		}
+		return false
+	})
+	c.stats.remove(count)
+}
+
+func (c *statsGroupedCache[T]) GroupRemoveIf(groupID snowflake.ID, filterFunc GroupedFilterFunc[T]) {
+	var count int
+	c.GroupedCache.GroupRemoveIf(groupID, func(groupID snowflake.ID, entity T) bool {
+		if filterFunc(groupID, entity) {
+			count++
+			return true
+		}
+		return false
+	})
+	c.stats.remove(count)
+}
+
+// newStats registers the statistics of the cache with the given name.
+func (c *cachesImpl) newStats(name string) *cacheStats {
+	stats := &cacheStats{name: name, recorder: c.config.StatsRecorder}
+	c.stats[name] = stats
+	return stats
+}
+
+func (c *cachesImpl) Stats() map[string]Stats {
+	return map[string]Stats{
+		"guilds":                 c.stats["guilds"].stats(c.guildCache.Len()),
+		"channels":               c.stats["channels"].stats(c.channelCache.Len()),
+		"stage_instances":        c.stats["stage_instances"].stats(c.stageInstanceCache.Len()),
+		"guild_scheduled_events": c.stats["guild_scheduled_events"].stats(c.guildScheduledEventCache.Len()),
+		"roles":                  c.stats["roles"].stats(c.roleCache.Len()),
+		"members":                c.stats["members"].stats(c.memberCache.Len()),
+		"thread_members":         c.stats["thread_members"].stats(c.threadMemberCache.Len()),
+		"presences":              c.stats["presences"].stats(c.presenceCache.Len()),
+		"voice_states":           c.stats["voice_states"].stats(c.voiceStateCache.Len()),
+		"messages":               c.stats["messages"].stats(c.messageCache.Len()),
+		"emojis":                 c.stats["emojis"].stats(c.emojiCache.Len()),
+		"stickers":               c.stats["stickers"].stats(c.stickerCache.Len()),
+	}
+}
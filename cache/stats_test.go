@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// testStatsRecorder sums up the recorded statistics of each cache.
+type testStatsRecorder struct {
+	mu    sync.Mutex
+	stats map[string]Stats
+}
+
+func (r *testStatsRecorder) record(cacheName string, fn func(stats *Stats)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats := r.stats[cacheName]
+	fn(&stats)
+	r.stats[cacheName] = stats
+}
+
+func (r *testStatsRecorder) RecordHit(cacheName string) {
+	r.record(cacheName, func(stats *Stats) { stats.Hits++ })
+}
+
+func (r *testStatsRecorder) RecordMiss(cacheName string) {
+	r.record(cacheName, func(stats *Stats) { stats.Misses++ })
+}
+
+func (r *testStatsRecorder) RecordPut(cacheName string) {
+	r.record(cacheName, func(stats *Stats) { stats.Puts++ })
+}
+
+func (r *testStatsRecorder) RecordRemove(cacheName string, count int) {
+	r.record(cacheName, func(stats *Stats) { stats.Removals += uint64(count) })
+}
+
+func TestCaches_Stats(t *testing.T) {
+	recorder := &testStatsRecorder{stats: map[string]Stats{}}
+	caches := New(
+		WithCacheFlags(FlagGuilds, FlagRoles),
+		WithIndex(FlagRoles, "name", func(entity any) string {
+			return entity.(discord.Role).Name
+		}),
+		WithInvalidator(testPublisher{&testChannel{}}),
+		WithStatsRecorder(recorder),
+	)
+	roles := caches.Roles()
+	role := func(id snowflake.ID) discord.Role {
+		return discord.Role{ID: id}
+	}
+	update := func(entity discord.Role, _ bool) (discord.Role, bool) {
+		return entity, true
+	}
+	remove := func(entity discord.Role, _ bool) (discord.Role, bool) {
+		return entity, false
+	}
+
+	roles.Get(1, 1)                                                        // miss
+	roles.Put(1, 1, role(1))                                               // put
+	roles.Get(1, 1)                                                        // hit
+	roles.GetOrPut(1, 1, func() discord.Role { return role(1) })           // hit
+	roles.GetOrPut(1, 2, func() discord.Role { return role(2) })           // miss, put
+	roles.PutIfAbsent(1, 2, role(2))                                       // nothing
+	roles.PutIfAbsent(1, 3, role(3))                                       // put
+	roles.Compute(1, 3, update)                                            // hit, put
+	roles.Compute(1, 3, remove)                                            // hit, remove
+	roles.Compute(1, 3, remove)                                            // miss
+	roles.PutAll(1, map[snowflake.ID]discord.Role{4: role(4), 5: role(5)}) // 2 puts
+	roles.Remove(1, 4)                                                     // remove
+	roles.Remove(1, 4)                                                     // nothing
+	roles.RemoveMany(1, []snowflake.ID{5, 99})                             // remove
+	roles.Put(2, 1, role(1))                                               // put
+	roles.Put(2, 2, role(2))                                               // put
+	roles.RemoveAll(2)                                                     // 2 removes
+	roles.Put(3, 1, role(1))                                               // put
+	roles.RemoveIf(func(groupID snowflake.ID, _ discord.Role) bool {       // remove
+		return groupID == 3
+	})
+
+	guilds := caches.Guilds()
+	guilds.Get(1)                       // miss
+	guilds.Put(1, discord.Guild{ID: 1}) // put
+	guilds.Get(1)                       // hit
+	guilds.Remove(1)                    // remove
+	guilds.Remove(1)                    // nothing
+
+	expected := map[string]Stats{
+		"roles":  {Hits: 4, Misses: 3, Puts: 9, Removals: 6, Size: 2},
+		"guilds": {Hits: 1, Misses: 1, Puts: 1, Removals: 1, Size: 0},
+	}
+	stats := caches.Stats()
+	for name, expectedStats := range expected {
+		assert.Equal(t, expectedStats, stats[name], name)
+		// the recorder doesn't know the size of the cache
+		expectedStats.Size = 0
+		assert.Equal(t, expectedStats, recorder.stats[name], name)
+	}
+}
@@ -0,0 +1,32 @@
+package cache
+
+import (
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// StickerCache is a GroupedCache for discord.Sticker(s) grouped by their guild.
+type StickerCache interface {
+	GroupedCache[discord.Sticker]
+
+	// FindByName returns the first discord.Sticker with the given name in the guild and a bool indicating if it exists.
+	FindByName(guildID snowflake.ID, name string) (discord.Sticker, bool)
+}
+
+// NewStickerCache returns a new stickerCacheImpl with the given flags and policy.
+// stickerCacheImpl is thread safe and can be used in multiple goroutines.
+func NewStickerCache(flags Flags, policy Policy[discord.Sticker]) StickerCache {
+	return &stickerCacheImpl{
+		GroupedCache: NewGroupedCache[discord.Sticker](flags, FlagStickers, policy),
+	}
+}
+
+type stickerCacheImpl struct {
+	GroupedCache[discord.Sticker]
+}
+
+func (c *stickerCacheImpl) FindByName(guildID snowflake.ID, name string) (discord.Sticker, bool) {
+	return c.GroupFindFirst(guildID, func(_ snowflake.ID, sticker discord.Sticker) bool {
+		return sticker.Name == name
+	})
+}
@@ -0,0 +1,195 @@
+package cache
+
+import (
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// View is an immutable view of the entities of a Cache returned by Cache.Snapshot.
+// It can be iterated for as long as needed without holding the lock of the Cache, so it doesn't block writes from the gateway.
+// The zero value is an empty View.
+type View[T any] struct {
+	entities map[snowflake.ID]T
+}
+
+// NewView returns a View of the given entities. The map must not be modified afterwards.
+// This is used by Cache implementations which don't share their entities with the View.
+func NewView[T any](entities map[snowflake.ID]T) View[T] {
+	return View[T]{entities: entities}
+}
+
+// Get returns the entity with the given snowflake and a bool whether it was found or not.
+func (v View[T]) Get(id snowflake.ID) (T, bool) {
+	entity, ok := v.entities[id]
+	return entity, ok
+}
+
+// Len returns the number of entities in the View.
+func (v View[T]) Len() int {
+	return len(v.entities)
+}
+
+// All returns all entities in the View as a slice.
+func (v View[T]) All() []T {
+	entities := make([]T, 0, len(v.entities))
+	for _, entity := range v.entities {
+		entities = append(entities, entity)
+	}
+	return entities
+}
+
+// MapAll returns a copy of all entities in the View as a map.
+func (v View[T]) MapAll() map[snowflake.ID]T {
+	return copyMap(v.entities)
+}
+
+// FindFirst returns the first entity that passes the given FilterFunc and a bool whether it was found or not.
+func (v View[T]) FindFirst(filterFunc FilterFunc[T]) (T, bool) {
+	for _, entity := range v.entities {
+		if filterFunc(entity) {
+			return entity, true
+		}
+	}
+	var entity T
+	return entity, false
+}
+
+// FindAll returns all entities that pass the given FilterFunc as a slice.
+func (v View[T]) FindAll(filterFunc FilterFunc[T]) []T {
+	var entities []T
+	for _, entity := range v.entities {
+		if filterFunc(entity) {
+			entities = append(entities, entity)
+		}
+	}
+	return entities
+}
+
+// ForEach calls the given function for each entity in the View.
+func (v View[T]) ForEach(forEachFunc func(entity T)) {
+	for _, entity := range v.entities {
+		forEachFunc(entity)
+	}
+}
+
+// Iter returns an iterator over all entities in the View. It is compatible with iter.Seq2 and can be used with range over func.
+// Unlike Cache.Iter no lock is held, so the Cache can be modified from within the loop.
+func (v View[T]) Iter() func(yield func(id snowflake.ID, entity T) bool) {
+	return func(yield func(id snowflake.ID, entity T) bool) {
+		for id, entity := range v.entities {
+			if !yield(id, entity) {
+				return
+			}
+		}
+	}
+}
+
+// GroupedView is an immutable view of the entities of a GroupedCache returned by GroupedCache.Snapshot.
+// It can be iterated for as long as needed without holding the locks of the GroupedCache, so it doesn't block writes from the gateway.
+// The zero value is an empty GroupedView.
+type GroupedView[T any] struct {
+	groups map[snowflake.ID]map[snowflake.ID]T
+}
+
+// NewGroupedView returns a GroupedView of the given entities. The maps must not be modified afterwards.
+// This is used by GroupedCache implementations which don't share their entities with the GroupedView.
+func NewGroupedView[T any](groups map[snowflake.ID]map[snowflake.ID]T) GroupedView[T] {
+	return GroupedView[T]{groups: groups}
+}
+
+// Get returns the entity with the given groupID and ID and a bool whether it was found or not.
+func (v GroupedView[T]) Get(groupID snowflake.ID, id snowflake.ID) (T, bool) {
+	entity, ok := v.groups[groupID][id]
+	return entity, ok
+}
+
+// Group returns a View of the entities within the groupID.
+func (v GroupedView[T]) Group(groupID snowflake.ID) View[T] {
+	return View[T]{entities: v.groups[groupID]}
+}
+
+// Len returns the total number of entities in the GroupedView.
+func (v GroupedView[T]) Len() int {
+	var totalLen int
+	for _, groupEntities := range v.groups {
+		totalLen += len(groupEntities)
+	}
+	return totalLen
+}
+
+// GroupLen returns the number of entities in the GroupedView within the groupID.
+func (v GroupedView[T]) GroupLen(groupID snowflake.ID) int {
+	return len(v.groups[groupID])
+}
+
+// GroupIDs returns the IDs of all groups which hold at least one entity.
+func (v GroupedView[T]) GroupIDs() []snowflake.ID {
+	groupIDs := make([]snowflake.ID, 0, len(v.groups))
+	for groupID, groupEntities := range v.groups {
+		if len(groupEntities) > 0 {
+			groupIDs = append(groupIDs, groupID)
+		}
+	}
+	return groupIDs
+}
+
+// FindFirst returns the first entity that passes the given GroupedFilterFunc.
+func (v GroupedView[T]) FindFirst(filterFunc GroupedFilterFunc[T]) (T, bool) {
+	for groupID, groupEntities := range v.groups {
+		for _, entity := range groupEntities {
+			if filterFunc(groupID, entity) {
+				return entity, true
+			}
+		}
+	}
+	var entity T
+	return entity, false
+}
+
+// FindAll returns all entities that pass the given GroupedFilterFunc.
+func (v GroupedView[T]) FindAll(filterFunc GroupedFilterFunc[T]) []T {
+	var entities []T
+	for groupID, groupEntities := range v.groups {
+		for _, entity := range groupEntities {
+			if filterFunc(groupID, entity) {
+				entities = append(entities, entity)
+			}
+		}
+	}
+	return entities
+}
+
+// ForEach calls the given function for each entity in the GroupedView.
+func (v GroupedView[T]) ForEach(forEachFunc func(groupID snowflake.ID, entity T)) {
+	for groupID, groupEntities := range v.groups {
+		for _, entity := range groupEntities {
+			forEachFunc(groupID, entity)
+		}
+	}
+}
+
+// Iter returns an iterator over all entities in the GroupedView with their groupID. It is compatible with iter.Seq2 and can be used with range over func.
+// Unlike GroupedCache.Iter no lock is held, so the GroupedCache can be modified from within the loop.
+func (v GroupedView[T]) Iter() func(yield func(groupID snowflake.ID, entity T) bool) {
+	return func(yield func(groupID snowflake.ID, entity T) bool) {
+		for groupID, groupEntities := range v.groups {
+			for _, entity := range groupEntities {
+				if !yield(groupID, entity) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// IterGroup returns an iterator over all entities in the GroupedView within the groupID with their ID. It is compatible with iter.Seq2 and can be used with range over func.
+func (v GroupedView[T]) IterGroup(groupID snowflake.ID) func(yield func(id snowflake.ID, entity T) bool) {
+	return v.Group(groupID).Iter()
+}
+
+func copyMap[T any](entities map[snowflake.ID]T) map[snowflake.ID]T {
+	copied := make(map[snowflake.ID]T, len(entities))
+	for id, entity := range entities {
+		copied[id] = entity
+	}
+	return copied
+}
@@ -0,0 +1,74 @@
+// Package clock provides a Clock abstraction used by all disgo modules which depend on time, so tests can advance time deterministically with a Mock.
+package clock
+
+import (
+	"time"
+)
+
+// Default is the Clock used by all disgo modules if no other Clock is configured.
+var Default Clock = System{}
+
+// Clock tells the current time and creates timers.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After waits for the duration to elapse and then sends the current time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+
+	// AfterFunc waits for the duration to elapse and then calls f in its own goroutine.
+	AfterFunc(d time.Duration, f func()) Timer
+
+	// NewTicker returns a new Ticker sending the current time on its channel every duration.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is a single event created by Clock.AfterFunc.
+type Timer interface {
+	// Stop prevents the Timer from firing. It returns false if the Timer already fired or was stopped.
+	Stop() bool
+}
+
+// Ticker sends the current time on its channel in intervals.
+type Ticker interface {
+	// C returns the channel the ticks are sent on.
+	C() <-chan time.Time
+
+	// Stop turns off the Ticker. No more ticks are sent afterwards.
+	Stop()
+}
+
+// System is the Clock using the time package.
+type System struct{}
+
+func (System) Now() time.Time {
+	return time.Now()
+}
+
+func (System) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (System) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+func (System) NewTicker(d time.Duration) Ticker {
+	return systemTicker{time.NewTicker(d)}
+}
+
+type systemTicker struct {
+	*time.Ticker
+}
+
+func (t systemTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
+
+// OrDefault returns the given Clock or Default if it is nil.
+func OrDefault(clock Clock) Clock {
+	if clock == nil {
+		return Default
+	}
+	return clock
+}
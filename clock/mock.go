@@ -0,0 +1,163 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+var _ Clock = (*Mock)(nil)
+
+// NewMock returns a new Mock starting at the given time.
+func NewMock(now time.Time) *Mock {
+	return &Mock{now: now}
+}
+
+// Mock is a Clock which only moves forward when Advance or Set is called. All timers due are fired in order before Advance returns,
+// functions passed to AfterFunc are called synchronously, which makes tests deterministic.
+type Mock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*mockTimer
+}
+
+type mockTimer struct {
+	at       time.Time
+	interval time.Duration
+	fire     func(now time.Time)
+	stopped  bool
+}
+
+func (t *mockTimer) Stop() bool {
+	stopped := t.stopped
+	t.stopped = true
+	return !stopped
+}
+
+func (m *Mock) Now() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.now
+}
+
+func (m *Mock) After(d time.Duration) <-chan time.Time {
+	c := make(chan time.Time, 1)
+	m.add(&mockTimer{fire: func(now time.Time) { c <- now }}, d)
+	return c
+}
+
+func (m *Mock) AfterFunc(d time.Duration, f func()) Timer {
+	timer := &mockTimer{fire: func(time.Time) { f() }}
+	m.add(timer, d)
+	return &mockTimerHandle{mock: m, timer: timer}
+}
+
+func (m *Mock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for Mock.NewTicker")
+	}
+	c := make(chan time.Time, 1)
+	timer := &mockTimer{
+		interval: d,
+		fire: func(now time.Time) {
+			// like time.Ticker, ticks are dropped for slow receivers
+			select {
+			case c <- now:
+			default:
+			}
+		},
+	}
+	m.add(timer, d)
+	return &mockTicker{mock: m, timer: timer, c: c}
+}
+
+func (m *Mock) add(timer *mockTimer, d time.Duration) {
+	m.mu.Lock()
+	timer.at = m.now.Add(d)
+	m.timers = append(m.timers, timer)
+	m.mu.Unlock()
+	if d <= 0 {
+		m.Advance(0)
+	}
+}
+
+// Advance moves the Mock forward by the given duration and fires all timers which are due.
+func (m *Mock) Advance(d time.Duration) {
+	m.mu.Lock()
+	m.setLocked(m.now.Add(d))
+}
+
+// Set moves the Mock to the given time and fires all timers which are due. Moving backwards is ignored.
+func (m *Mock) Set(now time.Time) {
+	m.mu.Lock()
+	m.setLocked(now)
+}
+
+// setLocked needs to be called with the lock held and releases it.
+func (m *Mock) setLocked(now time.Time) {
+	for {
+		m.removeStopped()
+		sort.SliceStable(m.timers, func(i, j int) bool {
+			return m.timers[i].at.Before(m.timers[j].at)
+		})
+		if len(m.timers) == 0 || m.timers[0].at.After(now) {
+			if now.After(m.now) {
+				m.now = now
+			}
+			m.mu.Unlock()
+			return
+		}
+
+		timer := m.timers[0]
+		fireAt := timer.at
+		if fireAt.After(m.now) {
+			m.now = fireAt
+		}
+		if timer.interval > 0 {
+			timer.at = timer.at.Add(timer.interval)
+		} else {
+			timer.stopped = true
+			m.timers = m.timers[1:]
+		}
+		m.mu.Unlock()
+		timer.fire(fireAt)
+		m.mu.Lock()
+	}
+}
+
+func (m *Mock) removeStopped() {
+	timers := m.timers[:0]
+	for _, t := range m.timers {
+		if !t.stopped {
+			timers = append(timers, t)
+		}
+	}
+	m.timers = timers
+}
+
+type mockTimerHandle struct {
+	mock  *Mock
+	timer *mockTimer
+}
+
+func (h *mockTimerHandle) Stop() bool {
+	h.mock.mu.Lock()
+	defer h.mock.mu.Unlock()
+	return h.timer.Stop()
+}
+
+type mockTicker struct {
+	mock  *Mock
+	timer *mockTimer
+	c     chan time.Time
+}
+
+func (t *mockTicker) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *mockTicker) Stop() {
+	t.mock.mu.Lock()
+	defer t.mock.mu.Unlock()
+	t.timer.Stop()
+}
@@ -0,0 +1,38 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMock(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	mock := NewMock(start)
+
+	var fired []time.Time
+	mock.AfterFunc(2*time.Second, func() { fired = append(fired, mock.Now()) })
+	stopped := mock.AfterFunc(time.Second, func() { t.Fatal("stopped timer fired") })
+	assert.True(t, stopped.Stop())
+	after := mock.After(3 * time.Second)
+	ticker := mock.NewTicker(time.Second)
+
+	mock.Advance(time.Second)
+	assert.Equal(t, start.Add(time.Second), <-ticker.C())
+	assert.Empty(t, fired)
+
+	mock.Advance(2 * time.Second)
+	assert.Equal(t, []time.Time{start.Add(2 * time.Second)}, fired)
+	assert.Equal(t, start.Add(3*time.Second), <-after)
+	assert.Equal(t, start.Add(2*time.Second), <-ticker.C())
+	assert.Equal(t, start.Add(3*time.Second), mock.Now())
+
+	ticker.Stop()
+	mock.Advance(time.Second)
+	select {
+	case <-ticker.C():
+		t.Fatal("stopped ticker ticked")
+	default:
+	}
+}
@@ -1,6 +1,12 @@
 package discord
 
-import "github.com/disgoorg/snowflake/v2"
+import (
+	"strings"
+	"time"
+
+	"github.com/disgoorg/disgo/rest/route"
+	"github.com/disgoorg/snowflake/v2"
+)
 
 // ActivityType represents the status of a user, one of Game, Streaming, Listening, Watching, Custom or Competing
 type ActivityType int
@@ -33,6 +39,72 @@ type Activity struct {
 	Instance      *bool               `json:"instance,omitempty"`
 	Flags         ActivityFlags       `json:"flags,omitempty"`
 	Buttons       []string            `json:"buttons"`
+	Metadata      *ActivityMetadata   `json:"metadata,omitempty"`
+}
+
+// ActivityButtons returns the Activity.Buttons labels paired with the urls from the Activity.Metadata.
+// The urls are only sent for some activities, in which case ActivityButton.URL is empty.
+func (a Activity) ActivityButtons() []ActivityButton {
+	if len(a.Buttons) == 0 {
+		return nil
+	}
+	buttons := make([]ActivityButton, len(a.Buttons))
+	for i, label := range a.Buttons {
+		buttons[i].Label = label
+		if a.Metadata != nil && i < len(a.Metadata.ButtonURLs) {
+			buttons[i].URL = a.Metadata.ButtonURLs[i]
+		}
+	}
+	return buttons
+}
+
+// LargeImageURL returns the url of the ActivityAssets.LargeImage or nil if the Activity has none.
+func (a Activity) LargeImageURL(opts ...CDNOpt) *string {
+	if a.Assets == nil {
+		return nil
+	}
+	return activityAssetURL(a.ApplicationID, a.Assets.LargeImage, opts)
+}
+
+// SmallImageURL returns the url of the ActivityAssets.SmallImage or nil if the Activity has none.
+func (a Activity) SmallImageURL(opts ...CDNOpt) *string {
+	if a.Assets == nil {
+		return nil
+	}
+	return activityAssetURL(a.ApplicationID, a.Assets.SmallImage, opts)
+}
+
+// activityAssetURL resolves an activity asset to its url.
+// Assets are either the id of an application asset or prefixed with the platform they are hosted on, like "mp:" for external assets proxied by discord.
+func activityAssetURL(applicationID snowflake.ID, asset string, opts []CDNOpt) *string {
+	if asset == "" {
+		return nil
+	}
+	var url string
+	if prefix, id, ok := strings.Cut(asset, ":"); ok {
+		switch prefix {
+		case "mp":
+			url = "https://media.discordapp.net/" + id
+		case "spotify":
+			url = "https://i.scdn.co/image/" + id
+		case "youtube":
+			url = "https://i.ytimg.com/vi/" + id + "/hqdefault_live.jpg"
+		case "twitch":
+			url = "https://static-cdn.jtvnw.net/previews-ttv/live_user_" + id + ".png"
+		default:
+			return nil
+		}
+		return &url
+	}
+	if applicationID == 0 {
+		return nil
+	}
+	return formatAssetURL(route.ApplicationAsset, opts, applicationID, asset)
+}
+
+// ActivityMetadata contains additional information about an Activity
+type ActivityMetadata struct {
+	ButtonURLs []string `json:"button_urls,omitempty"`
 }
 
 // ActivityFlags add additional information to an activity
@@ -63,6 +135,22 @@ type ActivityTimestamps struct {
 	End   int64 `json:"end,omitempty"`
 }
 
+// StartTime returns the ActivityTimestamps.Start as time.Time or the zero time if it is not set
+func (t ActivityTimestamps) StartTime() time.Time {
+	if t.Start == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(t.Start)
+}
+
+// EndTime returns the ActivityTimestamps.End as time.Time or the zero time if it is not set
+func (t ActivityTimestamps) EndTime() time.Time {
+	if t.End == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(t.End)
+}
+
 // ActivityEmoji is an Emoji object for an Activity
 type ActivityEmoji struct {
 	Name     string        `json:"name"`
@@ -76,6 +164,22 @@ type ActivityParty struct {
 	Size []int  `json:"size,omitempty"`
 }
 
+// CurrentSize returns the current size of the ActivityParty or 0 if it is not set
+func (p ActivityParty) CurrentSize() int {
+	if len(p.Size) < 1 {
+		return 0
+	}
+	return p.Size[0]
+}
+
+// MaxSize returns the max size of the ActivityParty or 0 if it is not set
+func (p ActivityParty) MaxSize() int {
+	if len(p.Size) < 2 {
+		return 0
+	}
+	return p.Size[1]
+}
+
 // ActivityAssets are the images for the presence and hover texts
 type ActivityAssets struct {
 	LargeImage string `json:"large_image,omitempty"`
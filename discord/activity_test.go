@@ -0,0 +1,32 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/disgoorg/disgo/json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActivity_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{
+		"name": "Game",
+		"type": 0,
+		"application_id": "123",
+		"buttons": ["Join", "Watch"],
+		"metadata": {"button_urls": ["https://example.com/join"]},
+		"assets": {"large_image": "456", "small_image": "mp:external/abc/https/example.com/small.png"},
+		"party": {"id": "party", "size": [2, 4]},
+		"timestamps": {"start": 1700000000000}
+	}`)
+
+	var activity Activity
+	assert.NoError(t, json.Unmarshal(data, &activity))
+
+	assert.Equal(t, []ActivityButton{{Label: "Join", URL: "https://example.com/join"}, {Label: "Watch"}}, activity.ActivityButtons())
+	assert.Equal(t, "https://cdn.discordapp.com/app-assets/123/456.png", *activity.LargeImageURL())
+	assert.Equal(t, "https://media.discordapp.net/external/abc/https/example.com/small.png", *activity.SmallImageURL())
+	assert.Equal(t, 2, activity.Party.CurrentSize())
+	assert.Equal(t, 4, activity.Party.MaxSize())
+	assert.Equal(t, int64(1700000000000), activity.Timestamps.StartTime().UnixMilli())
+	assert.True(t, activity.Timestamps.EndTime().IsZero())
+}
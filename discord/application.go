@@ -31,6 +31,10 @@ type Application struct {
 	Slug                  *string             `json:"slug,omitempty"`
 	Cover                 *string             `json:"cover_image,omitempty"`
 	Flags                 ApplicationFlags    `json:"flags,omitempty"`
+	// ApproximateGuildCount is only present when the Application is fetched via /applications/@me
+	ApproximateGuildCount *int `json:"approximate_guild_count,omitempty"`
+	// ApproximateUserInstallCount is only present when the Application is fetched via /applications/@me
+	ApproximateUserInstallCount *int `json:"approximate_user_install_count,omitempty"`
 }
 
 func (a Application) IconURL(opts ...CDNOpt) *string {
@@ -154,14 +158,23 @@ func (t TokenType) Apply(token string) string {
 type ApplicationFlags int
 
 const (
-	ApplicationFlagGatewayPresence = 1 << (iota + 12)
+	ApplicationFlagApplicationAutoModerationRuleCreateBadge ApplicationFlags = 1 << 6
+	ApplicationFlagApplicationCommandBadge                  ApplicationFlags = 1 << 23
+)
+
+const (
+	ApplicationFlagGatewayPresence ApplicationFlags = 1 << (iota + 12)
 	ApplicationFlagGatewayPresenceLimited
 	ApplicationFlagGatewayGuildMembers
 	ApplicationFlagGatewayGuildMemberLimited
 	ApplicationFlagVerificationPendingGuildLimit
 	ApplicationFlagEmbedded
+	ApplicationFlagGatewayMessageContent
+	ApplicationFlagGatewayMessageContentLimited
 )
 
+const ApplicationFlagsNone ApplicationFlags = 0
+
 // Add allows you to add multiple bits together, producing a new bit
 func (f ApplicationFlags) Add(bits ...ApplicationFlags) ApplicationFlags {
 	for _, bit := range bits {
@@ -198,6 +211,32 @@ func (f ApplicationFlags) Missing(bits ...ApplicationFlags) bool {
 	return false
 }
 
+// OwnerIDs returns the IDs of all users owning this Application.
+// If the Application belongs to a Team, all members who accepted the invitation are returned, otherwise the Owner.
+func (a Application) OwnerIDs() []snowflake.ID {
+	if a.Team != nil {
+		ownerIDs := make([]snowflake.ID, 0, len(a.Team.Members))
+		for _, member := range a.Team.Members {
+			if member.MembershipState == MembershipStateAccepted {
+				ownerIDs = append(ownerIDs, member.User.ID)
+			}
+		}
+		return ownerIDs
+	}
+	if a.Owner != nil {
+		return []snowflake.ID{a.Owner.ID}
+	}
+	return nil
+}
+
+// ApplicationIntegrationType is where an application can be installed (https://discord.com/developers/docs/resources/application#application-object-application-integration-types)
+type ApplicationIntegrationType int
+
+const (
+	ApplicationIntegrationTypeGuildInstall ApplicationIntegrationType = iota
+	ApplicationIntegrationTypeUserInstall
+)
+
 type Team struct {
 	Icon    *string      `json:"icon"`
 	ID      snowflake.ID `json:"id"`
@@ -0,0 +1,75 @@
+package discord
+
+// ChoiceValue are the types the value of an ApplicationCommandOptionChoice or AutocompleteChoice can have.
+type ChoiceValue interface {
+	string | int | float64
+}
+
+// NewChoice returns the ApplicationCommandOptionChoiceString, ApplicationCommandOptionChoiceInt or ApplicationCommandOptionChoiceFloat matching the type of value.
+func NewChoice[T ChoiceValue](name string, value T) ApplicationCommandOptionChoice {
+	switch v := any(value).(type) {
+	case string:
+		return ApplicationCommandOptionChoiceString{Name: name, Value: v}
+	case int:
+		return ApplicationCommandOptionChoiceInt{Name: name, Value: v}
+	default:
+		return ApplicationCommandOptionChoiceFloat{Name: name, Value: any(value).(float64)}
+	}
+}
+
+// NewAutocompleteChoice returns the AutocompleteChoiceString, AutocompleteChoiceInt or AutocompleteChoiceFloat matching the type of value.
+func NewAutocompleteChoice[T ChoiceValue](name string, value T) AutocompleteChoice {
+	switch v := any(value).(type) {
+	case string:
+		return AutocompleteChoiceString{Name: name, Value: v}
+	case int:
+		return AutocompleteChoiceInt{Name: name, Value: v}
+	default:
+		return AutocompleteChoiceFloat{Name: name, Value: any(value).(float64)}
+	}
+}
+
+// ChoiceValueOf returns the value of the given ApplicationCommandOptionChoice if it is of type T.
+func ChoiceValueOf[T ChoiceValue](choice ApplicationCommandOptionChoice) (T, bool) {
+	var value any
+	switch c := choice.(type) {
+	case ApplicationCommandOptionChoiceString:
+		value = c.Value
+	case ApplicationCommandOptionChoiceInt:
+		value = c.Value
+	case ApplicationCommandOptionChoiceFloat:
+		value = c.Value
+	}
+	v, ok := value.(T)
+	return v, ok
+}
+
+// OptChoice returns the value of the option with the given name if it is of type T.
+func OptChoice[T ChoiceValue](data SlashCommandInteractionData, name string) (T, bool) {
+	var value any
+	switch option, _ := data.Option(name); o := option.(type) {
+	case SlashCommandOptionString:
+		value = o.Value
+	case SlashCommandOptionInt:
+		value = o.Value
+	case SlashCommandOptionFloat:
+		value = o.Value
+	}
+	v, ok := value.(T)
+	return v, ok
+}
+
+// OptAutocompleteChoice returns the value of the autocomplete option with the given name if it is of type T.
+func OptAutocompleteChoice[T ChoiceValue](data AutocompleteInteractionData, name string) (T, bool) {
+	var value any
+	switch option, _ := data.Option(name); o := option.(type) {
+	case AutocompleteOptionString:
+		value = o.Value
+	case AutocompleteOptionInt:
+		value = o.Value
+	case AutocompleteOptionFloat:
+		value = o.Value
+	}
+	v, ok := value.(T)
+	return v, ok
+}
@@ -0,0 +1,38 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewChoice(t *testing.T) {
+	assert.Equal(t, ApplicationCommandOptionChoiceString{Name: "a", Value: "b"}, NewChoice("a", "b"))
+	assert.Equal(t, ApplicationCommandOptionChoiceInt{Name: "a", Value: 1}, NewChoice("a", 1))
+	assert.Equal(t, ApplicationCommandOptionChoiceFloat{Name: "a", Value: 1.5}, NewChoice("a", 1.5))
+	assert.Equal(t, AutocompleteChoiceInt{Name: "a", Value: 1}, NewAutocompleteChoice("a", 1))
+
+	value, ok := ChoiceValueOf[int](NewChoice("a", 1))
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	_, ok = ChoiceValueOf[string](NewChoice("a", 1))
+	assert.False(t, ok)
+}
+
+func TestOptChoice(t *testing.T) {
+	data := SlashCommandInteractionData{
+		Options: map[string]SlashCommandOption{
+			"count": SlashCommandOptionInt{OptionName: "count", Value: 3},
+		},
+	}
+
+	count, ok := OptChoice[int](data, "count")
+	assert.True(t, ok)
+	assert.Equal(t, 3, count)
+
+	_, ok = OptChoice[string](data, "count")
+	assert.False(t, ok)
+	_, ok = OptChoice[int](data, "missing")
+	assert.False(t, ok)
+}
@@ -1,6 +1,16 @@
 package discord
 
-import "github.com/disgoorg/snowflake/v2"
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+)
 
 //Attachment is used for files sent in a Message
 type Attachment struct {
@@ -16,6 +26,123 @@ type Attachment struct {
 	Ephemeral   bool         `json:"ephemeral,omitempty"`
 }
 
+// ExpiresAt returns when the signed URL of the Attachment expires or nil if the URL is not signed
+func (a Attachment) ExpiresAt() *time.Time {
+	u, err := url.Parse(a.URL)
+	if err != nil {
+		return nil
+	}
+	ex := u.Query().Get("ex")
+	if ex == "" {
+		return nil
+	}
+	unix, err := strconv.ParseInt(ex, 16, 64)
+	if err != nil {
+		return nil
+	}
+	expiresAt := time.Unix(unix, 0)
+	return &expiresAt
+}
+
+// Expired returns whether the signed URL of the Attachment has expired
+func (a Attachment) Expired() bool {
+	expiresAt := a.ExpiresAt()
+	return expiresAt != nil && time.Now().After(*expiresAt)
+}
+
+// AttachmentURLRefresher is used by Attachment.Download to refresh expired signed URLs.
+// bot.BuildClient sets it to rest.Channels.RefreshAttachmentURL of the built client if it is nil.
+var AttachmentURLRefresher func(ctx context.Context, url string) (string, error)
+
+// RefreshAttachmentURLs is the request body to refresh expired attachment URLs.
+type RefreshAttachmentURLs struct {
+	AttachmentURLs []string `json:"attachment_urls"`
+}
+
+// RefreshAttachmentURLsResponse is the response to refreshing expired attachment URLs.
+type RefreshAttachmentURLsResponse struct {
+	RefreshedURLs []RefreshedAttachmentURL `json:"refreshed_urls"`
+}
+
+// RefreshedAttachmentURL is a refreshed signed attachment URL.
+type RefreshedAttachmentURL struct {
+	Original  string `json:"original"`
+	Refreshed string `json:"refreshed"`
+}
+
+// Download downloads the Attachment. Expired signed URLs are refreshed via the AttachmentURLRefresher.
+// It returns ErrAttachmentURLExpired if the signed URL has expired and no AttachmentURLRefresher is set.
+// The caller is responsible for closing the returned io.ReadCloser.
+func (a Attachment) Download(ctx context.Context) (io.ReadCloser, error) {
+	return a.DownloadWithProgress(ctx, 0, nil)
+}
+
+// DownloadWithProgress downloads the Attachment starting at the given byte offset and reports the progress to the optional ProgressFunc while reading.
+// Use the offset to resume an interrupted download. Expired signed URLs are refreshed like in Download.
+// The caller is responsible for closing the returned io.ReadCloser.
+func (a Attachment) DownloadWithProgress(ctx context.Context, offset int64, progress ProgressFunc) (io.ReadCloser, error) {
+	refreshed := false
+	if a.Expired() {
+		if err := a.refreshURL(ctx); err != nil {
+			return nil, err
+		}
+		refreshed = true
+	}
+	rs, err := a.download(ctx, offset)
+	if err != nil {
+		return nil, err
+	}
+	// the signature might be rejected before we consider the URL expired
+	if !refreshed && AttachmentURLRefresher != nil && (rs.StatusCode == http.StatusForbidden || rs.StatusCode == http.StatusNotFound) {
+		_ = rs.Body.Close()
+		if err = a.refreshURL(ctx); err != nil {
+			return nil, err
+		}
+		if rs, err = a.download(ctx, offset); err != nil {
+			return nil, err
+		}
+	}
+	if offset > 0 && rs.StatusCode != http.StatusPartialContent {
+		_ = rs.Body.Close()
+		return nil, fmt.Errorf("failed to resume attachment download: %s", rs.Status)
+	}
+	if rs.StatusCode != http.StatusOK && rs.StatusCode != http.StatusPartialContent {
+		_ = rs.Body.Close()
+		return nil, fmt.Errorf("failed to download attachment: %s", rs.Status)
+	}
+	if progress == nil {
+		return rs.Body, nil
+	}
+	total := int64(a.Size)
+	if rs.ContentLength >= 0 {
+		total = offset + rs.ContentLength
+	}
+	return NewProgressReader(rs.Body, offset, total, progress), nil
+}
+
+func (a *Attachment) refreshURL(ctx context.Context) error {
+	if AttachmentURLRefresher == nil {
+		return ErrAttachmentURLExpired
+	}
+	refreshedURL, err := AttachmentURLRefresher(ctx, a.URL)
+	if err != nil {
+		return fmt.Errorf("failed to refresh attachment url: %w", err)
+	}
+	a.URL = refreshedURL
+	return nil
+}
+
+func (a Attachment) download(ctx context.Context, offset int64) (*http.Response, error) {
+	rq, err := http.NewRequestWithContext(ctx, http.MethodGet, a.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if offset > 0 {
+		rq.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	return http.DefaultClient.Do(rq)
+}
+
 type AttachmentUpdate interface {
 	attachmentUpdate()
 }
@@ -0,0 +1,45 @@
+package discord
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachmentDownloadRefreshesURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/refreshed" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("content"))
+	}))
+	defer server.Close()
+
+	defer func(refresher func(ctx context.Context, url string) (string, error)) {
+		AttachmentURLRefresher = refresher
+	}(AttachmentURLRefresher)
+	AttachmentURLRefresher = func(_ context.Context, url string) (string, error) {
+		assert.Equal(t, server.URL+"/expired?ex=1", url)
+		return server.URL + "/refreshed", nil
+	}
+
+	attachment := Attachment{URL: server.URL + "/expired?ex=1"}
+	assert.True(t, attachment.Expired())
+
+	reader, err := attachment.Download(context.Background())
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, "content", string(data))
+
+	AttachmentURLRefresher = nil
+	_, err = attachment.Download(context.Background())
+	assert.ErrorIs(t, err, ErrAttachmentURLExpired)
+}
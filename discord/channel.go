@@ -194,7 +194,7 @@ type GuildTextChannel struct {
 	topic                      *string
 	nsfw                       bool
 	lastMessageID              *snowflake.ID
-	rateLimitPerUser           int
+	rateLimitPerUser           SlowmodeDuration
 	parentID                   *snowflake.ID
 	lastPinTimestamp           *time.Time
 	defaultAutoArchiveDuration AutoArchiveDuration
@@ -295,6 +295,11 @@ func (c GuildTextChannel) DefaultAutoArchiveDuration() AutoArchiveDuration {
 	return c.defaultAutoArchiveDuration
 }
 
+// RateLimitPerUser returns the slowmode of the GuildTextChannel.
+func (c GuildTextChannel) RateLimitPerUser() SlowmodeDuration {
+	return c.rateLimitPerUser
+}
+
 func (GuildTextChannel) channel()             {}
 func (GuildTextChannel) guildChannel()        {}
 func (GuildTextChannel) messageChannel()      {}
@@ -385,6 +390,8 @@ type GuildVoiceChannel struct {
 	topic                      *string
 	nsfw                       bool
 	defaultAutoArchiveDuration AutoArchiveDuration
+	// Status is the voice channel status which is shown in the channel list. It is only sent in the GUILD_CREATE event and kept up to date through the VOICE_CHANNEL_STATUS_UPDATE event
+	Status *string
 }
 
 func (c *GuildVoiceChannel) UnmarshalJSON(data []byte) error {
@@ -408,6 +415,7 @@ func (c *GuildVoiceChannel) UnmarshalJSON(data []byte) error {
 	c.topic = v.Topic
 	c.nsfw = v.NSFW
 	c.defaultAutoArchiveDuration = v.DefaultAutoArchiveDuration
+	c.Status = v.Status
 	return nil
 }
 
@@ -429,6 +437,7 @@ func (c GuildVoiceChannel) MarshalJSON() ([]byte, error) {
 		Topic:                      c.topic,
 		NSFW:                       c.nsfw,
 		DefaultAutoArchiveDuration: c.defaultAutoArchiveDuration,
+		Status:                     c.Status,
 	})
 }
 
@@ -596,7 +605,7 @@ type GuildNewsChannel struct {
 	topic                      *string
 	nsfw                       bool
 	lastMessageID              *snowflake.ID
-	rateLimitPerUser           int
+	rateLimitPerUser           SlowmodeDuration
 	parentID                   *snowflake.ID
 	lastPinTimestamp           *time.Time
 	defaultAutoArchiveDuration AutoArchiveDuration
@@ -681,6 +690,11 @@ func (c GuildNewsChannel) DefaultAutoArchiveDuration() AutoArchiveDuration {
 	return c.defaultAutoArchiveDuration
 }
 
+// RateLimitPerUser returns the slowmode of the GuildNewsChannel.
+func (c GuildNewsChannel) RateLimitPerUser() SlowmodeDuration {
+	return c.rateLimitPerUser
+}
+
 func (c GuildNewsChannel) LastMessageID() *snowflake.ID {
 	return c.lastMessageID
 }
@@ -717,7 +731,7 @@ type GuildThread struct {
 	nsfw             bool
 	lastMessageID    *snowflake.ID
 	lastPinTimestamp *time.Time
-	RateLimitPerUser int
+	RateLimitPerUser SlowmodeDuration
 	OwnerID          snowflake.ID
 	parentID         snowflake.ID
 	MessageCount     int
@@ -953,6 +967,7 @@ type ThreadMetadata struct {
 	CreateTimestamp     time.Time           `json:"create_timestamp"`
 }
 
+// AutoArchiveDuration is the duration in minutes after which a GuildThread is archived without activity.
 type AutoArchiveDuration int
 
 const (
@@ -962,6 +977,56 @@ const (
 	AutoArchiveDuration1w  AutoArchiveDuration = 10080
 )
 
+// NewAutoArchiveDuration converts the given time.Duration to an AutoArchiveDuration.
+// It returns ErrInvalidAutoArchiveDuration if the duration is not one of the allowed values.
+func NewAutoArchiveDuration(d time.Duration) (AutoArchiveDuration, error) {
+	duration := AutoArchiveDuration(d / time.Minute)
+	if time.Duration(duration)*time.Minute != d || !duration.Valid() {
+		return 0, ErrInvalidAutoArchiveDuration
+	}
+	return duration, nil
+}
+
+// Valid returns whether the AutoArchiveDuration is one of the values allowed by discord.
+func (d AutoArchiveDuration) Valid() bool {
+	switch d {
+	case AutoArchiveDuration1h, AutoArchiveDuration24h, AutoArchiveDuration3d, AutoArchiveDuration1w:
+		return true
+	}
+	return false
+}
+
+// Duration returns the AutoArchiveDuration as time.Duration.
+func (d AutoArchiveDuration) Duration() time.Duration {
+	return time.Duration(d) * time.Minute
+}
+
+// MaxSlowmodeDuration is the maximum SlowmodeDuration discord allows.
+const MaxSlowmodeDuration SlowmodeDuration = 21600
+
+// SlowmodeDuration is the amount of seconds a user has to wait between sending messages (rate_limit_per_user).
+type SlowmodeDuration int
+
+// NewSlowmodeDuration converts the given time.Duration to a SlowmodeDuration.
+// It returns ErrInvalidSlowmodeDuration if the duration is not a whole amount of seconds between 0 and 6 hours.
+func NewSlowmodeDuration(d time.Duration) (SlowmodeDuration, error) {
+	duration := SlowmodeDuration(d / time.Second)
+	if time.Duration(duration)*time.Second != d || !duration.Valid() {
+		return 0, ErrInvalidSlowmodeDuration
+	}
+	return duration, nil
+}
+
+// Valid returns whether the SlowmodeDuration is within the range allowed by discord.
+func (d SlowmodeDuration) Valid() bool {
+	return d >= 0 && d <= MaxSlowmodeDuration
+}
+
+// Duration returns the SlowmodeDuration as time.Duration.
+func (d SlowmodeDuration) Duration() time.Duration {
+	return time.Duration(d) * time.Second
+}
+
 func channelString(channel Channel) string {
 	return fmt.Sprintf("%d:%s(%s)", channel.Type(), channel.Name(), channel.ID())
 }
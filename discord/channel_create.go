@@ -24,7 +24,7 @@ var (
 type GuildTextChannelCreate struct {
 	Name                       string                `json:"name"`
 	Topic                      string                `json:"topic,omitempty"`
-	RateLimitPerUser           int                   `json:"rate_limit_per_user,omitempty"`
+	RateLimitPerUser           SlowmodeDuration      `json:"rate_limit_per_user,omitempty"`
 	Position                   int                   `json:"position,omitempty"`
 	PermissionOverwrites       []PermissionOverwrite `json:"permission_overwrites,omitempty"`
 	ParentID                   snowflake.ID          `json:"parent_id,omitempty"`
@@ -63,6 +63,8 @@ type GuildVoiceChannelCreate struct {
 	Position             int                   `json:"position,omitempty"`
 	PermissionOverwrites []PermissionOverwrite `json:"permission_overwrites,omitempty"`
 	ParentID             snowflake.ID          `json:"parent_id,omitempty"`
+	RTCRegion            string                `json:"rtc_region,omitempty"`
+	VideoQualityMode     VideoQualityMode      `json:"video_quality_mode,omitempty"`
 }
 
 func (c GuildVoiceChannelCreate) Type() ChannelType {
@@ -121,7 +123,7 @@ var (
 type GuildNewsChannelCreate struct {
 	Name                       string                `json:"name"`
 	Topic                      string                `json:"topic,omitempty"`
-	RateLimitPerUser           int                   `json:"rate_limit_per_user,omitempty"`
+	RateLimitPerUser           SlowmodeDuration      `json:"rate_limit_per_user,omitempty"`
 	Position                   int                   `json:"position,omitempty"`
 	PermissionOverwrites       []PermissionOverwrite `json:"permission_overwrites,omitempty"`
 	ParentID                   snowflake.ID          `json:"parent_id,omitempty"`
@@ -160,6 +162,8 @@ type GuildStageVoiceChannelCreate struct {
 	Position             int                   `json:"position,omitempty"`
 	PermissionOverwrites []PermissionOverwrite `json:"permission_overwrites,omitempty"`
 	ParentID             snowflake.ID          `json:"parent_id,omitempty"`
+	RTCRegion            string                `json:"rtc_region,omitempty"`
+	VideoQualityMode     VideoQualityMode      `json:"video_quality_mode,omitempty"`
 }
 
 func (c GuildStageVoiceChannelCreate) Type() ChannelType {
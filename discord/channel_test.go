@@ -0,0 +1,51 @@
+package discord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAutoArchiveDuration(t *testing.T) {
+	duration, err := NewAutoArchiveDuration(24 * time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, AutoArchiveDuration24h, duration)
+	assert.Equal(t, 24*time.Hour, duration.Duration())
+
+	_, err = NewAutoArchiveDuration(2 * time.Hour)
+	assert.ErrorIs(t, err, ErrInvalidAutoArchiveDuration)
+}
+
+func TestNewSlowmodeDuration(t *testing.T) {
+	duration, err := NewSlowmodeDuration(30 * time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, SlowmodeDuration(30), duration)
+
+	_, err = NewSlowmodeDuration(1500 * time.Millisecond)
+	assert.ErrorIs(t, err, ErrInvalidSlowmodeDuration)
+
+	_, err = NewSlowmodeDuration(7 * time.Hour)
+	assert.ErrorIs(t, err, ErrInvalidSlowmodeDuration)
+}
+
+func TestNewChannelTree(t *testing.T) {
+	categoryID := snowflake.ID(10)
+	category := GuildCategoryChannel{id: categoryID, position: 0}
+	otherCategory := GuildCategoryChannel{id: 11, position: 1}
+	voice := GuildVoiceChannel{id: 1, position: 0, parentID: &categoryID}
+	text := GuildTextChannel{id: 2, position: 5, parentID: &categoryID}
+	secondText := GuildTextChannel{id: 3, position: 5, parentID: &categoryID}
+	noCategory := GuildTextChannel{id: 4, position: 9}
+	thread := GuildThread{id: 5, parentID: categoryID}
+
+	tree := NewChannelTree([]GuildChannel{otherCategory, voice, secondText, thread, category, text, noCategory})
+
+	assert.Equal(t, []GuildChannel{noCategory}, tree.Channels)
+	assert.Len(t, tree.Categories, 2)
+	assert.Equal(t, category, tree.Categories[0].Category)
+	assert.Equal(t, []GuildChannel{text, secondText, voice}, tree.Categories[0].Channels)
+	assert.Empty(t, tree.Categories[1].Channels)
+	assert.Equal(t, []GuildChannel{noCategory, category, text, secondText, voice, otherCategory}, tree.Flatten())
+}
@@ -0,0 +1,89 @@
+package discord
+
+import (
+	"sort"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// ChannelTree is the ordered channel list of a guild like it is displayed in the discord client.
+type ChannelTree struct {
+	// Channels are the channels without a category which are displayed above all categories.
+	Channels []GuildChannel
+	// Categories are the categories with their channels.
+	Categories []ChannelTreeCategory
+}
+
+// ChannelTreeCategory is a GuildCategoryChannel with its ordered channels.
+type ChannelTreeCategory struct {
+	Category GuildCategoryChannel
+	Channels []GuildChannel
+}
+
+// Flatten returns all channels of the ChannelTree in display order with each category followed by its channels.
+func (t ChannelTree) Flatten() []GuildChannel {
+	channels := make([]GuildChannel, 0, len(t.Channels)+len(t.Categories))
+	channels = append(channels, t.Channels...)
+	for _, category := range t.Categories {
+		channels = append(channels, category.Category)
+		channels = append(channels, category.Channels...)
+	}
+	return channels
+}
+
+// NewChannelTree builds the ChannelTree of the given guild channels.
+// Like in the discord client text channels are sorted above audio channels, then channels are sorted by their position and ID.
+// Threads are ignored and channels whose category is missing are treated as channels without a category.
+func NewChannelTree(channels []GuildChannel) ChannelTree {
+	var (
+		tree       ChannelTree
+		categories = map[snowflake.ID]int{}
+		children   []GuildChannel
+	)
+	for _, channel := range channels {
+		switch ch := channel.(type) {
+		case GuildThread:
+			continue
+		case GuildCategoryChannel:
+			tree.Categories = append(tree.Categories, ChannelTreeCategory{Category: ch})
+		default:
+			children = append(children, channel)
+		}
+	}
+
+	sort.Slice(tree.Categories, func(i, j int) bool {
+		return lessChannel(tree.Categories[i].Category, tree.Categories[j].Category)
+	})
+	for i, category := range tree.Categories {
+		categories[category.Category.ID()] = i
+	}
+
+	sort.Slice(children, func(i, j int) bool {
+		return lessChannel(children[i], children[j])
+	})
+	for _, channel := range children {
+		if parentID := channel.ParentID(); parentID != nil {
+			if i, ok := categories[*parentID]; ok {
+				tree.Categories[i].Channels = append(tree.Categories[i].Channels, channel)
+				continue
+			}
+		}
+		tree.Channels = append(tree.Channels, channel)
+	}
+	return tree
+}
+
+// lessChannel reports whether channel a is displayed above channel b.
+func lessChannel(a GuildChannel, b GuildChannel) bool {
+	if aAudio, bAudio := isAudioChannelType(a.Type()), isAudioChannelType(b.Type()); aAudio != bAudio {
+		return bAudio
+	}
+	if a.Position() != b.Position() {
+		return a.Position() < b.Position()
+	}
+	return a.ID() < b.ID()
+}
+
+func isAudioChannelType(channelType ChannelType) bool {
+	return channelType == ChannelTypeGuildVoice || channelType == ChannelTypeGuildStageVoice
+}
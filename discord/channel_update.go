@@ -20,7 +20,7 @@ type GuildTextChannelUpdate struct {
 	Position                   *int                   `json:"position,omitempty"`
 	Topic                      *string                `json:"topic,omitempty"`
 	NSFW                       *bool                  `json:"nsfw,omitempty"`
-	RateLimitPerUser           *int                   `json:"rate_limit_per_user,omitempty"`
+	RateLimitPerUser           *SlowmodeDuration      `json:"rate_limit_per_user,omitempty"`
 	PermissionOverwrites       *[]PermissionOverwrite `json:"permission_overwrites,omitempty"`
 	ParentID                   *snowflake.ID          `json:"parent_id,omitempty"`
 	DefaultAutoArchiveDuration *AutoArchiveDuration   `json:"default_auto_archive_duration,omitempty"`
@@ -32,18 +32,23 @@ func (GuildTextChannelUpdate) guildChannelUpdate() {}
 type GuildVoiceChannelUpdate struct {
 	Name                 *string                `json:"name,omitempty"`
 	Position             *int                   `json:"position,omitempty"`
-	RateLimitPerUser     *int                   `json:"rate_limit_per_user,omitempty"`
+	RateLimitPerUser     *SlowmodeDuration      `json:"rate_limit_per_user,omitempty"`
 	Bitrate              *int                   `json:"bitrate,omitempty"`
 	UserLimit            *int                   `json:"user_limit,omitempty"`
 	PermissionOverwrites *[]PermissionOverwrite `json:"permission_overwrites,omitempty"`
 	ParentID             *snowflake.ID          `json:"parent_id,omitempty"`
-	RTCRegion            *string                `json:"rtc_region"`
-	VideoQualityMode     *VideoQualityMode      `json:"video_quality_mode"`
+	RTCRegion            *json.Nullable[string] `json:"rtc_region,omitempty"`
+	VideoQualityMode     *VideoQualityMode      `json:"video_quality_mode,omitempty"`
 }
 
 func (GuildVoiceChannelUpdate) channelUpdate()      {}
 func (GuildVoiceChannelUpdate) guildChannelUpdate() {}
 
+// VoiceChannelStatusUpdate is used to set the status of a GuildVoiceChannel. An empty Status clears it.
+type VoiceChannelStatusUpdate struct {
+	Status string `json:"status"`
+}
+
 type GuildCategoryChannelUpdate struct {
 	Name                 *string                `json:"name,omitempty"`
 	Position             *int                   `json:"position,omitempty"`
@@ -58,10 +63,10 @@ type GuildNewsChannelUpdate struct {
 	Type                       *ChannelType           `json:"type,omitempty"`
 	Position                   *int                   `json:"position,omitempty"`
 	Topic                      *string                `json:"topic,omitempty"`
-	RateLimitPerUser           *int                   `json:"rate_limit_per_user,omitempty"`
+	RateLimitPerUser           *SlowmodeDuration      `json:"rate_limit_per_user,omitempty"`
 	PermissionOverwrites       *[]PermissionOverwrite `json:"permission_overwrites,omitempty"`
 	ParentID                   *snowflake.ID          `json:"parent_id,omitempty"`
-	DefaultAutoArchiveDuration *int                   `json:"default_auto_archive_duration"`
+	DefaultAutoArchiveDuration *AutoArchiveDuration   `json:"default_auto_archive_duration,omitempty"`
 }
 
 func (GuildNewsChannelUpdate) channelUpdate()      {}
@@ -73,7 +78,7 @@ type GuildThreadUpdate struct {
 	AutoArchiveDuration *AutoArchiveDuration `json:"auto_archive_duration,omitempty"`
 	Locked              *bool                `json:"locked,omitempty"`
 	Invitable           *bool                `json:"invitable,omitempty"`
-	RateLimitPerUser    *int                 `json:"rate_limit_per_user,omitempty"`
+	RateLimitPerUser    *SlowmodeDuration    `json:"rate_limit_per_user,omitempty"`
 }
 
 func (GuildThreadUpdate) channelUpdate()      {}
@@ -87,12 +92,21 @@ type GuildStageVoiceChannelUpdate struct {
 	UserLimit            *int                   `json:"user_limit,omitempty"`
 	PermissionOverwrites *[]PermissionOverwrite `json:"permission_overwrites,omitempty"`
 	ParentID             *snowflake.ID          `json:"parent_id,omitempty"`
-	RTCRegion            *string                `json:"rtc_region"`
+	RTCRegion            *json.Nullable[string] `json:"rtc_region,omitempty"`
+	VideoQualityMode     *VideoQualityMode      `json:"video_quality_mode,omitempty"`
 }
 
 func (GuildStageVoiceChannelUpdate) channelUpdate()      {}
 func (GuildStageVoiceChannelUpdate) guildChannelUpdate() {}
 
+// GuildChannelPermissionOverwritesUpdate is used to only update the PermissionOverwrites of any GuildChannel
+type GuildChannelPermissionOverwritesUpdate struct {
+	PermissionOverwrites []PermissionOverwrite `json:"permission_overwrites"`
+}
+
+func (GuildChannelPermissionOverwritesUpdate) channelUpdate()      {}
+func (GuildChannelPermissionOverwritesUpdate) guildChannelUpdate() {}
+
 type GuildChannelPositionUpdate struct {
 	ID              snowflake.ID                 `json:"id"`
 	Position        *json.Nullable[int]          `json:"position"`
@@ -25,7 +25,7 @@ type guildTextChannel struct {
 	Topic                      *string               `json:"topic"`
 	NSFW                       bool                  `json:"nsfw"`
 	LastMessageID              *snowflake.ID         `json:"last_message_id"`
-	RateLimitPerUser           int                   `json:"rate_limit_per_user"`
+	RateLimitPerUser           SlowmodeDuration      `json:"rate_limit_per_user"`
 	ParentID                   *snowflake.ID         `json:"parent_id"`
 	LastPinTimestamp           *time.Time            `json:"last_pin_timestamp"`
 	DefaultAutoArchiveDuration AutoArchiveDuration   `json:"default_auto_archive_duration"`
@@ -54,7 +54,7 @@ type guildNewsChannel struct {
 	Name                       string                `json:"name"`
 	Topic                      *string               `json:"topic"`
 	NSFW                       bool                  `json:"nsfw"`
-	RateLimitPerUser           int                   `json:"rate_limit_per_user"`
+	RateLimitPerUser           SlowmodeDuration      `json:"rate_limit_per_user"`
 	ParentID                   *snowflake.ID         `json:"parent_id"`
 	LastMessageID              *snowflake.ID         `json:"last_message_id"`
 	LastPinTimestamp           *time.Time            `json:"last_pin_timestamp"`
@@ -76,19 +76,19 @@ func (t *guildNewsChannel) UnmarshalJSON(data []byte) error {
 }
 
 type guildThread struct {
-	ID               snowflake.ID   `json:"id"`
-	Type             ChannelType    `json:"type"`
-	GuildID          snowflake.ID   `json:"guild_id"`
-	Name             string         `json:"name"`
-	NSFW             bool           `json:"nsfw"`
-	LastMessageID    *snowflake.ID  `json:"last_message_id"`
-	RateLimitPerUser int            `json:"rate_limit_per_user"`
-	OwnerID          snowflake.ID   `json:"owner_id"`
-	ParentID         snowflake.ID   `json:"parent_id"`
-	LastPinTimestamp *time.Time     `json:"last_pin_timestamp"`
-	MessageCount     int            `json:"message_count"`
-	MemberCount      int            `json:"member_count"`
-	ThreadMetadata   ThreadMetadata `json:"thread_metadata"`
+	ID               snowflake.ID     `json:"id"`
+	Type             ChannelType      `json:"type"`
+	GuildID          snowflake.ID     `json:"guild_id"`
+	Name             string           `json:"name"`
+	NSFW             bool             `json:"nsfw"`
+	LastMessageID    *snowflake.ID    `json:"last_message_id"`
+	RateLimitPerUser SlowmodeDuration `json:"rate_limit_per_user"`
+	OwnerID          snowflake.ID     `json:"owner_id"`
+	ParentID         snowflake.ID     `json:"parent_id"`
+	LastPinTimestamp *time.Time       `json:"last_pin_timestamp"`
+	MessageCount     int              `json:"message_count"`
+	MemberCount      int              `json:"member_count"`
+	ThreadMetadata   ThreadMetadata   `json:"thread_metadata"`
 }
 
 type guildCategoryChannel struct {
@@ -131,6 +131,7 @@ type guildVoiceChannel struct {
 	Topic                      *string               `json:"topic"`
 	NSFW                       bool                  `json:"nsfw"`
 	DefaultAutoArchiveDuration AutoArchiveDuration   `json:"default_auto_archive_duration"`
+	Status                     *string               `json:"status,omitempty"`
 }
 
 func (t *guildVoiceChannel) UnmarshalJSON(data []byte) error {
@@ -0,0 +1,58 @@
+package discord
+
+import (
+	"strings"
+)
+
+const (
+	// CustomIDMaxLength is the maximum length of a CustomID.
+	CustomIDMaxLength = 100
+
+	customIDSeparator = ':'
+	customIDEscape    = '\\'
+)
+
+// EncodeCustomID encodes the given parts into a CustomID separated by ':'. Separators & backslashes in the parts are escaped, so CustomID.Decode returns the same parts again.
+// It returns ErrCustomIDTooLong if the encoded CustomID is longer than CustomIDMaxLength.
+func EncodeCustomID(parts ...string) (CustomID, error) {
+	var b strings.Builder
+	for i, part := range parts {
+		if i > 0 {
+			b.WriteByte(customIDSeparator)
+		}
+		for j := 0; j < len(part); j++ {
+			if part[j] == customIDSeparator || part[j] == customIDEscape {
+				b.WriteByte(customIDEscape)
+			}
+			b.WriteByte(part[j])
+		}
+	}
+	if b.Len() > CustomIDMaxLength {
+		return "", ErrCustomIDTooLong
+	}
+	return CustomID(b.String()), nil
+}
+
+// Decode decodes a CustomID created with EncodeCustomID into its parts.
+func (c CustomID) Decode() []string {
+	var (
+		parts   []string
+		part    strings.Builder
+		escaped bool
+	)
+	for i := 0; i < len(c); i++ {
+		switch {
+		case escaped:
+			part.WriteByte(c[i])
+			escaped = false
+		case c[i] == customIDEscape:
+			escaped = true
+		case c[i] == customIDSeparator:
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteByte(c[i])
+		}
+	}
+	return append(parts, part.String())
+}
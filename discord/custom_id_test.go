@@ -0,0 +1,20 @@
+package discord
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomIDEncoding(t *testing.T) {
+	parts := []string{"modal", "a:b", `c\d`, ""}
+
+	customID, err := EncodeCustomID(parts...)
+	assert.NoError(t, err)
+	assert.Equal(t, CustomID(`modal:a\:b:c\\d:`), customID)
+	assert.Equal(t, parts, customID.Decode())
+
+	_, err = EncodeCustomID(strings.Repeat("a", CustomIDMaxLength+1))
+	assert.ErrorIs(t, err, ErrCustomIDTooLong)
+}
@@ -1,6 +1,8 @@
 package discord
 
 import (
+	"strings"
+
 	"github.com/disgoorg/disgo/rest/route"
 	"github.com/disgoorg/snowflake/v2"
 )
@@ -39,6 +41,29 @@ func (e Emoji) URL(opts ...CDNOpt) string {
 	return ""
 }
 
+// Reaction returns the string used to add the Emoji as reaction via the API
+func (e Emoji) Reaction() string {
+	return e.ReactionEmoji().Reaction()
+}
+
+// ReactionEmoji converts the Emoji to a ReactionEmoji
+func (e Emoji) ReactionEmoji() ReactionEmoji {
+	return ReactionEmoji{
+		ID:       e.ID,
+		Name:     e.Name,
+		Animated: e.Animated,
+	}
+}
+
+// ComponentEmoji converts the Emoji to a ComponentEmoji
+func (e Emoji) ComponentEmoji() ComponentEmoji {
+	return ComponentEmoji{
+		ID:       e.ID,
+		Name:     e.Name,
+		Animated: e.Animated,
+	}
+}
+
 type EmojiCreate struct {
 	Name  string         `json:"name"`
 	Image Icon           `json:"image"`
@@ -55,3 +80,56 @@ type ReactionEmoji struct {
 	Name     string       `json:"name,omitempty"`
 	Animated bool         `json:"animated"`
 }
+
+// Reaction returns the string used to add the ReactionEmoji as reaction via the API.
+// This is name:id for custom emojis and the unicode literal for unicode emojis.
+func (e ReactionEmoji) Reaction() string {
+	if e.ID == 0 {
+		return e.Name
+	}
+	return e.Name + ":" + e.ID.String()
+}
+
+// Mention returns the string used to send the ReactionEmoji in a Message content
+func (e ReactionEmoji) Mention() string {
+	if e.ID == 0 {
+		return e.Name
+	}
+	if e.Animated {
+		return AnimatedEmojiMention(e.ID, e.Name)
+	}
+	return EmojiMention(e.ID, e.Name)
+}
+
+// ComponentEmoji converts the ReactionEmoji to a ComponentEmoji
+func (e ReactionEmoji) ComponentEmoji() ComponentEmoji {
+	return ComponentEmoji(e)
+}
+
+// ParseReactionEmoji parses an emoji mention (<:name:id> or <a:name:id>), a reaction string (name:id or a:name:id) or a unicode literal into a ReactionEmoji.
+func ParseReactionEmoji(emoji string) ReactionEmoji {
+	emoji = strings.TrimSpace(emoji)
+	emoji = strings.TrimSuffix(strings.TrimPrefix(emoji, "<"), ">")
+
+	parts := strings.Split(emoji, ":")
+	var animated bool
+	if len(parts) == 3 && (parts[0] == "a" || parts[0] == "") {
+		animated = parts[0] == "a"
+		parts = parts[1:]
+	}
+	if len(parts) == 2 {
+		if id, err := snowflake.Parse(parts[1]); err == nil {
+			return ReactionEmoji{
+				ID:       id,
+				Name:     parts[0],
+				Animated: animated,
+			}
+		}
+	}
+	return ReactionEmoji{Name: emoji}
+}
+
+// NormalizeReaction converts any emoji format supported by ParseReactionEmoji into the string used to add reactions via the API.
+func NormalizeReaction(emoji string) string {
+	return ParseReactionEmoji(emoji).Reaction()
+}
@@ -0,0 +1,25 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseReactionEmoji(t *testing.T) {
+	custom := ReactionEmoji{ID: 123456789012345678, Name: "disgo"}
+	animated := ReactionEmoji{ID: 123456789012345678, Name: "disgo", Animated: true}
+
+	assert.Equal(t, custom, ParseReactionEmoji("<:disgo:123456789012345678>"))
+	assert.Equal(t, animated, ParseReactionEmoji("<a:disgo:123456789012345678>"))
+	assert.Equal(t, custom, ParseReactionEmoji("disgo:123456789012345678"))
+	assert.Equal(t, animated, ParseReactionEmoji("a:disgo:123456789012345678"))
+	assert.Equal(t, ReactionEmoji{Name: "👍"}, ParseReactionEmoji("👍"))
+	assert.Equal(t, ReactionEmoji{Name: "#️⃣"}, ParseReactionEmoji("#️⃣"))
+}
+
+func TestReactionEmoji_Reaction(t *testing.T) {
+	assert.Equal(t, "disgo:123456789012345678", NormalizeReaction("<a:disgo:123456789012345678>"))
+	assert.Equal(t, "👍", NormalizeReaction("👍"))
+	assert.Equal(t, "<a:disgo:123456789012345678>", ParseReactionEmoji("a:disgo:123456789012345678").Mention())
+}
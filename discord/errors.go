@@ -5,15 +5,17 @@ import (
 )
 
 var (
-	ErrNoGatewayOrShardManager = errors.New("no gateway or shard manager configured")
-	ErrNoGuildMembersIntent    = errors.New("this operation requires the GUILD_MEMBERS intent")
-	ErrNoShardManager          = errors.New("no shard manager configured")
-	ErrNoGateway               = errors.New("no gateway configured")
-	ErrGatewayAlreadyConnected = errors.New("gateway is already connected")
-	ErrShardNotConnected       = errors.New("shard is not connected")
-	ErrShardNotFound           = errors.New("shard not found in shard manager")
-	ErrGatewayCompressedData   = errors.New("disgo does not currently support compressed gateway data")
-	ErrNoHTTPServer            = errors.New("no http server configured")
+	ErrNoGatewayOrShardManager   = errors.New("no gateway or shard manager configured")
+	ErrNoGuildMembersIntent      = errors.New("this operation requires the GUILD_MEMBERS intent")
+	ErrNoGuildPresencesIntent    = errors.New("this operation requires the GUILD_PRESENCES intent")
+	ErrNoShardManager            = errors.New("no shard manager configured")
+	ErrNoGateway                 = errors.New("no gateway configured")
+	ErrGatewayAlreadyConnected   = errors.New("gateway is already connected")
+	ErrShardNotConnected         = errors.New("shard is not connected")
+	ErrShardNotFound             = errors.New("shard not found in shard manager")
+	ErrGatewayCompressedData     = errors.New("disgo does not currently support compressed gateway data")
+	ErrNoHTTPServer              = errors.New("no http server configured")
+	ErrSessionStartLimitExceeded = errors.New("session start limit exceeded, no identifies remaining until the limit resets")
 
 	ErrNoDisgoInstance = errors.New("no disgo instance injected")
 
@@ -26,10 +28,46 @@ var (
 	ErrInteractionExpired        = errors.New("this interaction has expired")
 
 	ErrChannelNotTypeNews = errors.New("channel type is not 'NEWS'")
+	ErrChannelNoParent    = errors.New("channel has no parent category")
+
+	ErrInvalidAutoArchiveDuration = errors.New("auto archive duration must be one of 1h, 24h, 3d or 1w")
+	ErrInvalidSlowmodeDuration    = errors.New("slowmode duration must be a whole amount of seconds between 0 and 6h")
 
 	ErrCheckFailed = errors.New("check failed")
+	ErrCircuitOpen = errors.New("circuit breaker is open, the discord api is currently failing")
 
 	ErrMemberMustBeConnectedToChannel = errors.New("the member must be connected to the channel")
 
 	ErrStickerTypeGuild = errors.New("sticker type must be of type StickerTypeGuild")
+
+	ErrAttachmentURLExpired = errors.New("attachment url has expired")
+
+	ErrNoMessageGetter              = errors.New("no message getter is set")
+	ErrMessageReferenceNoMessageID  = errors.New("message reference must have a message id")
+	ErrMessageReferenceCrossChannel = errors.New("replies must reference a message in the same channel")
+	ErrMessageReferenceCrossGuild   = errors.New("message reference guild id does not match the guild of the channel")
+
+	ErrCustomIDTooLong = errors.New("custom id must not be longer than 100 characters")
+
+	ErrScheduledMessageFiles    = errors.New("scheduled messages can't contain files")
+	ErrScheduledMessageNotFound = errors.New("scheduled message not found")
+
+	ErrNoGuildID                 = errors.New("guild id must be set")
+	ErrInvalidOnlineStatus       = errors.New("online status must be one of online, dnd, idle, invisible or offline")
+	ErrInvalidGuildMembersFilter = errors.New("either query or user ids must be set, but not both")
+	ErrTooManyUserIDs            = errors.New("at most 100 user ids can be requested at once")
+	ErrNonceTooLong              = errors.New("nonce must not be longer than 32 bytes")
+
+	ErrCannotDMUser = errors.New("cannot send direct messages to this user")
+
+	ErrBotAlreadyManaged = errors.New("a bot with this application id is already managed")
+
+	ErrInvalidBotConfig = errors.New("invalid bot config")
+
+	ErrUnknownLocale = errors.New("unknown locale")
+
+	ErrUploadTooLarge           = errors.New("file is larger than discord allows for this upload")
+	ErrUnsupportedContentType   = errors.New("content type is not supported for this upload")
+	ErrGuildEmojiLimitReached   = errors.New("guild has no emoji slots left")
+	ErrGuildStickerLimitReached = errors.New("guild has no sticker slots left")
 )
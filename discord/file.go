@@ -7,6 +7,7 @@ import (
 	"mime/multipart"
 	"net/textproto"
 
+	"github.com/disgoorg/disgo/internal/bufferpool"
 	"github.com/disgoorg/disgo/json"
 )
 
@@ -18,11 +19,24 @@ type Payload interface {
 type MultipartBuffer struct {
 	Buffer      *bytes.Buffer
 	ContentType string
+
+	pooled bool
+}
+
+// Release puts the Buffer back into the internal pool if it was created by PayloadWithFiles.
+// The rest.Client calls this after the request succeeded, so the MultipartBuffer must not be used afterwards.
+func (b *MultipartBuffer) Release() {
+	if !b.pooled {
+		return
+	}
+	b.pooled = false
+	bufferpool.Put(b.Buffer)
+	b.Buffer = nil
 }
 
 // PayloadWithFiles returns the given payload as multipart body with all files in it
 func PayloadWithFiles(v any, files ...*File) (*MultipartBuffer, error) {
-	buffer := &bytes.Buffer{}
+	buffer := bufferpool.Get()
 	writer := multipart.NewWriter(buffer)
 	writer.FormDataContentType()
 	defer func() {
@@ -63,6 +77,7 @@ func PayloadWithFiles(v any, files ...*File) (*MultipartBuffer, error) {
 	return &MultipartBuffer{
 		Buffer:      buffer,
 		ContentType: writer.FormDataContentType(),
+		pooled:      true,
 	}, nil
 }
 
@@ -0,0 +1,34 @@
+package discord
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayloadWithFiles(t *testing.T) {
+	buffer, err := PayloadWithFiles(MessageCreate{Content: "test"}, NewFile("test.txt", "", strings.NewReader("file content")))
+	assert.NoError(t, err)
+	assert.Contains(t, buffer.Buffer.String(), `{"content":"test"}`)
+	assert.Contains(t, buffer.Buffer.String(), "file content")
+
+	buffer.Release()
+	assert.Nil(t, buffer.Buffer)
+}
+
+func BenchmarkPayloadWithFiles(b *testing.B) {
+	content := bytes.Repeat([]byte("a"), 64*1024)
+	messageCreate := MessageCreate{Content: "hello world"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buffer, err := PayloadWithFiles(messageCreate, NewFile("file.txt", "", bytes.NewReader(content)))
+		if err != nil {
+			b.Fatal(err)
+		}
+		buffer.Release()
+	}
+}
@@ -1,5 +1,7 @@
 package discord
 
+import "time"
+
 type Gateway struct {
 	URL string `json:"url"`
 }
@@ -16,3 +18,8 @@ type SessionStartLimit struct {
 	ResetAfter     int `json:"reset_after"`
 	MaxConcurrency int `json:"max_concurrency"`
 }
+
+// ResetAfterDuration returns the duration until the SessionStartLimit resets.
+func (l SessionStartLimit) ResetAfterDuration() time.Duration {
+	return time.Duration(l.ResetAfter) * time.Millisecond
+}
@@ -19,6 +19,48 @@ const (
 	PremiumTier3
 )
 
+// EmojiLimit returns how many static and how many animated emojis a Guild with this PremiumTier can have.
+func (t PremiumTier) EmojiLimit() int {
+	switch t {
+	case PremiumTier1:
+		return 100
+	case PremiumTier2:
+		return 150
+	case PremiumTier3:
+		return 250
+	default:
+		return 50
+	}
+}
+
+// StickerLimit returns how many stickers a Guild with this PremiumTier can have.
+func (t PremiumTier) StickerLimit() int {
+	switch t {
+	case PremiumTier1:
+		return 15
+	case PremiumTier2:
+		return 30
+	case PremiumTier3:
+		return 60
+	default:
+		return 5
+	}
+}
+
+// SoundboardSoundLimit returns how many soundboard sounds a Guild with this PremiumTier can have.
+func (t PremiumTier) SoundboardSoundLimit() int {
+	switch t {
+	case PremiumTier1:
+		return 24
+	case PremiumTier2:
+		return 36
+	case PremiumTier3:
+		return 48
+	default:
+		return 8
+	}
+}
+
 // SystemChannelFlags contains the settings for the Guild(s) system channel
 type SystemChannelFlags int
 
@@ -83,6 +125,7 @@ const (
 	GuildFeatureInviteSplash                  GuildFeature = "INVITE_SPLASH"
 	GuildFeatureMemberVerificationGateEnabled GuildFeature = "MEMBER_VERIFICATION_GATE_ENABLED"
 	GuildFeatureMonetizationEnabled           GuildFeature = "MONETIZATION_ENABLED"
+	GuildFeatureMoreEmoji                     GuildFeature = "MORE_EMOJI"
 	GuildFeatureMoreStickers                  GuildFeature = "MORE_STICKERS"
 	GuildFeatureNews                          GuildFeature = "NEWS"
 	GuildFeaturePartnered                     GuildFeature = "PARTNERED"
@@ -215,12 +258,14 @@ type UnavailableGuild struct {
 
 // OAuth2Guild is returned on the route.GetGuilds route
 type OAuth2Guild struct {
-	ID          snowflake.ID   `json:"id"`
-	Name        string         `json:"name"`
-	Icon        *string        `json:"icon"`
-	Owner       bool           `json:"owner"`
-	Permissions Permissions    `json:"permissions"`
-	Features    []GuildFeature `json:"features"`
+	ID                       snowflake.ID   `json:"id"`
+	Name                     string         `json:"name"`
+	Icon                     *string        `json:"icon"`
+	Owner                    bool           `json:"owner"`
+	Permissions              Permissions    `json:"permissions"`
+	Features                 []GuildFeature `json:"features"`
+	ApproximateMemberCount   *int           `json:"approximate_member_count,omitempty"`
+	ApproximatePresenceCount *int           `json:"approximate_presence_count,omitempty"`
 }
 
 // WelcomeScreen is the Welcome Screen of a Guild
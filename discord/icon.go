@@ -16,6 +16,10 @@ const (
 	IconTypeWEBP    IconType = "image/webp"
 	IconTypeGIF     IconType = "image/gif"
 	IconTypeUnknown          = IconTypeJPEG
+
+	// IconTypeMP3 and IconTypeOGG are used for the sound of a SoundboardSoundCreate
+	IconTypeMP3 IconType = "audio/mpeg"
+	IconTypeOGG IconType = "audio/ogg"
 )
 
 func (t IconType) GetMIME() string {
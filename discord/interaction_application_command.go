@@ -491,7 +491,8 @@ func (d SlashCommandInteractionData) AttachmentOption(name string) (SlashCommand
 
 func (d SlashCommandInteractionData) OptAttachment(name string) (Attachment, bool) {
 	if option, ok := d.AttachmentOption(name); ok {
-		return d.Resolved.Attachments[option.Value], true
+		attachment, ok := d.Resolved.Attachments[option.Value]
+		return attachment, ok
 	}
 	return Attachment{}, false
 }
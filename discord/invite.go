@@ -1,6 +1,7 @@
 package discord
 
 import (
+	"strings"
 	"time"
 
 	"github.com/disgoorg/disgo/rest/route"
@@ -18,17 +19,20 @@ const (
 
 // Invite is a partial invite struct
 type Invite struct {
-	Code                     string               `json:"code"`
-	Guild                    *InviteGuild         `json:"guild"`
-	Channel                  *InviteChannel       `json:"channel"`
-	ChannelID                snowflake.ID         `json:"channel_id"`
-	Inviter                  *User                `json:"inviter"`
-	TargetUser               *User                `json:"target_user"`
-	TargetType               InviteTargetType     `json:"target_user_type"`
-	ApproximatePresenceCount int                  `json:"approximate_presence_count"`
-	ApproximateMemberCount   int                  `json:"approximate_member_count"`
-	ExpiresAt                *time.Time           `json:"created_at"`
-	GuildScheduledEvent      *GuildScheduledEvent `json:"guild_scheduled_event"`
+	Code              string              `json:"code"`
+	Guild             *InviteGuild        `json:"guild"`
+	Channel           *InviteChannel      `json:"channel"`
+	ChannelID         snowflake.ID        `json:"channel_id"`
+	Inviter           *User               `json:"inviter"`
+	TargetType        InviteTargetType    `json:"target_type"`
+	TargetUser        *User               `json:"target_user"`
+	TargetApplication *PartialApplication `json:"target_application"`
+	// ApproximatePresenceCount is only present when the Invite was requested with counts
+	ApproximatePresenceCount *int `json:"approximate_presence_count"`
+	// ApproximateMemberCount is only present when the Invite was requested with counts
+	ApproximateMemberCount *int                 `json:"approximate_member_count"`
+	ExpiresAt              *time.Time           `json:"expires_at"`
+	GuildScheduledEvent    *GuildScheduledEvent `json:"guild_scheduled_event"`
 }
 
 func (i Invite) URL() string {
@@ -38,6 +42,7 @@ func (i Invite) URL() string {
 	return ""
 }
 
+// ExtendedInvite is an Invite with its metadata, returned when listing the invites of a Guild or Channel
 type ExtendedInvite struct {
 	Invite
 	Uses      int       `json:"uses"`
@@ -77,7 +82,7 @@ type InviteGuild struct {
 }
 
 type InviteCreate struct {
-	MaxAgree            int              `json:"max_agree,omitempty"`
+	MaxAge              int              `json:"max_age,omitempty"`
 	MaxUses             int              `json:"max_uses,omitempty"`
 	Temporary           bool             `json:"temporary,omitempty"`
 	Unique              bool             `json:"unique,omitempty"`
@@ -85,3 +90,28 @@ type InviteCreate struct {
 	TargetUserID        snowflake.ID     `json:"target_user_id,omitempty"`
 	TargetApplicationID snowflake.ID     `json:"target_application_id,omitempty"`
 }
+
+// VanityInvite is the vanity invite of a Guild
+type VanityInvite struct {
+	Code *string `json:"code"`
+	Uses int     `json:"uses"`
+}
+
+// ParseInviteCode returns the invite code of the given invite url like https://discord.gg/code or https://discord.com/invite/code.
+// Codes are returned as is.
+func ParseInviteCode(invite string) string {
+	invite = strings.TrimSpace(invite)
+	for _, prefix := range []string{"https://", "http://"} {
+		invite = strings.TrimPrefix(invite, prefix)
+	}
+	for _, prefix := range []string{"discord.gg/", "discord.com/invite/", "discordapp.com/invite/", "www.discord.com/invite/"} {
+		if strings.HasPrefix(invite, prefix) {
+			invite = strings.TrimPrefix(invite, prefix)
+			break
+		}
+	}
+	if i := strings.IndexAny(invite, "/?#"); i != -1 {
+		invite = invite[:i]
+	}
+	return invite
+}
@@ -0,0 +1,14 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInviteCode(t *testing.T) {
+	assert.Equal(t, "disgo", ParseInviteCode("disgo"))
+	assert.Equal(t, "disgo", ParseInviteCode("https://discord.gg/disgo"))
+	assert.Equal(t, "disgo", ParseInviteCode("discord.com/invite/disgo?event=1"))
+	assert.Equal(t, "disgo", ParseInviteCode(" http://discordapp.com/invite/disgo/ "))
+}
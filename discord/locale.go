@@ -1,5 +1,11 @@
 package discord
 
+import (
+	"fmt"
+	"strings"
+)
+
+// Locale is a language discord supports, for example for localized application command names or the locale of an interaction user.
 type Locale string
 
 func (l Locale) String() string {
@@ -29,6 +35,7 @@ const (
 	LocaleGreek        Locale = "el"
 	LocaleHindi        Locale = "hi"
 	LocaleHungarian    Locale = "hu"
+	LocaleIndonesian   Locale = "id"
 	LocaleItalian      Locale = "it"
 	LocaleJapanese     Locale = "ja"
 	LocaleKorean       Locale = "ko"
@@ -39,6 +46,7 @@ const (
 	LocaleRomanian     Locale = "ro"
 	LocaleRussian      Locale = "ru"
 	LocaleSpanishES    Locale = "es-ES"
+	LocaleSpanishLATAM Locale = "es-419"
 	LocaleSwedish      Locale = "sv-SE"
 	LocaleThai         Locale = "th"
 	LocaleTurkish      Locale = "tr"
@@ -63,6 +71,7 @@ var Locales = map[Locale]string{
 	LocaleGreek:        "Greek",
 	LocaleHindi:        "Hindi",
 	LocaleHungarian:    "Hungarian",
+	LocaleIndonesian:   "Indonesian",
 	LocaleItalian:      "Italian",
 	LocaleJapanese:     "Japanese",
 	LocaleKorean:       "Korean",
@@ -73,6 +82,7 @@ var Locales = map[Locale]string{
 	LocaleRomanian:     "Romanian",
 	LocaleRussian:      "Russian",
 	LocaleSpanishES:    "Spanish (Spain)",
+	LocaleSpanishLATAM: "Spanish (Latin America)",
 	LocaleSwedish:      "Swedish",
 	LocaleThai:         "Thai",
 	LocaleTurkish:      "Turkish",
@@ -80,3 +90,15 @@ var Locales = map[Locale]string{
 	LocaleVietnamese:   "Vietnamese",
 	LocaleUnknown:      "unknown",
 }
+
+// ParseLocale returns the Locale of the given code. The code is matched case-insensitive and an underscore is accepted instead of a hyphen, so "en_us" parses as LocaleEnglishUS.
+// It returns ErrUnknownLocale if discord does not support the locale.
+func ParseLocale(code string) (Locale, error) {
+	code = strings.ReplaceAll(code, "_", "-")
+	for locale := range Locales {
+		if locale != LocaleUnknown && strings.EqualFold(string(locale), code) {
+			return locale, nil
+		}
+	}
+	return LocaleUnknown, fmt.Errorf("%w: %q", ErrUnknownLocale, code)
+}
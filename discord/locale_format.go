@@ -0,0 +1,129 @@
+package discord
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// localeFormat holds the number & date conventions of a Locale.
+type localeFormat struct {
+	decimal    string
+	group      string
+	dateLayout string
+	timeLayout string
+}
+
+const (
+	nbsp       = "\u00a0"
+	narrowNbsp = "\u202f"
+)
+
+var localeFormats = map[Locale]localeFormat{
+	LocaleEnglishUS:    {".", ",", "01/02/2006", "3:04 PM"},
+	LocaleEnglishGB:    {".", ",", "02/01/2006", "15:04"},
+	LocaleBulgarian:    {",", nbsp, "02.01.2006", "15:04"},
+	LocaleChineseCN:    {".", ",", "2006/01/02", "15:04"},
+	LocaleChineseTW:    {".", ",", "2006/01/02", "15:04"},
+	LocaleCroatian:     {",", ".", "02. 01. 2006.", "15:04"},
+	LocaleCzech:        {",", nbsp, "02. 01. 2006", "15:04"},
+	LocaleDanish:       {",", ".", "02.01.2006", "15.04"},
+	LocaleDutch:        {",", ".", "02-01-2006", "15:04"},
+	LocaleFinnish:      {",", nbsp, "2.1.2006", "15.04"},
+	LocaleFrench:       {",", narrowNbsp, "02/01/2006", "15:04"},
+	LocaleGerman:       {",", ".", "02.01.2006", "15:04"},
+	LocaleGreek:        {",", ".", "2/1/2006", "15:04"},
+	LocaleHindi:        {".", ",", "2/1/2006", "3:04 PM"},
+	LocaleHungarian:    {",", nbsp, "2006. 01. 02.", "15:04"},
+	LocaleIndonesian:   {",", ".", "02/01/2006", "15.04"},
+	LocaleItalian:      {",", ".", "02/01/2006", "15:04"},
+	LocaleJapanese:     {".", ",", "2006/01/02", "15:04"},
+	LocaleKorean:       {".", ",", "2006. 1. 2.", "15:04"},
+	LocaleLithuanian:   {",", nbsp, "2006-01-02", "15:04"},
+	LocaleNorwegian:    {",", nbsp, "02.01.2006", "15:04"},
+	LocalePolish:       {",", nbsp, "02.01.2006", "15:04"},
+	LocalePortugueseBR: {",", ".", "02/01/2006", "15:04"},
+	LocaleRomanian:     {",", ".", "02.01.2006", "15:04"},
+	LocaleRussian:      {",", nbsp, "02.01.2006", "15:04"},
+	LocaleSpanishES:    {",", ".", "02/01/2006", "15:04"},
+	LocaleSpanishLATAM: {".", ",", "02/01/2006", "15:04"},
+	LocaleSwedish:      {",", nbsp, "2006-01-02", "15:04"},
+	LocaleThai:         {".", ",", "02/01/2006", "15:04"},
+	LocaleTurkish:      {",", ".", "02.01.2006", "15:04"},
+	LocaleUkrainian:    {",", nbsp, "02.01.2006", "15:04"},
+	LocaleVietnamese:   {",", ".", "02/01/2006", "15:04"},
+}
+
+// format returns the localeFormat of the Locale or the one of LocaleEnglishUS for unknown locales.
+func (l Locale) format() localeFormat {
+	if format, ok := localeFormats[l]; ok {
+		return format
+	}
+	return localeFormats[LocaleEnglishUS]
+}
+
+// FormatInt formats the integer with the digit grouping of the Locale, for example 1234567 as "1,234,567" in LocaleEnglishUS and "1.234.567" in LocaleGerman.
+func (l Locale) FormatInt(n int64) string {
+	s := strconv.FormatInt(n, 10)
+	sign := ""
+	if n < 0 {
+		sign, s = "-", s[1:]
+	}
+	return sign + groupDigits(s, l.format().group)
+}
+
+// FormatFloat formats the float with the given number of decimals and the digit grouping & decimal separator of the Locale,
+// for example 1234.5 with 2 decimals as "1,234.50" in LocaleEnglishUS and "1.234,50" in LocaleGerman.
+func (l Locale) FormatFloat(f float64, decimals int) string {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	format := l.format()
+	s := strconv.FormatFloat(math.Abs(f), 'f', decimals, 64)
+	sign := ""
+	if f < 0 && strings.Trim(s, "0.") != "" {
+		sign = "-"
+	}
+	integer, fraction, _ := strings.Cut(s, ".")
+	s = sign + groupDigits(integer, format.group)
+	if fraction != "" {
+		s += format.decimal + fraction
+	}
+	return s
+}
+
+// FormatDate formats the date of the time in the numeric date format of the Locale, for example "01/31/2006" in LocaleEnglishUS and "31.01.2006" in LocaleGerman.
+func (l Locale) FormatDate(t time.Time) string {
+	return t.Format(l.format().dateLayout)
+}
+
+// FormatTime formats the time of day in the format of the Locale, for example "3:04 PM" in LocaleEnglishUS and "15:04" in LocaleGerman.
+func (l Locale) FormatTime(t time.Time) string {
+	return t.Format(l.format().timeLayout)
+}
+
+// FormatDateTime formats the time with FormatDate followed by FormatTime.
+// To show a time in a message, prefer a FormattedTimestampMention, which the discord client formats in the locale & timezone of every user itself.
+func (l Locale) FormatDateTime(t time.Time) string {
+	return l.FormatDate(t) + " " + l.FormatTime(t)
+}
+
+// groupDigits inserts the group separator every 3 digits from the right.
+func groupDigits(digits string, group string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+	var sb strings.Builder
+	first := len(digits) % 3
+	if first > 0 {
+		sb.WriteString(digits[:first])
+	}
+	for i := first; i < len(digits); i += 3 {
+		if i > 0 {
+			sb.WriteString(group)
+		}
+		sb.WriteString(digits[i : i+3])
+	}
+	return sb.String()
+}
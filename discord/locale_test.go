@@ -0,0 +1,38 @@
+package discord
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLocale(t *testing.T) {
+	locale, err := ParseLocale("en-US")
+	assert.NoError(t, err)
+	assert.Equal(t, LocaleEnglishUS, locale)
+
+	locale, err = ParseLocale("pt_br")
+	assert.NoError(t, err)
+	assert.Equal(t, LocalePortugueseBR, locale)
+
+	_, err = ParseLocale("xx")
+	assert.True(t, errors.Is(err, ErrUnknownLocale))
+}
+
+func TestLocale_Format(t *testing.T) {
+	assert.Equal(t, "1,234,567", LocaleEnglishUS.FormatInt(1234567))
+	assert.Equal(t, "-1.234.567", LocaleGerman.FormatInt(-1234567))
+	assert.Equal(t, "999", LocaleGerman.FormatInt(999))
+	assert.Equal(t, "1,234.50", LocaleEnglishUS.FormatFloat(1234.5, 2))
+	assert.Equal(t, "1.234,50", LocaleGerman.FormatFloat(1234.5, 2))
+	assert.Equal(t, "1\u202f234,5", LocaleFrench.FormatFloat(1234.5, 1))
+	assert.Equal(t, "0", LocaleEnglishUS.FormatFloat(-0.1, 0))
+
+	tm := time.Date(2006, time.January, 31, 15, 4, 0, 0, time.UTC)
+	assert.Equal(t, "01/31/2006 3:04 PM", LocaleEnglishUS.FormatDateTime(tm))
+	assert.Equal(t, "31.01.2006 15:04", LocaleGerman.FormatDateTime(tm))
+	assert.Equal(t, "2006-01-31", LocaleSwedish.FormatDate(tm))
+	assert.Equal(t, "01/31/2006", Locale("xx").FormatDate(tm))
+}
@@ -22,6 +22,7 @@ type Member struct {
 	Mute                       bool           `json:"mute,omitempty"`
 	Pending                    bool           `json:"pending"`
 	CommunicationDisabledUntil *time.Time     `json:"communication_disabled_until"`
+	Flags                      MemberFlags    `json:"flags"`
 
 	// This field is not present everywhere in the API and often populated by disgo
 	GuildID snowflake.ID `json:"guild_id"`
@@ -60,6 +61,74 @@ func (m Member) AvatarURL(opts ...CDNOpt) *string {
 	return formatAssetURL(route.MemberAvatar, opts, m.GuildID, m.User.ID, *m.Avatar)
 }
 
+// DidRejoin returns whether the Member has left and rejoined the guild
+func (m Member) DidRejoin() bool {
+	return m.Flags.Has(MemberFlagDidRejoin)
+}
+
+// CompletedOnboarding returns whether the Member has completed the onboarding of the guild
+func (m Member) CompletedOnboarding() bool {
+	return m.Flags.Has(MemberFlagCompletedOnboarding)
+}
+
+// BypassesVerification returns whether the Member is exempt from the verification requirements of the guild
+func (m Member) BypassesVerification() bool {
+	return m.Flags.Has(MemberFlagBypassesVerification)
+}
+
+// StartedOnboarding returns whether the Member has started the onboarding of the guild
+func (m Member) StartedOnboarding() bool {
+	return m.Flags.Has(MemberFlagStartedOnboarding)
+}
+
+// MemberFlags are flags of a Member (https://discord.com/developers/docs/resources/guild#guild-member-object-guild-member-flags)
+type MemberFlags int
+
+// All MemberFlags
+const (
+	MemberFlagDidRejoin MemberFlags = 1 << iota
+	MemberFlagCompletedOnboarding
+	MemberFlagBypassesVerification
+	MemberFlagStartedOnboarding
+	MemberFlagsNone MemberFlags = 0
+)
+
+// Add allows you to add multiple bits together, producing a new bit
+func (f MemberFlags) Add(bits ...MemberFlags) MemberFlags {
+	for _, bit := range bits {
+		f |= bit
+	}
+	return f
+}
+
+// Remove allows you to subtract multiple bits from the first, producing a new bit
+func (f MemberFlags) Remove(bits ...MemberFlags) MemberFlags {
+	for _, bit := range bits {
+		f &^= bit
+	}
+	return f
+}
+
+// Has will ensure that the bit includes all the bits entered
+func (f MemberFlags) Has(bits ...MemberFlags) bool {
+	for _, bit := range bits {
+		if (f & bit) != bit {
+			return false
+		}
+	}
+	return true
+}
+
+// Missing will check whether the bit is missing any one of the bits
+func (f MemberFlags) Missing(bits ...MemberFlags) bool {
+	for _, bit := range bits {
+		if (f & bit) != bit {
+			return true
+		}
+	}
+	return false
+}
+
 // MemberAdd is used to add a member via the oauth2 access token to a guild
 type MemberAdd struct {
 	AccessToken string         `json:"access_token"`
@@ -77,6 +146,8 @@ type MemberUpdate struct {
 	Mute                       *bool                     `json:"mute,omitempty"`
 	Deaf                       *bool                     `json:"deaf,omitempty"`
 	CommunicationDisabledUntil *json.Nullable[time.Time] `json:"communication_disabled_until,omitempty"`
+	// Flags can only be used to set or unset MemberFlagBypassesVerification
+	Flags *MemberFlags `json:"flags,omitempty"`
 }
 
 // SelfNickUpdate is used to update your own nick
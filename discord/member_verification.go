@@ -0,0 +1,37 @@
+package discord
+
+import (
+	"time"
+
+	"github.com/disgoorg/disgo/json"
+)
+
+// MemberVerification is the membership screening form new members have to accept before they can interact with a Guild which has GuildFeatureMemberVerificationGateEnabled.
+type MemberVerification struct {
+	Version     time.Time                 `json:"version"`
+	FormFields  []MemberVerificationField `json:"form_fields"`
+	Description *string                   `json:"description"`
+}
+
+// MemberVerificationFieldType is the type of MemberVerificationField
+type MemberVerificationFieldType string
+
+// All MemberVerificationFieldType(s)
+const (
+	MemberVerificationFieldTypeTerms MemberVerificationFieldType = "TERMS"
+)
+
+// MemberVerificationField is a single field of the MemberVerification form, like the server rules
+type MemberVerificationField struct {
+	FieldType MemberVerificationFieldType `json:"field_type"`
+	Label     string                      `json:"label"`
+	Values    []string                    `json:"values,omitempty"`
+	Required  bool                        `json:"required"`
+}
+
+// MemberVerificationUpdate is used to update the MemberVerification of a Guild
+type MemberVerificationUpdate struct {
+	Enabled     *bool                      `json:"enabled,omitempty"`
+	FormFields  *[]MemberVerificationField `json:"form_fields,omitempty"`
+	Description *json.Nullable[string]     `json:"description,omitempty"`
+}
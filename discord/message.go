@@ -18,7 +18,7 @@ const (
 	MessageTypeCall
 	MessageTypeChannelNameChange
 	MessageTypeChannelIconChange
-	ChannelPinnedMessage
+	MessageTypeChannelPinnedMessage
 	MessageTypeUserJoin
 	MessageTypeGuildBoost
 	MessageTypeGuildBoostTier1
@@ -37,9 +37,34 @@ const (
 	MessageTypeGuildInviteReminder
 	MessageTypeContextMenuCommand
 	MessageTypeAutoModerationAction
+	MessageTypeRoleSubscriptionPurchase
+	MessageTypeInteractionPremiumUpsell
+	MessageTypeStageStart
+	MessageTypeStageEnd
+	MessageTypeStageSpeaker
+	_
+	MessageTypeStageTopic
+	MessageTypeGuildApplicationPremiumSubscription
 )
 
-func (t MessageType) System() bool {
+// Constants for the MessageType which are not consecutive
+const (
+	MessageTypeGuildIncidentAlertModeEnabled MessageType = iota + 36
+	MessageTypeGuildIncidentAlertModeDisabled
+	MessageTypeGuildIncidentReportRaid
+	MessageTypeGuildIncidentReportFalseAlarm
+
+	MessageTypePurchaseNotification MessageType = 44
+	MessageTypePollResult           MessageType = 46
+)
+
+// ChannelPinnedMessage is the MessageType of the system message sent when a Message was pinned.
+//
+// Deprecated: Use MessageTypeChannelPinnedMessage instead
+const ChannelPinnedMessage = MessageTypeChannelPinnedMessage
+
+// IsSystem returns whether the MessageType is sent by discord instead of a user, bot or webhook.
+func (t MessageType) IsSystem() bool {
 	switch t {
 	case MessageTypeDefault, MessageTypeReply, MessageTypeSlashCommand, MessageTypeThreadStarterMessage, MessageTypeContextMenuCommand:
 		return false
@@ -49,7 +74,9 @@ func (t MessageType) System() bool {
 	}
 }
 
-func (t MessageType) Deleteable() bool {
+// IsDeletable returns whether Message(s) of the MessageType can be deleted, so purge features can skip the others.
+// Message(s) of MessageTypeAutoModerationAction can only be deleted with discord.PermissionManageMessages.
+func (t MessageType) IsDeletable() bool {
 	switch t {
 	case MessageTypeRecipientAdd, MessageTypeRecipientRemove, MessageTypeCall,
 		MessageTypeChannelNameChange, MessageTypeChannelIconChange, MessageTypeGuildDiscoveryDisqualified,
@@ -62,37 +89,74 @@ func (t MessageType) Deleteable() bool {
 	}
 }
 
+// IsInteractionResponse returns whether the MessageType is the response to an application command.
+func (t MessageType) IsInteractionResponse() bool {
+	return t == MessageTypeSlashCommand || t == MessageTypeContextMenuCommand
+}
+
+// IsStage returns whether the MessageType is a stage channel system message.
+func (t MessageType) IsStage() bool {
+	switch t {
+	case MessageTypeStageStart, MessageTypeStageEnd, MessageTypeStageSpeaker, MessageTypeStageTopic:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// IsGuildIncident returns whether the MessageType is a guild incident system message like MessageTypeGuildIncidentReportRaid.
+func (t MessageType) IsGuildIncident() bool {
+	return t >= MessageTypeGuildIncidentAlertModeEnabled && t <= MessageTypeGuildIncidentReportFalseAlarm
+}
+
+// System returns whether the MessageType is a system message.
+//
+// Deprecated: Use MessageType.IsSystem instead
+func (t MessageType) System() bool {
+	return t.IsSystem()
+}
+
+// Deleteable returns whether Message(s) of the MessageType can be deleted.
+//
+// Deprecated: Use MessageType.IsDeletable instead
+func (t MessageType) Deleteable() bool {
+	return t.IsDeletable()
+}
+
 // Message is a struct for messages sent in discord text-based channels
 type Message struct {
-	ID                snowflake.ID         `json:"id"`
-	GuildID           *snowflake.ID        `json:"guild_id"`
-	Reactions         []MessageReaction    `json:"reactions"`
-	Attachments       []Attachment         `json:"attachments"`
-	TTS               bool                 `json:"tts"`
-	Embeds            []Embed              `json:"embeds,omitempty"`
-	Components        []ContainerComponent `json:"components,omitempty"`
-	CreatedAt         time.Time            `json:"timestamp"`
-	Mentions          []User               `json:"mentions"`
-	MentionEveryone   bool                 `json:"mention_everyone"`
-	MentionRoles      []snowflake.ID       `json:"mention_roles"`
-	MentionChannels   []Channel            `json:"mention_channels"`
-	Pinned            bool                 `json:"pinned"`
-	EditedTimestamp   *time.Time           `json:"edited_timestamp"`
-	Author            User                 `json:"author"`
-	Member            *Member              `json:"member"`
-	Content           string               `json:"content,omitempty"`
-	ChannelID         snowflake.ID         `json:"channel_id"`
-	Type              MessageType          `json:"type"`
-	Flags             MessageFlags         `json:"flags"`
-	MessageReference  *MessageReference    `json:"message_reference,omitempty"`
-	Interaction       *MessageInteraction  `json:"interaction,omitempty"`
-	WebhookID         *snowflake.ID        `json:"webhook_id,omitempty"`
-	Activity          *MessageActivity     `json:"activity,omitempty"`
-	Application       *MessageApplication  `json:"application,omitempty"`
-	Stickers          []MessageSticker     `json:"sticker_items,omitempty"`
-	ReferencedMessage *Message             `json:"referenced_message,omitempty"`
-	LastUpdated       *time.Time           `json:"last_updated,omitempty"`
-	Thread            *MessageThread       `json:"thread,omitempty"`
+	ID                   snowflake.ID                `json:"id"`
+	GuildID              *snowflake.ID               `json:"guild_id"`
+	Reactions            []MessageReaction           `json:"reactions"`
+	Attachments          []Attachment                `json:"attachments"`
+	TTS                  bool                        `json:"tts"`
+	Embeds               []Embed                     `json:"embeds,omitempty"`
+	Components           []ContainerComponent        `json:"components,omitempty"`
+	CreatedAt            time.Time                   `json:"timestamp"`
+	Mentions             []User                      `json:"mentions"`
+	MentionEveryone      bool                        `json:"mention_everyone"`
+	MentionRoles         []snowflake.ID              `json:"mention_roles"`
+	MentionChannels      []Channel                   `json:"mention_channels"`
+	Pinned               bool                        `json:"pinned"`
+	EditedTimestamp      *time.Time                  `json:"edited_timestamp"`
+	Author               User                        `json:"author"`
+	Member               *Member                     `json:"member"`
+	Content              string                      `json:"content,omitempty"`
+	ChannelID            snowflake.ID                `json:"channel_id"`
+	Type                 MessageType                 `json:"type"`
+	Flags                MessageFlags                `json:"flags"`
+	MessageReference     *MessageReference           `json:"message_reference,omitempty"`
+	Interaction          *MessageInteraction         `json:"interaction,omitempty"`
+	InteractionMetadata  *MessageInteractionMetadata `json:"interaction_metadata,omitempty"`
+	WebhookID            *snowflake.ID               `json:"webhook_id,omitempty"`
+	Activity             *MessageActivity            `json:"activity,omitempty"`
+	Application          *MessageApplication         `json:"application,omitempty"`
+	Stickers             []MessageSticker            `json:"sticker_items,omitempty"`
+	ReferencedMessage    *Message                    `json:"referenced_message,omitempty"`
+	LastUpdated          *time.Time                  `json:"last_updated,omitempty"`
+	Thread               *MessageThread              `json:"thread,omitempty"`
+	RoleSubscriptionData *RoleSubscriptionData       `json:"role_subscription_data,omitempty"`
 }
 
 func (m *Message) UnmarshalJSON(data []byte) error {
@@ -249,10 +313,11 @@ type MessageApplication struct {
 
 // MessageReference is a reference to another message
 type MessageReference struct {
-	MessageID       *snowflake.ID `json:"message_id"`
-	ChannelID       *snowflake.ID `json:"channel_id,omitempty"`
-	GuildID         *snowflake.ID `json:"guild_id,omitempty"`
-	FailIfNotExists bool          `json:"fail_if_not_exists,omitempty"`
+	Type            MessageReferenceType `json:"type,omitempty"`
+	MessageID       *snowflake.ID        `json:"message_id"`
+	ChannelID       *snowflake.ID        `json:"channel_id,omitempty"`
+	GuildID         *snowflake.ID        `json:"guild_id,omitempty"`
+	FailIfNotExists bool                 `json:"fail_if_not_exists,omitempty"`
 }
 
 // MessageInteraction is sent on the Message object when the message_events is a response to an interaction
@@ -263,6 +328,47 @@ type MessageInteraction struct {
 	User User            `json:"user"`
 }
 
+// MessageInteractionMetadata is sent on the Message object when the message is a response to an interaction.
+// It replaces MessageInteraction and also covers component & modal interactions.
+type MessageInteractionMetadata struct {
+	ID                            snowflake.ID                                `json:"id"`
+	Type                          InteractionType                             `json:"type"`
+	User                          User                                        `json:"user"`
+	AuthorizingIntegrationOwners  map[ApplicationIntegrationType]snowflake.ID `json:"authorizing_integration_owners"`
+	OriginalResponseMessageID     *snowflake.ID                               `json:"original_response_message_id,omitempty"`
+	InteractedMessageID           *snowflake.ID                               `json:"interacted_message_id,omitempty"`
+	TriggeringInteractionMetadata *MessageInteractionMetadata                 `json:"triggering_interaction_metadata,omitempty"`
+}
+
+// GuildInstallOwner returns the guild the application was installed to, if the interaction was authorized by a guild install.
+func (m MessageInteractionMetadata) GuildInstallOwner() (snowflake.ID, bool) {
+	id, ok := m.AuthorizingIntegrationOwners[ApplicationIntegrationTypeGuildInstall]
+	return id, ok
+}
+
+// UserInstallOwner returns the user the application was installed to, if the interaction was authorized by a user install.
+func (m MessageInteractionMetadata) UserInstallOwner() (snowflake.ID, bool) {
+	id, ok := m.AuthorizingIntegrationOwners[ApplicationIntegrationTypeUserInstall]
+	return id, ok
+}
+
+// Root follows TriggeringInteractionMetadata back to the interaction which started the chain, usually the application command which created the message the components are attached to.
+func (m *MessageInteractionMetadata) Root() *MessageInteractionMetadata {
+	root := m
+	for root.TriggeringInteractionMetadata != nil {
+		root = root.TriggeringInteractionMetadata
+	}
+	return root
+}
+
+// RoleSubscriptionData is sent on Message(s) of type MessageTypeRoleSubscriptionPurchase
+type RoleSubscriptionData struct {
+	RoleSubscriptionListingID snowflake.ID `json:"role_subscription_listing_id"`
+	TierName                  string       `json:"tier_name"`
+	TotalMonthsSubscribed     int          `json:"total_months_subscribed"`
+	IsRenewal                 bool         `json:"is_renewal"`
+}
+
 type MessageBulkDelete struct {
 	Messages []snowflake.ID `json:"message s"`
 }
@@ -1,6 +1,7 @@
 package discord
 
 import (
+	"github.com/disgoorg/disgo/json"
 	"github.com/disgoorg/snowflake/v2"
 )
 
@@ -17,10 +18,35 @@ type MessageCreate struct {
 	AllowedMentions  *AllowedMentions     `json:"allowed_mentions,omitempty"`
 	MessageReference *MessageReference    `json:"message_reference,omitempty"`
 	Flags            MessageFlags         `json:"flags,omitempty"`
+	// EnforceNonce makes discord check whether a Message with the same Nonce was sent by the same user within the past few minutes and return it instead of creating a duplicate.
+	EnforceNonce bool `json:"enforce_nonce,omitempty"`
 }
 
 func (MessageCreate) interactionCallbackData() {}
 
+func (m *MessageCreate) UnmarshalJSON(data []byte) error {
+	type messageCreate MessageCreate
+	var v struct {
+		Components []UnmarshalComponent `json:"components"`
+		messageCreate
+	}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*m = MessageCreate(v.messageCreate)
+
+	if len(v.Components) > 0 {
+		m.Components = make([]ContainerComponent, len(v.Components))
+		for i := range v.Components {
+			m.Components[i] = v.Components[i].Component.(ContainerComponent)
+		}
+	}
+
+	return nil
+}
+
 // ToBody returns the MessageCreate ready for body
 func (m MessageCreate) ToBody() (any, error) {
 	if len(m.Files) > 0 {
@@ -196,6 +196,22 @@ func (b *MessageCreateBuilder) SetMessageReferenceByID(messageID snowflake.ID) *
 	return b
 }
 
+// SetNonce sets the nonce of the Message
+func (b *MessageCreateBuilder) SetNonce(nonce string) *MessageCreateBuilder {
+	b.Nonce = nonce
+	return b
+}
+
+// SetEnforceNonce sets whether discord should de-duplicate the Message by its nonce.
+// If no nonce is set yet, a new one is generated using the DefaultNonceGenerator.
+func (b *MessageCreateBuilder) SetEnforceNonce(enforceNonce bool) *MessageCreateBuilder {
+	if enforceNonce && b.Nonce == "" {
+		b.Nonce = NewNonce()
+	}
+	b.EnforceNonce = enforceNonce
+	return b
+}
+
 // SetFlags sets the message flags of the Message
 func (b *MessageCreateBuilder) SetFlags(flags MessageFlags) *MessageCreateBuilder {
 	b.Flags = flags
@@ -0,0 +1,134 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// MessageReferenceType is the type of MessageReference
+type MessageReferenceType int
+
+// Constants for MessageReferenceType
+const (
+	// MessageReferenceTypeDefault is a reply to a message in the same channel
+	MessageReferenceTypeDefault MessageReferenceType = iota
+	// MessageReferenceTypeForward is a forward of a message, which can be in any channel
+	MessageReferenceTypeForward
+)
+
+// MessageGetter is used by Message.ReferencedChain to get referenced messages.
+// bot.BuildClient sets it to look up the message in the cache of the built client first and request it from the rest api otherwise if it is nil.
+var MessageGetter func(ctx context.Context, channelID snowflake.ID, messageID snowflake.ID) (*Message, error)
+
+// ReferencedChain walks the reply chain of the Message and returns the referenced messages starting with the one the Message directly replies to.
+// It stops after depth messages or at the first message which doesn't reference another one. A depth of 0 or less walks the whole chain.
+// The Message.ReferencedMessage is used if present, all other messages are retrieved via the MessageGetter.
+// If a message can't be retrieved, for example because it was deleted, the messages retrieved until then are returned together with the error.
+func (m Message) ReferencedChain(ctx context.Context, depth int) ([]Message, error) {
+	var chain []Message
+	current := m
+	for depth <= 0 || len(chain) < depth {
+		ref := current.MessageReference
+		if ref == nil || ref.MessageID == nil || ref.Type != MessageReferenceTypeDefault {
+			return chain, nil
+		}
+		if current.ReferencedMessage != nil {
+			current = *current.ReferencedMessage
+			chain = append(chain, current)
+			continue
+		}
+		if MessageGetter == nil {
+			return chain, ErrNoMessageGetter
+		}
+		channelID := current.ChannelID
+		if ref.ChannelID != nil {
+			channelID = *ref.ChannelID
+		}
+		message, err := MessageGetter(ctx, channelID, *ref.MessageID)
+		if err != nil {
+			return chain, fmt.Errorf("failed to get referenced message %d: %w", *ref.MessageID, err)
+		}
+		current = *message
+		chain = append(chain, current)
+	}
+	return chain, nil
+}
+
+// NewMessageReferenceBuilder returns a new MessageReferenceBuilder
+func NewMessageReferenceBuilder() *MessageReferenceBuilder {
+	return &MessageReferenceBuilder{}
+}
+
+// NewReplyBuilder returns a new MessageReferenceBuilder which replies to the given Message
+func NewReplyBuilder(message Message) *MessageReferenceBuilder {
+	return NewMessageReferenceBuilder().SetMessage(message)
+}
+
+// MessageReferenceBuilder helps create a MessageReference and validates it against the channel the message is sent to
+type MessageReferenceBuilder struct {
+	MessageReference
+}
+
+// SetType sets the MessageReferenceType of the MessageReference
+func (b *MessageReferenceBuilder) SetType(referenceType MessageReferenceType) *MessageReferenceBuilder {
+	b.Type = referenceType
+	return b
+}
+
+// SetMessage sets the message, channel & guild id of the MessageReference to the ones of the given Message
+func (b *MessageReferenceBuilder) SetMessage(message Message) *MessageReferenceBuilder {
+	b.MessageID = &message.ID
+	b.ChannelID = &message.ChannelID
+	b.GuildID = message.GuildID
+	return b
+}
+
+// SetMessageID sets the message id of the MessageReference
+func (b *MessageReferenceBuilder) SetMessageID(messageID snowflake.ID) *MessageReferenceBuilder {
+	b.MessageID = &messageID
+	return b
+}
+
+// SetChannelID sets the channel id of the MessageReference
+func (b *MessageReferenceBuilder) SetChannelID(channelID snowflake.ID) *MessageReferenceBuilder {
+	b.ChannelID = &channelID
+	return b
+}
+
+// SetGuildID sets the guild id of the MessageReference
+func (b *MessageReferenceBuilder) SetGuildID(guildID snowflake.ID) *MessageReferenceBuilder {
+	b.GuildID = &guildID
+	return b
+}
+
+// SetFailIfNotExists sets whether sending the message should fail if the referenced message doesn't exist
+func (b *MessageReferenceBuilder) SetFailIfNotExists(failIfNotExists bool) *MessageReferenceBuilder {
+	b.FailIfNotExists = failIfNotExists
+	return b
+}
+
+// Validate checks whether the MessageReference can be sent in the given channel & guild. The guildID is nil for direct messages.
+// Replies must reference a message in the same channel and guild, while forwards can reference a message in any channel.
+func (b *MessageReferenceBuilder) Validate(channelID snowflake.ID, guildID *snowflake.ID) error {
+	if b.MessageID == nil {
+		return ErrMessageReferenceNoMessageID
+	}
+	if b.Type == MessageReferenceTypeForward {
+		return nil
+	}
+	if b.ChannelID != nil && *b.ChannelID != channelID {
+		return ErrMessageReferenceCrossChannel
+	}
+	if b.GuildID != nil && (guildID == nil || *b.GuildID != *guildID) {
+		return ErrMessageReferenceCrossGuild
+	}
+	return nil
+}
+
+// Build builds the MessageReference. Use Validate first to check it against the channel the message is sent to.
+func (b *MessageReferenceBuilder) Build() *MessageReference {
+	ref := b.MessageReference
+	return &ref
+}
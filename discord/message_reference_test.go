@@ -0,0 +1,60 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func replyTo(id snowflake.ID, referencedID snowflake.ID) Message {
+	return Message{ID: id, ChannelID: 1, MessageReference: &MessageReference{MessageID: &referencedID}}
+}
+
+func TestMessage_ReferencedChain(t *testing.T) {
+	messages := map[snowflake.ID]Message{
+		3: replyTo(3, 2),
+		2: replyTo(2, 1),
+		1: {ID: 1, ChannelID: 1},
+	}
+	MessageGetter = func(_ context.Context, _ snowflake.ID, messageID snowflake.ID) (*Message, error) {
+		message, ok := messages[messageID]
+		if !ok {
+			return nil, errors.New("unknown message")
+		}
+		return &message, nil
+	}
+	defer func() { MessageGetter = nil }()
+
+	message := replyTo(4, 3)
+	referenced := messages[3]
+	message.ReferencedMessage = &referenced
+
+	chain, err := message.ReferencedChain(context.Background(), 0)
+	assert.NoError(t, err)
+	assert.Len(t, chain, 3)
+	assert.Equal(t, snowflake.ID(1), chain[2].ID)
+
+	chain, err = message.ReferencedChain(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Len(t, chain, 2)
+
+	delete(messages, 1)
+	chain, err = message.ReferencedChain(context.Background(), 0)
+	assert.Error(t, err)
+	assert.Len(t, chain, 2)
+}
+
+func TestMessageReferenceBuilder_Validate(t *testing.T) {
+	guildID := snowflake.ID(10)
+	otherGuildID := snowflake.ID(11)
+	message := Message{ID: 1, ChannelID: 2, GuildID: &guildID}
+
+	assert.NoError(t, NewReplyBuilder(message).Validate(2, &guildID))
+	assert.ErrorIs(t, NewReplyBuilder(message).Validate(3, &guildID), ErrMessageReferenceCrossChannel)
+	assert.ErrorIs(t, NewReplyBuilder(message).Validate(2, &otherGuildID), ErrMessageReferenceCrossGuild)
+	assert.NoError(t, NewReplyBuilder(message).SetType(MessageReferenceTypeForward).Validate(3, nil))
+	assert.ErrorIs(t, NewMessageReferenceBuilder().Validate(2, nil), ErrMessageReferenceNoMessageID)
+}
@@ -0,0 +1,154 @@
+package discord
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MessageRenderFormat is the format Message.Render renders a Message in.
+type MessageRenderFormat int
+
+// All MessageRenderFormat(s)
+const (
+	// MessageRenderFormatText renders a Message as plain text with one labeled line per part.
+	MessageRenderFormatText MessageRenderFormat = iota
+	// MessageRenderFormatMarkdown renders a Message as discord markdown with embeds as block quotes, for example to mirror it to another channel.
+	MessageRenderFormatMarkdown
+)
+
+// Render renders the content, embeds, attachments, components & stickers of the Message into a summary in the given MessageRenderFormat.
+// This is useful for logging deleted or edited messages to an audit channel or indexing them for search.
+func (m Message) Render(format MessageRenderFormat) string {
+	r := messageRenderer{format: format}
+	if m.Content != "" {
+		r.line(m.Content)
+	}
+	for _, embed := range m.Embeds {
+		r.embed(embed)
+	}
+	for _, attachment := range m.Attachments {
+		r.attachment(attachment)
+	}
+	for _, component := range m.Components {
+		for _, c := range component.Components() {
+			r.component(c)
+		}
+	}
+	for _, sticker := range m.Stickers {
+		r.labeled("Sticker", sticker.Name)
+	}
+	return strings.TrimSuffix(r.sb.String(), "\n")
+}
+
+type messageRenderer struct {
+	format MessageRenderFormat
+	sb     strings.Builder
+	prefix string
+}
+
+func (r *messageRenderer) line(s string) {
+	for _, l := range strings.Split(s, "\n") {
+		r.sb.WriteString(r.prefix)
+		r.sb.WriteString(l)
+		r.sb.WriteString("\n")
+	}
+}
+
+func (r *messageRenderer) labeled(label string, s string) {
+	if r.format == MessageRenderFormatMarkdown {
+		r.line("**" + label + ":** " + s)
+		return
+	}
+	r.line(label + ": " + s)
+}
+
+func (r *messageRenderer) link(name string, url string) string {
+	if url == "" {
+		return name
+	}
+	if name == "" {
+		return url
+	}
+	if r.format == MessageRenderFormatMarkdown {
+		return "[" + name + "](" + url + ")"
+	}
+	return name + " (" + url + ")"
+}
+
+func (r *messageRenderer) embed(embed Embed) {
+	// embeds are quoted in markdown and indented below a label in text
+	if r.format == MessageRenderFormatMarkdown {
+		r.prefix = "> "
+	} else {
+		r.line("Embed:")
+		r.prefix = "  "
+	}
+	defer func() { r.prefix = "" }()
+
+	if embed.Author != nil && embed.Author.Name != "" {
+		r.labeled("Author", r.link(embed.Author.Name, embed.Author.URL))
+	}
+	if embed.Title != "" {
+		title := r.link(embed.Title, embed.URL)
+		if r.format == MessageRenderFormatMarkdown {
+			r.line("**" + title + "**")
+		} else {
+			r.line(title)
+		}
+	}
+	if embed.Description != "" {
+		r.line(embed.Description)
+	}
+	for _, field := range embed.Fields {
+		r.labeled(field.Name, field.Value)
+	}
+	if embed.Image != nil && embed.Image.URL != "" {
+		r.labeled("Image", embed.Image.URL)
+	}
+	if embed.Thumbnail != nil && embed.Thumbnail.URL != "" {
+		r.labeled("Thumbnail", embed.Thumbnail.URL)
+	}
+	if embed.Video != nil && embed.Video.URL != "" {
+		r.labeled("Video", embed.Video.URL)
+	}
+	if embed.Footer != nil && embed.Footer.Text != "" {
+		if r.format == MessageRenderFormatMarkdown {
+			r.line("*" + embed.Footer.Text + "*")
+		} else {
+			r.labeled("Footer", embed.Footer.Text)
+		}
+	}
+}
+
+func (r *messageRenderer) attachment(attachment Attachment) {
+	s := fmt.Sprintf("%s (%d bytes)", r.link(attachment.Filename, attachment.URL), attachment.Size)
+	if attachment.Description != nil && *attachment.Description != "" {
+		s += ": " + *attachment.Description
+	}
+	r.labeled("Attachment", s)
+}
+
+func (r *messageRenderer) component(component InteractiveComponent) {
+	switch c := component.(type) {
+	case ButtonComponent:
+		label := c.Label
+		if label == "" && c.Emoji != nil {
+			label = c.Emoji.Name
+		}
+		r.labeled("Button", r.link(label, c.URL))
+
+	case SelectMenuComponent:
+		options := make([]string, len(c.Options))
+		for i, option := range c.Options {
+			options[i] = option.Label
+		}
+		s := strings.Join(options, ", ")
+		if c.Placeholder != "" {
+			s = c.Placeholder + ": " + s
+		}
+		r.labeled("Select Menu", s)
+
+	case TextInputComponent:
+		r.labeled("Text Input", c.Label)
+	}
+}
@@ -0,0 +1,109 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/disgoorg/disgo/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageInteractionMetadata(t *testing.T) {
+	data := []byte(`{
+		"id": "1",
+		"type": 3,
+		"user": {"id": "2", "username": "clicker"},
+		"authorizing_integration_owners": {"0": "3"},
+		"interacted_message_id": "4",
+		"triggering_interaction_metadata": {
+			"id": "5",
+			"type": 2,
+			"user": {"id": "6", "username": "commander"},
+			"authorizing_integration_owners": {"0": "3", "1": "6"},
+			"original_response_message_id": "4"
+		}
+	}`)
+
+	var metadata MessageInteractionMetadata
+	assert.NoError(t, json.Unmarshal(data, &metadata))
+
+	guildID, ok := metadata.GuildInstallOwner()
+	assert.True(t, ok)
+	assert.Equal(t, snowflake.ID(3), guildID)
+	_, ok = metadata.UserInstallOwner()
+	assert.False(t, ok)
+
+	root := metadata.Root()
+	assert.Equal(t, InteractionTypeApplicationCommand, root.Type)
+	assert.Equal(t, snowflake.ID(6), root.User.ID)
+	assert.Equal(t, snowflake.ID(4), *root.OriginalResponseMessageID)
+}
+
+func TestMessageType(t *testing.T) {
+	assert.Equal(t, MessageType(25), MessageTypeRoleSubscriptionPurchase)
+	assert.Equal(t, MessageType(31), MessageTypeStageTopic)
+	assert.Equal(t, MessageType(39), MessageTypeGuildIncidentReportFalseAlarm)
+
+	assert.False(t, MessageTypeReply.IsSystem())
+	assert.True(t, MessageTypeStageTopic.IsSystem())
+	assert.True(t, MessageTypeStageTopic.IsStage())
+	assert.False(t, MessageTypeThreadStarterMessage.IsDeletable())
+	assert.False(t, MessageTypeCall.IsDeletable())
+	assert.True(t, MessageTypePurchaseNotification.IsDeletable())
+	assert.True(t, MessageTypeGuildIncidentReportRaid.IsGuildIncident())
+}
+
+func TestMessageCreateUnmarshal(t *testing.T) {
+	messageCreate := NewMessageCreateBuilder().
+		SetContent("hello").
+		AddActionRow(NewPrimaryButton("click", "button")).
+		Build()
+
+	data, err := json.Marshal(messageCreate)
+	assert.NoError(t, err)
+
+	var decoded MessageCreate
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, messageCreate, decoded)
+}
+
+func TestMessageRender(t *testing.T) {
+	message := Message{
+		Content: "hello",
+		Embeds: []Embed{{
+			Title:       "title",
+			URL:         "https://example.com",
+			Description: "line 1\nline 2",
+			Fields:      []EmbedField{{Name: "name", Value: "value"}},
+			Footer:      &EmbedFooter{Text: "footer"},
+		}},
+		Attachments: []Attachment{{Filename: "file.txt", URL: "https://cdn.example.com/file.txt", Size: 42}},
+		Components: []ContainerComponent{
+			NewActionRow(NewPrimaryButton("click", "id"), NewLinkButton("open", "https://example.com")),
+		},
+		Stickers: []MessageSticker{{Name: "wave"}},
+	}
+
+	assert.Equal(t, `hello
+Embed:
+  title (https://example.com)
+  line 1
+  line 2
+  name: value
+  Footer: footer
+Attachment: file.txt (https://cdn.example.com/file.txt) (42 bytes)
+Button: click
+Button: open (https://example.com)
+Sticker: wave`, message.Render(MessageRenderFormatText))
+
+	assert.Equal(t, `hello
+> **[title](https://example.com)**
+> line 1
+> line 2
+> **name:** value
+> *footer*
+**Attachment:** [file.txt](https://cdn.example.com/file.txt) (42 bytes)
+**Button:** click
+**Button:** [open](https://example.com)
+**Sticker:** wave`, message.Render(MessageRenderFormatMarkdown))
+}
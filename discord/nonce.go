@@ -0,0 +1,51 @@
+package discord
+
+import (
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// DefaultNonceGenerator is the NonceGenerator used by NewNonce & NewCustomID. Replace it to plug in your own id generation.
+var DefaultNonceGenerator NonceGenerator = NewSnowflakeNonceGenerator()
+
+// NonceGenerator generates unique ids used as Message nonces & component CustomID(s).
+// Generated ids must not be longer than 25 characters to be valid nonces.
+type NonceGenerator interface {
+	Generate() string
+}
+
+// NonceGeneratorFunc is a func which implements NonceGenerator.
+type NonceGeneratorFunc func() string
+
+// Generate calls the NonceGeneratorFunc.
+func (f NonceGeneratorFunc) Generate() string {
+	return f()
+}
+
+// NewSnowflakeNonceGenerator returns a NonceGenerator which generates snowflake.ID(s) of the current time.
+// The increment bits of the snowflake.ID are used to keep ids generated within the same millisecond unique.
+func NewSnowflakeNonceGenerator() NonceGenerator {
+	return &snowflakeNonceGenerator{}
+}
+
+type snowflakeNonceGenerator struct {
+	increment uint32
+}
+
+func (g *snowflakeNonceGenerator) Generate() string {
+	id := snowflake.New(time.Now()) | snowflake.ID(atomic.AddUint32(&g.increment, 1)&0xFFF)
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// NewNonce generates a new nonce using the DefaultNonceGenerator.
+func NewNonce() string {
+	return DefaultNonceGenerator.Generate()
+}
+
+// NewCustomID generates a new unique CustomID with the given prefix using the DefaultNonceGenerator.
+func NewCustomID(prefix string) CustomID {
+	return CustomID(prefix + DefaultNonceGenerator.Generate())
+}
@@ -41,6 +41,20 @@ func (p PermissionOverwrites) Member(id snowflake.ID) (MemberPermissionOverwrite
 	return MemberPermissionOverwrite{}, false
 }
 
+// Equal returns whether both PermissionOverwrites contain the same overwrites regardless of their order.
+func (p PermissionOverwrites) Equal(other PermissionOverwrites) bool {
+	if len(p) != len(other) {
+		return false
+	}
+	for _, overwrite := range p {
+		otherOverwrite, ok := other.Get(overwrite.Type(), overwrite.ID())
+		if !ok || otherOverwrite != overwrite {
+			return false
+		}
+	}
+	return true
+}
+
 // PermissionOverwrite is used to determine who can perform particular actions in a GetGuildChannel
 type PermissionOverwrite interface {
 	Type() PermissionOverwriteType
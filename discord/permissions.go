@@ -2,7 +2,10 @@ package discord
 
 import (
 	"bytes"
+	"fmt"
 	"strconv"
+	"strings"
+	"unicode"
 
 	"github.com/disgoorg/disgo/json"
 )
@@ -50,6 +53,22 @@ const (
 	PermissionSendMessagesInThreads
 	PermissionStartEmbeddedActivities
 	PermissionModerateMembers
+	PermissionViewCreatorMonetizationAnalytics
+	PermissionUseSoundboard
+	PermissionCreateGuildExpressions
+	PermissionCreateEvents
+	PermissionUseExternalSounds
+	PermissionSendVoiceMessages
+	_
+	PermissionSetVoiceChannelStatus
+	PermissionSendPolls
+	PermissionUseExternalApps
+)
+
+// Constants for the remaining single bit permissions
+const (
+	PermissionStream            Permissions = 1 << 9
+	PermissionViewGuildInsights Permissions = 1 << 19
 )
 
 // Constants for the different bit offsets of general permissions
@@ -102,7 +121,27 @@ const (
 		PermissionManageServer |
 		PermissionAdministrator |
 		PermissionManageWebhooks |
-		PermissionManageEmojisAndStickers
+		PermissionManageEmojisAndStickers |
+		PermissionStream |
+		PermissionUseExternalEmojis |
+		PermissionViewGuildInsights |
+		PermissionChangeNickname |
+		PermissionManageNicknames |
+		PermissionUseApplicationCommands |
+		PermissionRequestToSpeak |
+		PermissionManageEvents |
+		PermissionUseExternalStickers |
+		PermissionStartEmbeddedActivities |
+		PermissionModerateMembers |
+		PermissionViewCreatorMonetizationAnalytics |
+		PermissionUseSoundboard |
+		PermissionCreateGuildExpressions |
+		PermissionCreateEvents |
+		PermissionUseExternalSounds |
+		PermissionSendVoiceMessages |
+		PermissionSetVoiceChannelStatus |
+		PermissionSendPolls |
+		PermissionUseExternalApps
 
 	PermissionsStageModerator = PermissionManageChannels |
 		PermissionVoiceMuteMembers |
@@ -111,6 +150,111 @@ const (
 	PermissionsNone Permissions = 0
 )
 
+var permissionNames = []struct {
+	permission Permissions
+	name       string
+}{
+	{PermissionCreateInstantInvite, "CreateInstantInvite"},
+	{PermissionKickMembers, "KickMembers"},
+	{PermissionBanMembers, "BanMembers"},
+	{PermissionAdministrator, "Administrator"},
+	{PermissionManageChannels, "ManageChannels"},
+	{PermissionManageServer, "ManageServer"},
+	{PermissionAddReactions, "AddReactions"},
+	{PermissionViewAuditLogs, "ViewAuditLogs"},
+	{PermissionVoicePrioritySpeaker, "VoicePrioritySpeaker"},
+	{PermissionStream, "Stream"},
+	{PermissionViewChannel, "ViewChannel"},
+	{PermissionSendMessages, "SendMessages"},
+	{PermissionSendTTSMessages, "SendTTSMessages"},
+	{PermissionManageMessages, "ManageMessages"},
+	{PermissionEmbedLinks, "EmbedLinks"},
+	{PermissionAttachFiles, "AttachFiles"},
+	{PermissionReadMessageHistory, "ReadMessageHistory"},
+	{PermissionMentionEveryone, "MentionEveryone"},
+	{PermissionUseExternalEmojis, "UseExternalEmojis"},
+	{PermissionViewGuildInsights, "ViewGuildInsights"},
+	{PermissionVoiceConnect, "VoiceConnect"},
+	{PermissionVoiceSpeak, "VoiceSpeak"},
+	{PermissionVoiceMuteMembers, "VoiceMuteMembers"},
+	{PermissionVoiceDeafenMembers, "VoiceDeafenMembers"},
+	{PermissionVoiceMoveMembers, "VoiceMoveMembers"},
+	{PermissionVoiceUseVAD, "VoiceUseVAD"},
+	{PermissionChangeNickname, "ChangeNickname"},
+	{PermissionManageNicknames, "ManageNicknames"},
+	{PermissionManageRoles, "ManageRoles"},
+	{PermissionManageWebhooks, "ManageWebhooks"},
+	{PermissionManageEmojisAndStickers, "ManageEmojisAndStickers"},
+	{PermissionUseApplicationCommands, "UseApplicationCommands"},
+	{PermissionRequestToSpeak, "RequestToSpeak"},
+	{PermissionManageEvents, "ManageEvents"},
+	{PermissionManageThreads, "ManageThreads"},
+	{PermissionCreatePublicThread, "CreatePublicThread"},
+	{PermissionCreatePrivateThread, "CreatePrivateThread"},
+	{PermissionUseExternalStickers, "UseExternalStickers"},
+	{PermissionSendMessagesInThreads, "SendMessagesInThreads"},
+	{PermissionStartEmbeddedActivities, "StartEmbeddedActivities"},
+	{PermissionModerateMembers, "ModerateMembers"},
+	{PermissionViewCreatorMonetizationAnalytics, "ViewCreatorMonetizationAnalytics"},
+	{PermissionUseSoundboard, "UseSoundboard"},
+	{PermissionCreateGuildExpressions, "CreateGuildExpressions"},
+	{PermissionCreateEvents, "CreateEvents"},
+	{PermissionUseExternalSounds, "UseExternalSounds"},
+	{PermissionSendVoiceMessages, "SendVoiceMessages"},
+	{PermissionSetVoiceChannelStatus, "SetVoiceChannelStatus"},
+	{PermissionSendPolls, "SendPolls"},
+	{PermissionUseExternalApps, "UseExternalApps"},
+}
+
+// ParsePermissions parses the given permission names into Permissions.
+// Names are matched case-insensitive and ignoring underscores & spaces, so "SendMessages", "send messages" & "SEND_MESSAGES" are all valid.
+func ParsePermissions(names []string) (Permissions, error) {
+	var permissions Permissions
+outer:
+	for _, name := range names {
+		normalized := normalizePermissionName(name)
+		for _, p := range permissionNames {
+			if strings.EqualFold(p.name, normalized) {
+				permissions |= p.permission
+				continue outer
+			}
+		}
+		return PermissionsNone, fmt.Errorf("unknown permission: %s", name)
+	}
+	return permissions, nil
+}
+
+func normalizePermissionName(name string) string {
+	return strings.NewReplacer("_", "", " ", "", "-", "").Replace(strings.TrimSpace(name))
+}
+
+// Names returns the names of all set permissions.
+func (p Permissions) Names() []string {
+	var names []string
+	for _, permission := range permissionNames {
+		if p.Has(permission.permission) {
+			names = append(names, permission.name)
+		}
+	}
+	return names
+}
+
+// String returns the names of all set permissions separated by a comma or "None" if no permissions are set.
+// Unknown bits are appended as their raw value.
+func (p Permissions) String() string {
+	if p == PermissionsNone {
+		return "None"
+	}
+	names := p.Names()
+	for _, permission := range permissionNames {
+		p &^= permission.permission
+	}
+	if p != PermissionsNone {
+		names = append(names, strconv.FormatInt(int64(p), 10))
+	}
+	return strings.Join(names, ", ")
+}
+
 // MarshalJSON marshals permissions into a string
 func (p Permissions) MarshalJSON() ([]byte, error) {
 	return json.Marshal(strconv.FormatInt(int64(p), 10))
@@ -167,3 +311,89 @@ func (p Permissions) Missing(bits ...Permissions) bool {
 	}
 	return false
 }
+
+// DisplayNames returns the human-readable names of all set permissions, like "Send TTS Messages" for PermissionSendTTSMessages.
+func (p Permissions) DisplayNames() []string {
+	names := p.Names()
+	for i, name := range names {
+		names[i] = permissionDisplayName(name)
+	}
+	return names
+}
+
+// permissionDisplayName splits the camel case permission name into words while keeping acronyms like TTS & VAD together.
+func permissionDisplayName(name string) string {
+	var sb strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && (unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			sb.WriteRune(' ')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// Diff returns which permissions were added & removed when changing from p to newPermissions.
+// It can be called as Permissions.Diff(oldPermissions, newPermissions), for example to explain role edits from events.RoleUpdate.
+// For PermissionOverwrite(s) diff the allowed & denied permissions separately.
+func (p Permissions) Diff(newPermissions Permissions) PermissionsDiff {
+	return PermissionsDiff{
+		Added:   newPermissions &^ p,
+		Removed: p &^ newPermissions,
+	}
+}
+
+// PermissionsDiff holds the permissions which were added & removed between two Permissions.
+type PermissionsDiff struct {
+	Added   Permissions
+	Removed Permissions
+}
+
+// Empty returns whether no permissions were added or removed.
+func (d PermissionsDiff) Empty() bool {
+	return d.Added == PermissionsNone && d.Removed == PermissionsNone
+}
+
+// AddedNames returns the human-readable names of the added permissions.
+func (d PermissionsDiff) AddedNames() []string {
+	return d.Added.DisplayNames()
+}
+
+// RemovedNames returns the human-readable names of the removed permissions.
+func (d PermissionsDiff) RemovedNames() []string {
+	return d.Removed.DisplayNames()
+}
+
+// String returns the added permissions prefixed with "+" and the removed permissions prefixed with "-" separated by a comma or "No changes" if the diff is empty.
+func (d PermissionsDiff) String() string {
+	if d.Empty() {
+		return "No changes"
+	}
+	var changes []string
+	for _, name := range d.AddedNames() {
+		changes = append(changes, "+"+name)
+	}
+	for _, name := range d.RemovedNames() {
+		changes = append(changes, "-"+name)
+	}
+	return strings.Join(changes, ", ")
+}
+
+// Markdown returns the diff as a markdown diff code block with one permission per line, which discord renders green for added & red for removed permissions.
+// This is useful for audit log channels. An empty diff returns an empty string.
+func (d PermissionsDiff) Markdown() string {
+	if d.Empty() {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("```diff\n")
+	for _, name := range d.AddedNames() {
+		sb.WriteString("+ " + name + "\n")
+	}
+	for _, name := range d.RemovedNames() {
+		sb.WriteString("- " + name + "\n")
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
@@ -45,3 +45,34 @@ func TestPermissions_Has(t *testing.T) {
 func TestPermissions_Missing(t *testing.T) {
 	assert.True(t, PermissionManageChannels.Missing(PermissionAddReactions))
 }
+
+func TestPermissions_String(t *testing.T) {
+	assert.Equal(t, "None", PermissionsNone.String())
+	assert.Equal(t, "AddReactions, ChangeNickname", (PermissionAddReactions | PermissionChangeNickname).String())
+	assert.Equal(t, "SendVoiceMessages, 140737488355328", (PermissionSendVoiceMessages | 1<<47).String())
+}
+
+func TestParsePermissions(t *testing.T) {
+	perms, err := ParsePermissions([]string{"SendMessages", "use external sounds", "CREATE_EVENTS"})
+	assert.NoError(t, err)
+	assert.Equal(t, PermissionSendMessages|PermissionUseExternalSounds|PermissionCreateEvents, perms)
+
+	_, err = ParsePermissions([]string{"NotAPermission"})
+	assert.Error(t, err)
+}
+
+func TestPermissions_Diff(t *testing.T) {
+	diff := Permissions.Diff(PermissionSendMessages|PermissionAdministrator, PermissionSendMessages|PermissionSendTTSMessages|PermissionVoiceUseVAD)
+
+	assert.Equal(t, PermissionSendTTSMessages|PermissionVoiceUseVAD, diff.Added)
+	assert.Equal(t, PermissionAdministrator, diff.Removed)
+	assert.Equal(t, "+Send TTS Messages, +Voice Use VAD, -Administrator", diff.String())
+	assert.Equal(t, "```diff\n+ Send TTS Messages\n+ Voice Use VAD\n- Administrator\n```", diff.Markdown())
+	assert.True(t, PermissionSendMessages.Diff(PermissionSendMessages).Empty())
+}
+
+func TestPermissionsAll(t *testing.T) {
+	for _, p := range permissionNames {
+		assert.True(t, PermissionsAll.Has(p.permission), "PermissionsAll is missing %s", p.name)
+	}
+}
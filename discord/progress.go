@@ -0,0 +1,43 @@
+package discord
+
+import (
+	"io"
+)
+
+// ProgressFunc is called with the amount of bytes transferred so far and the total amount of bytes or -1 if unknown.
+type ProgressFunc func(transferred int64, total int64)
+
+// NewProgressReader wraps the given io.Reader and reports every read to the ProgressFunc.
+// offset is the amount of bytes already transferred before, for example when resuming a download.
+// If the given io.Reader implements io.Closer, Close is passed through.
+func NewProgressReader(reader io.Reader, offset int64, total int64, progress ProgressFunc) io.ReadCloser {
+	return &progressReader{
+		reader:      reader,
+		transferred: offset,
+		total:       total,
+		progress:    progress,
+	}
+}
+
+type progressReader struct {
+	reader      io.Reader
+	transferred int64
+	total       int64
+	progress    ProgressFunc
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.transferred += int64(n)
+		r.progress(r.transferred, r.total)
+	}
+	return n, err
+}
+
+func (r *progressReader) Close() error {
+	if closer, ok := r.reader.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
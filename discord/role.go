@@ -38,11 +38,88 @@ func (r Role) IconURL(opts ...CDNOpt) *string {
 	return formatAssetURL(route.RoleIcon, opts, r.ID, *r.Icon)
 }
 
+// Compare compares the position of this Role with the other Role in the Role hierarchy.
+// It returns -1 if this Role is lower, 0 if both are the same Role and 1 if this Role is higher.
+// Roles with the same position are ordered by their ID, where the older Role is higher.
+func (r Role) Compare(other Role) int {
+	if r.ID == other.ID {
+		return 0
+	}
+	if r.Position == other.Position {
+		if r.ID < other.ID {
+			return 1
+		}
+		return -1
+	}
+	if r.Position > other.Position {
+		return 1
+	}
+	return -1
+}
+
 // RoleTag are tags a Role has
 type RoleTag struct {
-	BotID             *snowflake.ID `json:"bot_id,omitempty"`
-	IntegrationID     *snowflake.ID `json:"integration_id,omitempty"`
-	PremiumSubscriber bool          `json:"premium_subscriber"`
+	BotID                 *snowflake.ID `json:"bot_id,omitempty"`
+	IntegrationID         *snowflake.ID `json:"integration_id,omitempty"`
+	PremiumSubscriber     bool          `json:"premium_subscriber"`
+	SubscriptionListingID *snowflake.ID `json:"subscription_listing_id,omitempty"`
+	AvailableForPurchase  bool          `json:"available_for_purchase"`
+	GuildConnections      bool          `json:"guild_connections"`
+}
+
+// roleTagFlag is a RoleTag boolean which discord encodes as null when true and omits when false
+type roleTagFlag bool
+
+func (f *roleTagFlag) UnmarshalJSON([]byte) error {
+	// this is only called if the key is present
+	*f = true
+	return nil
+}
+
+func (t *RoleTag) UnmarshalJSON(data []byte) error {
+	var v struct {
+		BotID                 *snowflake.ID `json:"bot_id"`
+		IntegrationID         *snowflake.ID `json:"integration_id"`
+		PremiumSubscriber     roleTagFlag   `json:"premium_subscriber"`
+		SubscriptionListingID *snowflake.ID `json:"subscription_listing_id"`
+		AvailableForPurchase  roleTagFlag   `json:"available_for_purchase"`
+		GuildConnections      roleTagFlag   `json:"guild_connections"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*t = RoleTag{
+		BotID:                 v.BotID,
+		IntegrationID:         v.IntegrationID,
+		PremiumSubscriber:     bool(v.PremiumSubscriber),
+		SubscriptionListingID: v.SubscriptionListingID,
+		AvailableForPurchase:  bool(v.AvailableForPurchase),
+		GuildConnections:      bool(v.GuildConnections),
+	}
+	return nil
+}
+
+func (t RoleTag) MarshalJSON() ([]byte, error) {
+	v := map[string]any{}
+	if t.BotID != nil {
+		v["bot_id"] = *t.BotID
+	}
+	if t.IntegrationID != nil {
+		v["integration_id"] = *t.IntegrationID
+	}
+	if t.PremiumSubscriber {
+		v["premium_subscriber"] = nil
+	}
+	if t.SubscriptionListingID != nil {
+		v["subscription_listing_id"] = *t.SubscriptionListingID
+	}
+	if t.AvailableForPurchase {
+		v["available_for_purchase"] = nil
+	}
+	if t.GuildConnections {
+		v["guild_connections"] = nil
+	}
+	return json.Marshal(v)
 }
 
 // RoleCreate is the payload to create a Role
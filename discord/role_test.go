@@ -0,0 +1,29 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/disgoorg/disgo/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleTag(t *testing.T) {
+	var tag RoleTag
+	assert.NoError(t, json.Unmarshal([]byte(`{"integration_id":"1","subscription_listing_id":"2","available_for_purchase":null}`), &tag))
+	assert.Equal(t, RoleTag{
+		IntegrationID:         json.NewPtr(snowflake.ID(1)),
+		SubscriptionListingID: json.NewPtr(snowflake.ID(2)),
+		AvailableForPurchase:  true,
+	}, tag)
+
+	data, err := json.Marshal(tag)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"integration_id":"1","subscription_listing_id":"2","available_for_purchase":null}`, string(data))
+
+	tag = RoleTag{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"premium_subscriber":null,"guild_connections":null}`), &tag))
+	assert.True(t, tag.PremiumSubscriber)
+	assert.True(t, tag.GuildConnections)
+	assert.False(t, tag.AvailableForPurchase)
+}
@@ -0,0 +1,17 @@
+package discord
+
+import (
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// SnowflakeFromTime returns the smallest snowflake.ID created at the given time. It can be used as before/after parameter to paginate by time.
+func SnowflakeFromTime(t time.Time) snowflake.ID {
+	return snowflake.New(t)
+}
+
+// TimeFromSnowflake returns the time the given snowflake.ID was created at.
+func TimeFromSnowflake(id snowflake.ID) time.Time {
+	return id.Time()
+}
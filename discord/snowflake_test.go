@@ -0,0 +1,13 @@
+package discord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnowflakeFromTime(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	assert.True(t, now.Equal(TimeFromSnowflake(SnowflakeFromTime(now))))
+}
@@ -0,0 +1,26 @@
+package discord
+
+import (
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// SoundboardSound is a sound which can be played in voice channels via the soundboard
+type SoundboardSound struct {
+	SoundID   snowflake.ID  `json:"sound_id"`
+	Name      string        `json:"name"`
+	Volume    float64       `json:"volume"`
+	EmojiID   *snowflake.ID `json:"emoji_id"`
+	EmojiName *string       `json:"emoji_name"`
+	GuildID   *snowflake.ID `json:"guild_id,omitempty"`
+	Available bool          `json:"available"`
+	User      *User         `json:"user,omitempty"`
+}
+
+// SoundboardSoundCreate is used to create a SoundboardSound in a Guild. The Sound must be an mp3 or ogg file of at most 512 KiB and 5.2 seconds.
+type SoundboardSoundCreate struct {
+	Name      string       `json:"name"`
+	Sound     Icon         `json:"sound"`
+	Volume    *float64     `json:"volume,omitempty"`
+	EmojiID   snowflake.ID `json:"emoji_id,omitempty"`
+	EmojiName string       `json:"emoji_name,omitempty"`
+}
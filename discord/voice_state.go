@@ -23,6 +23,11 @@ type VoiceState struct {
 	RequestToSpeakTimestamp *time.Time    `json:"request_to_speak_timestamp"`
 }
 
+// RequestedToSpeak returns whether the user raised their hand in a stage channel.
+func (s VoiceState) RequestedToSpeak() bool {
+	return s.RequestToSpeakTimestamp != nil
+}
+
 type UserVoiceStateUpdate struct {
 	ChannelID               snowflake.ID              `json:"channel_id"`
 	Suppress                *bool                     `json:"suppress,omitempty"`
@@ -109,10 +109,16 @@ func (w *IncomingWebhook) UnmarshalJSON(data []byte) error {
 func (w IncomingWebhook) MarshalJSON() ([]byte, error) {
 	type incomingWebhook IncomingWebhook
 	return json.Marshal(struct {
-		Type WebhookType `json:"type"`
+		Type   WebhookType  `json:"type"`
+		ID     snowflake.ID `json:"id"`
+		Name   string       `json:"name"`
+		Avatar *string      `json:"avatar"`
 		incomingWebhook
 	}{
 		Type:            w.Type(),
+		ID:              w.id,
+		Name:            w.name,
+		Avatar:          w.avatar,
 		incomingWebhook: incomingWebhook(w),
 	})
 }
@@ -200,10 +206,16 @@ func (w *ChannelFollowerWebhook) UnmarshalJSON(data []byte) error {
 func (w ChannelFollowerWebhook) MarshalJSON() ([]byte, error) {
 	type channelFollowerWebhook ChannelFollowerWebhook
 	return json.Marshal(struct {
-		Type WebhookType `json:"type"`
+		Type   WebhookType  `json:"type"`
+		ID     snowflake.ID `json:"id"`
+		Name   string       `json:"name"`
+		Avatar *string      `json:"avatar"`
 		channelFollowerWebhook
 	}{
 		Type:                   w.Type(),
+		ID:                     w.id,
+		Name:                   w.name,
+		Avatar:                 w.avatar,
 		channelFollowerWebhook: channelFollowerWebhook(w),
 	})
 }
@@ -235,6 +247,9 @@ func (w ChannelFollowerWebhook) EffectiveAvatarURL(opts ...CDNOpt) string {
 }
 
 func (w ChannelFollowerWebhook) AvatarURL(opts ...CDNOpt) *string {
+	if w.Avatar() == nil {
+		return nil
+	}
 	return formatAssetURL(route.UserAvatar, opts, w.ID(), *w.Avatar())
 }
 
@@ -253,7 +268,7 @@ type ApplicationWebhook struct {
 	id            snowflake.ID
 	name          string
 	avatar        *string
-	ApplicationID snowflake.ID
+	ApplicationID snowflake.ID `json:"application_id"`
 }
 
 func (w *ApplicationWebhook) UnmarshalJSON(data []byte) error {
@@ -276,10 +291,16 @@ func (w *ApplicationWebhook) UnmarshalJSON(data []byte) error {
 func (w ApplicationWebhook) MarshalJSON() ([]byte, error) {
 	type applicationWebhook ApplicationWebhook
 	return json.Marshal(struct {
-		Type WebhookType `json:"type"`
+		Type   WebhookType  `json:"type"`
+		ID     snowflake.ID `json:"id"`
+		Name   string       `json:"name"`
+		Avatar *string      `json:"avatar"`
 		applicationWebhook
 	}{
 		Type:               w.Type(),
+		ID:                 w.id,
+		Name:               w.name,
+		Avatar:             w.avatar,
 		applicationWebhook: applicationWebhook(w),
 	})
 }
@@ -347,11 +368,11 @@ type WebhookCreate struct {
 type WebhookUpdate struct {
 	Name      *string              `json:"name,omitempty"`
 	Avatar    *json.Nullable[Icon] `json:"avatar,omitempty"`
-	ChannelID *snowflake.ID        `json:"channel_id"`
+	ChannelID *snowflake.ID        `json:"channel_id,omitempty"`
 }
 
 // WebhookUpdateWithToken is used to update a Webhook with the token
 type WebhookUpdateWithToken struct {
-	Name   *string `json:"name,omitempty"`
-	Avatar *string `json:"avatar,omitempty"`
+	Name   *string              `json:"name,omitempty"`
+	Avatar *json.Nullable[Icon] `json:"avatar,omitempty"`
 }
@@ -3,6 +3,8 @@ package discord
 import (
 	"fmt"
 	"io"
+
+	"github.com/disgoorg/snowflake/v2"
 )
 
 // WebhookMessageCreateBuilder helper to build Message(s) easier
@@ -210,6 +212,18 @@ func (b *WebhookMessageCreateBuilder) SetThreadName(threadName string) *WebhookM
 	return b
 }
 
+// SetAppliedTags sets the forum tags the thread created with SetThreadName should have applied.
+func (b *WebhookMessageCreateBuilder) SetAppliedTags(tagIDs ...snowflake.ID) *WebhookMessageCreateBuilder {
+	b.AppliedTags = tagIDs
+	return b
+}
+
+// AddAppliedTags adds forum tags the thread created with SetThreadName should have applied.
+func (b *WebhookMessageCreateBuilder) AddAppliedTags(tagIDs ...snowflake.ID) *WebhookMessageCreateBuilder {
+	b.AppliedTags = append(b.AppliedTags, tagIDs...)
+	return b
+}
+
 // Build builds the WebhookMessageCreateBuilder to a MessageCreate struct
 func (b *WebhookMessageCreateBuilder) Build() WebhookMessageCreate {
 	b.WebhookMessageCreate.Components = b.Components
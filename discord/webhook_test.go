@@ -0,0 +1,44 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/disgoorg/disgo/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalWebhooks(t *testing.T) {
+	data := []byte(`[
+		{"type":1,"id":"1","name":"incoming","avatar":null,"channel_id":"10","guild_id":"20","token":"abc"},
+		{"type":2,"id":"2","name":"follower","avatar":"hash","channel_id":"10","guild_id":"20","source_guild":{"id":"30","name":"news"},"source_channel":{"id":"40","name":"announcements"}},
+		{"type":3,"id":"3","name":"application","avatar":null,"application_id":"50"}
+	]`)
+
+	var webhooks []UnmarshalWebhook
+	assert.NoError(t, json.Unmarshal(data, &webhooks))
+	assert.Len(t, webhooks, 3)
+
+	incoming, ok := webhooks[0].Webhook.(IncomingWebhook)
+	assert.True(t, ok)
+	assert.Equal(t, snowflake.ID(1), incoming.ID())
+	assert.Equal(t, "abc", incoming.Token)
+
+	follower, ok := webhooks[1].Webhook.(ChannelFollowerWebhook)
+	assert.True(t, ok)
+	assert.Equal(t, snowflake.ID(30), follower.SourceGuild.ID)
+
+	application, ok := webhooks[2].Webhook.(ApplicationWebhook)
+	assert.True(t, ok)
+	assert.Equal(t, snowflake.ID(50), application.ApplicationID)
+	assert.Nil(t, application.AvatarURL())
+
+	for _, webhook := range webhooks {
+		raw, err := json.Marshal(webhook.Webhook)
+		assert.NoError(t, err)
+
+		var v UnmarshalWebhook
+		assert.NoError(t, json.Unmarshal(raw, &v))
+		assert.Equal(t, webhook.Webhook, v.Webhook)
+	}
+}
@@ -16,6 +16,10 @@
 //
 // Package sharding is used to connect and interact with the Discord Gateway.
 //
+// Voice
+//
+// Package voice contains the opcodes and close codes of the Discord voice gateway.
+//
 // Cache
 //
 // Package cache provides a generic cache interface for Discord entities.
@@ -43,6 +47,42 @@
 // OAuth2
 //
 // Package oauth2 provides a high level client interface for interacting with Discord oauth2.
+//
+// AntiRaid
+//
+// Package antiraid provides an optional event listener which detects suspicious member join rates.
+//
+// AuditResolver
+//
+// Package auditresolver provides an optional event listener which resolves the moderator responsible for bans, kicks & channel deletions.
+//
+// Moderation
+//
+// Package moderation provides a helper which notifies users via direct message before banning or kicking them.
+//
+// Upload
+//
+// Package upload provides a helper which fetches images & sounds from a URL and uploads them as emojis, stickers or soundboard sounds.
+//
+// BotManager
+//
+// Package botmanager provides a manager which runs multiple bots with different tokens in one process and combines their events.
+//
+// BotConfig
+//
+// Package botconfig loads the intents, cache flags, sharding & presence of a bot from a JSON or YAML file and environment variables.
+//
+// DisgoTest
+//
+// Package disgotest provides golden file helpers to unit test the payloads your bot would send.
+//
+// Store
+//
+// Package store provides a shared key value store abstraction with TTL support.
+//
+// Clock
+//
+// Package clock provides the time source used by all packages, which can be replaced with a mock to advance time deterministically in tests.
 package disgo
 
 import (
@@ -98,6 +138,7 @@ func getOS() string {
 // New creates a new bot.Client with the provided token & bot.ConfigOpt(s)
 func New(token string, opts ...bot.ConfigOpt) (bot.Client, error) {
 	config := bot.DefaultConfig(handlers.GetGatewayHandlers(), handlers.GetHTTPServerHandler())
+	config.MemberChunkingDoneEventFunc = handlers.NewGuildMembersChunkingDone
 	config.Apply(opts)
 
 	return bot.BuildClient(token,
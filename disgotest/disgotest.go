@@ -0,0 +1,81 @@
+// Package disgotest provides helpers to unit test the payloads your bot would send to Discord using golden files.
+package disgotest
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/disgo/json"
+	"github.com/disgoorg/disgo/rest"
+)
+
+// UpdateEnv is the environment variable which makes AssertGoldenJSON (re)write the golden files instead of comparing against them.
+//
+//	DISGO_UPDATE_GOLDEN=1 go test ./...
+const UpdateEnv = "DISGO_UPDATE_GOLDEN"
+
+// GoldenDir is the directory golden files are read from and written to, relative to the package under test.
+var GoldenDir = "testdata"
+
+var _ events.InteractionResponderFunc = (*Responder)(nil).Respond
+
+// Responder records the responses instead of sending them to Discord.
+// Assign Responder.Respond to the Respond field of an interaction event before passing it to your handler.
+type Responder struct {
+	mu        sync.Mutex
+	responses []discord.InteractionResponse
+}
+
+// Respond records the discord.InteractionResponse.
+func (r *Responder) Respond(responseType discord.InteractionResponseType, data discord.InteractionResponseData, _ ...rest.RequestOpt) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.responses = append(r.responses, discord.InteractionResponse{
+		Type: responseType,
+		Data: data,
+	})
+	return nil
+}
+
+// Responses returns all recorded discord.InteractionResponse(s) in the order they were sent.
+func (r *Responder) Responses() []discord.InteractionResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]discord.InteractionResponse(nil), r.responses...)
+}
+
+// AssertGoldenJSON marshals v the same way it would be sent to Discord and compares it with the golden file GoldenDir/<name>.golden.json.
+// Set the UpdateEnv environment variable to create or update the golden file instead.
+func AssertGoldenJSON(t testing.TB, name string, v any) {
+	t.Helper()
+
+	actual, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		t.Fatalf("failed to marshal %s: %s", name, err)
+	}
+	actual = append(actual, '\n')
+
+	path := filepath.Join(GoldenDir, name+".golden.json")
+	if os.Getenv(UpdateEnv) != "" {
+		if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create golden file directory: %s", err)
+		}
+		if err = os.WriteFile(path, actual, 0644); err != nil {
+			t.Fatalf("failed to write golden file %s: %s", path, err)
+		}
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file %s: %s. run the tests with %s=1 to create it", path, err, UpdateEnv)
+	}
+	if !bytes.Equal(expected, actual) {
+		t.Errorf("%s does not match golden file %s\nexpected:\n%s\nactual:\n%s", name, path, expected, actual)
+	}
+}
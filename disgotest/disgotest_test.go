@@ -0,0 +1,23 @@
+package disgotest
+
+import (
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssertGoldenJSON(t *testing.T) {
+	responder := &Responder{}
+	_ = responder.Respond(discord.InteractionResponseTypeCreateMessage, discord.NewMessageCreateBuilder().
+		SetContent("pong").
+		AddEmbeds(discord.NewEmbedBuilder().SetTitle("Ping").SetColor(0x5865F2).Build()).
+		SetEphemeral(true).
+		Build(),
+	)
+
+	responses := responder.Responses()
+	assert.Len(t, responses, 1)
+	AssertGoldenJSON(t, "message_create_response", responses[0])
+}
@@ -0,0 +1,30 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventGuildID(t *testing.T) {
+	guildID := snowflake.ID(123)
+
+	id, ok := bot.EventGuildID(&GuildMessageCreate{GenericGuildMessage: &GenericGuildMessage{GuildID: guildID}})
+	assert.True(t, ok)
+	assert.Equal(t, guildID, id)
+
+	id, ok = bot.EventGuildID(&MessageCreate{GenericMessage: &GenericMessage{GuildID: &guildID}})
+	assert.True(t, ok)
+	assert.Equal(t, guildID, id)
+
+	_, ok = bot.EventGuildID(&MessageCreate{GenericMessage: &GenericMessage{}})
+	assert.False(t, ok)
+
+	_, ok = bot.EventGuildID(&GuildMessageCreate{})
+	assert.False(t, ok)
+
+	_, ok = bot.EventGuildID(&Ready{})
+	assert.False(t, ok)
+}
@@ -0,0 +1,217 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/json"
+)
+
+// ErrUnknownEventType is returned by Marshal and Unmarshal for events which are not registered via RegisterEventTypes.
+var ErrUnknownEventType = errors.New("unknown event type")
+
+var (
+	eventTypesMu sync.RWMutex
+	eventTypes   = map[string]reflect.Type{}
+
+	genericEventType = reflect.TypeOf((*GenericEvent)(nil))
+	channelType      = reflect.TypeOf((*discord.Channel)(nil)).Elem()
+	integrationType  = reflect.TypeOf((*discord.Integration)(nil)).Elem()
+)
+
+func init() {
+	RegisterEventTypes(
+		&DMChannelCreate{}, &DMChannelUpdate{}, &DMChannelDelete{}, &DMChannelPinsUpdate{}, &DMUserTypingStart{},
+		&DMMessageCreate{}, &DMMessageUpdate{}, &DMMessageDelete{},
+		&DMMessageReactionAdd{}, &DMMessageReactionRemove{}, &DMMessageReactionRemoveEmoji{}, &DMMessageReactionRemoveAll{},
+		&Ready{}, &Resumed{}, &GatewayReconnected{}, &GatewayEventsLost{}, &GatewayResumed{}, &ShardRebalance{}, &CacheReady{}, &UnknownGatewayEvent{},
+		&AutoModerationRuleCreate{}, &AutoModerationRuleUpdate{}, &AutoModerationRuleDelete{}, &AutoModerationActionExecution{},
+		&GuildChannelCreate{}, &GuildChannelUpdate{}, &GuildChannelDelete{}, &GuildChannelPinsUpdate{},
+		&EmojisUpdate{}, &EmojiCreate{}, &EmojiUpdate{}, &EmojiDelete{},
+		&GuildUpdate{}, &GuildAvailable{}, &GuildUnavailable{}, &GuildJoin{}, &GuildLeave{}, &GuildReady{}, &GuildsReady{}, &GuildBan{}, &GuildUnban{},
+		&IntegrationCreate{}, &IntegrationUpdate{}, &IntegrationDelete{}, &GuildIntegrationsUpdate{},
+		&GuildApplicationCommandPermissionsUpdate{},
+		&InviteCreate{}, &InviteDelete{},
+		&GuildMemberJoin{}, &GuildMemberUpdate{}, &GuildMemberLeave{}, &GuildMemberGatePassed{}, &GuildMemberTypingStart{}, &GuildMembersChunkingDone{},
+		&GuildMessageCreate{}, &GuildMessageUpdate{}, &GuildMessageDelete{},
+		&GuildMessageReactionAdd{}, &GuildMessageReactionRemove{}, &GuildMessageReactionRemoveEmoji{}, &GuildMessageReactionRemoveAll{},
+		&RoleCreate{}, &RoleUpdate{}, &RoleDelete{},
+		&GuildScheduledEventCreate{}, &GuildScheduledEventUpdate{}, &GuildScheduledEventDelete{},
+		&GuildScheduledEventUserAdd{}, &GuildScheduledEventUserRemove{},
+		&StageInstanceCreate{}, &StageInstanceUpdate{}, &StageInstanceDelete{},
+		&StickersUpdate{}, &StickerCreate{}, &StickerUpdate{}, &StickerDelete{},
+		&ThreadCreate{}, &ThreadUpdate{}, &ThreadDelete{}, &ThreadShow{}, &ThreadHide{},
+		&ThreadMemberAdd{}, &ThreadMemberUpdate{}, &ThreadMemberRemove{},
+		&GuildVoiceStateUpdate{}, &GuildVoiceJoin{}, &GuildVoiceMove{}, &GuildVoiceLeave{}, &GuildVoiceSelfServerStateUpdate{}, &GuildVoiceChannelStatusUpdate{},
+		&VoiceServerUpdate{}, &WebhooksUpdate{},
+		&MessageCreate{}, &MessageUpdate{}, &MessageDelete{},
+		&MessageReactionAdd{}, &MessageReactionRemove{}, &MessageReactionRemoveEmoji{}, &MessageReactionRemoveAll{},
+		&SelfUpdate{},
+		&UserActivityStart{}, &UserActivityUpdate{}, &UserActivityStop{},
+		&UserUpdate{}, &UserTypingStart{}, &UserStatusUpdate{}, &UserClientStatusUpdate{},
+	)
+}
+
+// RegisterEventTypes registers the given events so they can be used with Marshal and Unmarshal.
+// Events are identified by their type name, so pass pointers to zero values of your own event structs.
+// Interaction events, Raw, GatewayDisconnected & GatewayPayloadError are not registered by default as they can't be restored from JSON.
+func RegisterEventTypes(events ...bot.Event) {
+	eventTypesMu.Lock()
+	defer eventTypesMu.Unlock()
+	for _, event := range events {
+		t := reflect.TypeOf(event).Elem()
+		eventTypes[t.Name()] = t
+	}
+}
+
+type eventEnvelope struct {
+	Type           string          `json:"type"`
+	ShardID        int             `json:"shard_id"`
+	SequenceNumber int             `json:"sequence_number"`
+	Data           json.RawMessage `json:"data"`
+}
+
+// Marshal serializes the given event to JSON tagged with its type, so it can be persisted or sent to another process and restored with Unmarshal.
+func Marshal(event bot.Event) ([]byte, error) {
+	t := reflect.TypeOf(event)
+	if t.Kind() != reflect.Pointer {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEventType, t)
+	}
+	t = t.Elem()
+
+	eventTypesMu.RLock()
+	_, ok := eventTypes[t.Name()]
+	eventTypesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEventType, t.Name())
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	var shardID int
+	if e, ok := event.(interface{ ShardID() int }); ok {
+		shardID = e.ShardID()
+	}
+	return json.Marshal(eventEnvelope{
+		Type:           t.Name(),
+		ShardID:        shardID,
+		SequenceNumber: event.SequenceNumber(),
+		Data:           data,
+	})
+}
+
+// Unmarshal restores an event serialized with Marshal. The returned event is bound to the given bot.Client.
+func Unmarshal(client bot.Client, data []byte) (bot.Event, error) {
+	var envelope eventEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, err
+	}
+
+	eventTypesMu.RLock()
+	t, ok := eventTypes[envelope.Type]
+	eventTypesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEventType, envelope.Type)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(envelope.Data, &fields); err != nil {
+		return nil, err
+	}
+
+	v := reflect.New(t)
+	genericEvent := NewGenericEvent(client, envelope.SequenceNumber, envelope.ShardID)
+	interfaceFields := map[string]reflect.Value{}
+	prepareEvent(v.Elem(), genericEvent, interfaceFields)
+
+	// interface fields can't be decoded by encoding/json, so we decode them ourselves
+	for name := range interfaceFields {
+		raw, ok := fields[name]
+		delete(fields, name)
+		if !ok || string(raw) == "null" {
+			continue
+		}
+		if err := decodeInterface(interfaceFields[name], raw); err != nil {
+			return nil, fmt.Errorf("failed to decode field %s of %s: %w", name, envelope.Type, err)
+		}
+	}
+
+	rest, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(rest, v.Interface()); err != nil {
+		return nil, err
+	}
+	return v.Interface().(bot.Event), nil
+}
+
+// prepareEvent allocates all embedded structs, sets the GenericEvent and collects all interface fields by their json name.
+func prepareEvent(v reflect.Value, genericEvent *GenericEvent, interfaceFields map[string]reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fv := v.Field(i)
+
+		if field.Anonymous {
+			switch {
+			case field.Type == genericEventType:
+				fv.Set(reflect.ValueOf(genericEvent))
+			case field.Type.Kind() == reflect.Pointer && field.Type.Elem().Kind() == reflect.Struct:
+				fv.Set(reflect.New(field.Type.Elem()))
+				prepareEvent(fv.Elem(), genericEvent, interfaceFields)
+			case field.Type.Kind() == reflect.Struct:
+				prepareEvent(fv, genericEvent, interfaceFields)
+			}
+			continue
+		}
+
+		if field.Type.Kind() == reflect.Interface {
+			name := field.Name
+			if tag := strings.Split(field.Tag.Get("json"), ",")[0]; tag != "" {
+				name = tag
+			}
+			interfaceFields[name] = fv
+		}
+	}
+}
+
+func decodeInterface(v reflect.Value, data []byte) error {
+	var value any
+	switch {
+	case v.Type().Implements(channelType):
+		var channel discord.UnmarshalChannel
+		if err := json.Unmarshal(data, &channel); err != nil {
+			return err
+		}
+		value = channel.Channel
+
+	case v.Type() == integrationType:
+		var integration discord.UnmarshalIntegration
+		if err := json.Unmarshal(data, &integration); err != nil {
+			return err
+		}
+		value = integration.Integration
+
+	default:
+		return fmt.Errorf("unsupported interface type %s", v.Type())
+	}
+
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() || !rv.Type().AssignableTo(v.Type()) {
+		return fmt.Errorf("decoded %T is not assignable to %s", value, v.Type())
+	}
+	v.Set(rv)
+	return nil
+}
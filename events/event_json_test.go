@@ -0,0 +1,55 @@
+package events
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalUnmarshal(t *testing.T) {
+	channel := discord.GuildTextChannel{}
+	event := &GuildChannelCreate{
+		GenericGuildChannel: &GenericGuildChannel{
+			GenericEvent: NewGenericEvent(nil, 42, 1),
+			ChannelID:    snowflake.ID(123),
+			Channel:      channel,
+			GuildID:      snowflake.ID(456),
+		},
+	}
+
+	data, err := Marshal(event)
+	assert.NoError(t, err)
+
+	restored, err := Unmarshal(nil, data)
+	assert.NoError(t, err)
+
+	channelCreate, ok := restored.(*GuildChannelCreate)
+	assert.True(t, ok)
+	assert.Equal(t, 42, channelCreate.SequenceNumber())
+	assert.Equal(t, 1, channelCreate.ShardID())
+	assert.Equal(t, snowflake.ID(123), channelCreate.ChannelID)
+	assert.Equal(t, snowflake.ID(456), channelCreate.GuildID)
+	assert.Equal(t, channel, channelCreate.Channel)
+}
+
+func TestMarshalUnmarshalRegisteredTypes(t *testing.T) {
+	for name, eventType := range eventTypes {
+		v := reflect.New(eventType)
+		prepareEvent(v.Elem(), NewGenericEvent(nil, 1, 0), map[string]reflect.Value{})
+
+		data, err := Marshal(v.Interface().(bot.Event))
+		assert.NoError(t, err, name)
+
+		_, err = Unmarshal(nil, data)
+		assert.NoError(t, err, name)
+	}
+}
+
+func TestMarshalUnknownEventType(t *testing.T) {
+	_, err := Marshal(&InteractionCreate{})
+	assert.ErrorIs(t, err, ErrUnknownEventType)
+}
@@ -1,6 +1,9 @@
 package events
 
-import "github.com/disgoorg/disgo/gateway"
+import (
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/snowflake/v2"
+)
 
 // Ready indicates we received the Ready from the gateway.Gateway
 type Ready struct {
@@ -8,7 +11,63 @@ type Ready struct {
 	gateway.EventReady
 }
 
+// CacheReady indicates the bot.CacheWarmer pre-populated the cache.Caches of all guilds received in the Ready event.
+// It is only dispatched if cache warming is enabled.
+type CacheReady struct {
+	*GenericEvent
+	// GuildIDs are the IDs of the warmed guilds.
+	GuildIDs []snowflake.ID
+	// FailedGuildIDs are the IDs of the guilds which could not be warmed.
+	FailedGuildIDs []snowflake.ID
+	// Err is the error returned by the bot.CacheWarmer or nil if all guilds were warmed.
+	Err error
+}
+
 // Resumed indicates disgo resumed the gateway.Gateway
 type Resumed struct {
 	*GenericEvent
 }
+
+// GatewayDisconnected indicates the gateway.Gateway lost its connection to discord
+type GatewayDisconnected struct {
+	*GenericEvent
+	gateway.EventGatewayDisconnected
+}
+
+// GatewayReconnected indicates the gateway.Gateway identified with a new session after it was disconnected
+type GatewayReconnected struct {
+	*GenericEvent
+	gateway.EventGatewayReconnected
+}
+
+// GatewayEventsLost indicates the gateway.Gateway detected a gap in the received dispatch sequence numbers
+type GatewayEventsLost struct {
+	*GenericEvent
+	gateway.EventGatewayEventsLost
+}
+
+// GatewayPayloadError indicates the gateway.Gateway failed to decode a payload.
+// gateway.EventGatewayPayloadError holds the event type and raw payload, so it can be reported.
+type GatewayPayloadError struct {
+	*GenericEvent
+	gateway.EventGatewayPayloadError
+}
+
+// ShardRebalance indicates the sharding.ShardManager drained or re-sharded the shard with the ID of GenericEvent.ShardID.
+type ShardRebalance struct {
+	*GenericEvent
+	gateway.EventShardRebalance
+}
+
+// UnknownGatewayEvent indicates the gateway.Gateway received a dispatch event disgo does not support yet.
+// gateway.EventUnknown holds the original event type and raw payload.
+type UnknownGatewayEvent struct {
+	*GenericEvent
+	gateway.EventUnknown
+}
+
+// GatewayResumed indicates the gateway.Gateway resumed its session after it was disconnected
+type GatewayResumed struct {
+	*GenericEvent
+	gateway.EventGatewayResumed
+}
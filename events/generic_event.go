@@ -1,7 +1,10 @@
 package events
 
 import (
+	"context"
+
 	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/rest"
 )
 
 // NewGenericEvent constructs a new GenericEvent with the provided Client instance
@@ -30,3 +33,8 @@ func (e *GenericEvent) SequenceNumber() int {
 func (e *GenericEvent) ShardID() int {
 	return e.shardID
 }
+
+// Context returns a context.Context carrying the shard ID of the event. Pass it to REST calls via rest.WithCtx to attribute them to the shard.
+func (e *GenericEvent) Context() context.Context {
+	return rest.ContextWithShardID(context.Background(), e.shardID)
+}
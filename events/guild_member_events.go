@@ -25,6 +25,11 @@ type GuildMemberUpdate struct {
 	OldMember discord.Member
 }
 
+// GuildMemberGatePassed indicates that a pending discord.Member accepted the discord.MemberVerification of the discord.Guild and is no longer pending(requires gateway.IntentGuildMembers)
+type GuildMemberGatePassed struct {
+	*GenericGuildMember
+}
+
 // GuildMemberLeave indicates that a discord.Member left the discord.Guild
 type GuildMemberLeave struct {
 	*GenericEvent
@@ -47,3 +52,11 @@ type GuildMemberTypingStart struct {
 func (e GuildMemberTypingStart) Channel() (discord.GuildMessageChannel, bool) {
 	return e.Client().Caches().Channels().GetGuildMessageChannel(e.ChannelID)
 }
+
+// GuildMembersChunkingDone indicates that all members of a discord.Guild requested via bot.MemberChunkingManager.ChunkGuild were received and put into the member cache(requires gateway.IntentGuildMembers)
+type GuildMembersChunkingDone struct {
+	*GenericEvent
+	GuildID snowflake.ID
+	// MemberCount is the number of cached members of the discord.Guild after chunking.
+	MemberCount int
+}
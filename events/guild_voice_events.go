@@ -3,6 +3,7 @@ package events
 import (
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/snowflake/v2"
 )
 
 // GenericGuildVoiceState is called upon receiving GuildVoiceJoin , GuildVoiceMove , GuildVoiceLeave
@@ -29,12 +30,44 @@ type GuildVoiceMove struct {
 	OldVoiceState discord.VoiceState
 }
 
+// GuildVoiceSelfServerStateUpdate indicates that the bot got server muted/deafened or unmuted/undeafened(requires gateway.IntentsGuildVoiceStates)
+type GuildVoiceSelfServerStateUpdate struct {
+	*GenericGuildVoiceState
+	OldVoiceState discord.VoiceState
+}
+
+// MuteUpdated returns whether the server mute state of the bot changed.
+func (e *GuildVoiceSelfServerStateUpdate) MuteUpdated() bool {
+	return e.VoiceState.GuildMute != e.OldVoiceState.GuildMute
+}
+
+// DeafUpdated returns whether the server deaf state of the bot changed.
+func (e *GuildVoiceSelfServerStateUpdate) DeafUpdated() bool {
+	return e.VoiceState.GuildDeaf != e.OldVoiceState.GuildDeaf
+}
+
 // GuildVoiceLeave indicates that a discord.Member left a discord.Channel(requires gateway.IntentsGuildVoiceStates)
 type GuildVoiceLeave struct {
 	*GenericGuildVoiceState
 	OldVoiceState discord.VoiceState
 }
 
+// GuildVoiceChannelStatusUpdate indicates that the status of a discord.GuildVoiceChannel has changed
+type GuildVoiceChannelStatusUpdate struct {
+	*GenericEvent
+	ChannelID snowflake.ID
+	GuildID   snowflake.ID
+	// Status is nil if the status was cleared
+	Status    *string
+	OldStatus *string
+}
+
+// Channel returns the discord.GuildVoiceChannel the status was updated in.
+// This will only return cached channels!
+func (e *GuildVoiceChannelStatusUpdate) Channel() (discord.GuildVoiceChannel, bool) {
+	return e.Client().Caches().Channels().GetGuildVoiceChannel(e.ChannelID)
+}
+
 // VoiceServerUpdate indicates that a voice server the bot is connected to has been changed
 type VoiceServerUpdate struct {
 	*GenericEvent
@@ -1,10 +1,17 @@
 package events
 
 import (
+	"errors"
+	"net/http"
+
 	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/json"
 	"github.com/disgoorg/disgo/rest"
 )
 
+// jsonErrorCodeUnknownInteraction is the JSON error code discord returns when the interaction token expired.
+const jsonErrorCodeUnknownInteraction = 10062
+
 // InteractionResponderFunc is a function that can be used to respond to a discord.Interaction.
 type InteractionResponderFunc func(responseType discord.InteractionResponseType, data discord.InteractionResponseData, opts ...rest.RequestOpt) error
 
@@ -269,3 +276,33 @@ func (e *ModalSubmitInteractionCreate) UpdateMessage(messageUpdate discord.Messa
 func (e *ModalSubmitInteractionCreate) DeferUpdateMessage(opts ...rest.RequestOpt) error {
 	return e.Respond(discord.InteractionResponseTypeDeferredUpdateMessage, nil, opts...)
 }
+
+// InteractionResponseFailed indicates that responding to a discord.Interaction failed because of a rate limit or because the interaction token expired.
+// It is dispatched after the InteractionResponderFunc returned the error, so frameworks can implement fallback behavior like DMing the user in one place.
+type InteractionResponseFailed struct {
+	*GenericEvent
+	Interaction discord.Interaction
+	Response    discord.InteractionResponse
+	Err         error
+}
+
+// RateLimited returns whether the response failed because it hit a rate limit.
+func (e *InteractionResponseFailed) RateLimited() bool {
+	var restErr *rest.Error
+	return errors.As(e.Err, &restErr) && restErr.Response != nil && restErr.Response.StatusCode == http.StatusTooManyRequests
+}
+
+// Expired returns whether the response failed because the interaction token expired.
+func (e *InteractionResponseFailed) Expired() bool {
+	if errors.Is(e.Err, discord.ErrInteractionExpired) {
+		return true
+	}
+	var restErr *rest.Error
+	if !errors.As(e.Err, &restErr) || restErr.Response == nil || restErr.Response.StatusCode != http.StatusNotFound {
+		return false
+	}
+	var body struct {
+		Code int `json:"code"`
+	}
+	return json.Unmarshal(restErr.RsBody, &body) == nil && body.Code == jsonErrorCodeUnknownInteraction
+}
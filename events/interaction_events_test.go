@@ -0,0 +1,27 @@
+package events
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInteractionResponseFailed(t *testing.T) {
+	rateLimited := &InteractionResponseFailed{Err: rest.NewError(nil, nil, &http.Response{StatusCode: http.StatusTooManyRequests}, nil)}
+	assert.True(t, rateLimited.RateLimited())
+	assert.False(t, rateLimited.Expired())
+
+	expired := &InteractionResponseFailed{Err: rest.NewError(nil, nil, &http.Response{StatusCode: http.StatusNotFound}, []byte(`{"message":"Unknown interaction","code":10062}`))}
+	assert.False(t, expired.RateLimited())
+	assert.True(t, expired.Expired())
+
+	expired = &InteractionResponseFailed{Err: fmt.Errorf("failed to respond: %w", discord.ErrInteractionExpired)}
+	assert.True(t, expired.Expired())
+
+	notFound := &InteractionResponseFailed{Err: rest.NewError(nil, nil, &http.Response{StatusCode: http.StatusNotFound}, []byte(`{"message":"Unknown Channel","code":10003}`))}
+	assert.False(t, notFound.Expired())
+}
@@ -68,8 +68,16 @@ type ListenerAdapter struct {
 	OnStickerDelete  func(event *StickerDelete)
 
 	// gateway status Events
-	OnReady   func(event *Ready)
-	OnResumed func(event *Resumed)
+	OnReady               func(event *Ready)
+	OnResumed             func(event *Resumed)
+	OnGatewayDisconnected func(event *GatewayDisconnected)
+	OnGatewayReconnected  func(event *GatewayReconnected)
+	OnGatewayResumed      func(event *GatewayResumed)
+	OnGatewayEventsLost   func(event *GatewayEventsLost)
+	OnGatewayPayloadError func(event *GatewayPayloadError)
+	OnShardRebalance      func(event *ShardRebalance)
+	OnCacheReady          func(event *CacheReady)
+	OnUnknownGatewayEvent func(event *UnknownGatewayEvent)
 
 	// Guild Events
 	OnGuildJoin        func(event *GuildJoin)
@@ -91,6 +99,8 @@ type ListenerAdapter struct {
 	OnGuildMemberUpdate func(event *GuildMemberUpdate)
 	OnGuildMemberLeave  func(event *GuildMemberLeave)
 
+	OnGuildMemberGatePassed func(event *GuildMemberGatePassed)
+
 	// Guild Message Events
 	OnGuildMessageCreate func(event *GuildMessageCreate)
 	OnGuildMessageUpdate func(event *GuildMessageUpdate)
@@ -109,6 +119,10 @@ type ListenerAdapter struct {
 	OnGuildVoiceMove        func(event *GuildVoiceMove)
 	OnGuildVoiceLeave       func(event *GuildVoiceLeave)
 
+	OnGuildVoiceSelfServerStateUpdate func(event *GuildVoiceSelfServerStateUpdate)
+
+	OnGuildVoiceChannelStatusUpdate func(event *GuildVoiceChannelStatusUpdate)
+
 	// Guild StageInstance Events
 	OnStageInstanceCreate func(event *StageInstanceCreate)
 	OnStageInstanceUpdate func(event *StageInstanceUpdate)
@@ -349,6 +363,38 @@ func (l *ListenerAdapter) OnEvent(event bot.Event) {
 		if listener := l.OnResumed; listener != nil {
 			listener(e)
 		}
+	case *GatewayDisconnected:
+		if listener := l.OnGatewayDisconnected; listener != nil {
+			listener(e)
+		}
+	case *GatewayReconnected:
+		if listener := l.OnGatewayReconnected; listener != nil {
+			listener(e)
+		}
+	case *GatewayResumed:
+		if listener := l.OnGatewayResumed; listener != nil {
+			listener(e)
+		}
+	case *GatewayEventsLost:
+		if listener := l.OnGatewayEventsLost; listener != nil {
+			listener(e)
+		}
+	case *GatewayPayloadError:
+		if listener := l.OnGatewayPayloadError; listener != nil {
+			listener(e)
+		}
+	case *ShardRebalance:
+		if listener := l.OnShardRebalance; listener != nil {
+			listener(e)
+		}
+	case *CacheReady:
+		if listener := l.OnCacheReady; listener != nil {
+			listener(e)
+		}
+	case *UnknownGatewayEvent:
+		if listener := l.OnUnknownGatewayEvent; listener != nil {
+			listener(e)
+		}
 
 	// Guild Events
 	case *GuildJoin:
@@ -411,6 +457,10 @@ func (l *ListenerAdapter) OnEvent(event bot.Event) {
 		if listener := l.OnGuildMemberLeave; listener != nil {
 			listener(e)
 		}
+	case *GuildMemberGatePassed:
+		if listener := l.OnGuildMemberGatePassed; listener != nil {
+			listener(e)
+		}
 
 	// Guild Message Events
 	case *GuildMessageCreate:
@@ -449,6 +499,10 @@ func (l *ListenerAdapter) OnEvent(event bot.Event) {
 		if listener := l.OnVoiceServerUpdate; listener != nil {
 			listener(e)
 		}
+	case *GuildVoiceChannelStatusUpdate:
+		if listener := l.OnGuildVoiceChannelStatusUpdate; listener != nil {
+			listener(e)
+		}
 	case *GuildVoiceStateUpdate:
 		if listener := l.OnGuildVoiceStateUpdate; listener != nil {
 			listener(e)
@@ -465,6 +519,10 @@ func (l *ListenerAdapter) OnEvent(event bot.Event) {
 		if listener := l.OnGuildVoiceLeave; listener != nil {
 			listener(e)
 		}
+	case *GuildVoiceSelfServerStateUpdate:
+		if listener := l.OnGuildVoiceSelfServerStateUpdate; listener != nil {
+			listener(e)
+		}
 
 	// Guild StageInstance Events
 	case *StageInstanceCreate:
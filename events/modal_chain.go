@@ -0,0 +1,72 @@
+package events
+
+import (
+	"sync"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+)
+
+// modalChainPrefix is the first part of the discord.CustomID of all modals opened via a ModalChain.
+const modalChainPrefix = "modal_chain"
+
+var _ bot.EventListener = (*ModalChain)(nil)
+
+// NewModalChain returns a new ModalChain. It needs to be added as bot.EventListener to receive the ModalSubmitInteractionCreate events.
+func NewModalChain() *ModalChain {
+	return &ModalChain{
+		handlers: map[string]ModalChainHandler{},
+	}
+}
+
+// ModalChainHandler handles the ModalSubmitInteractionCreate of a modal opened via ModalChain.CreateModal.
+// The values are the ones passed to ModalChain.CreateModal. The handler is responsible for responding to the interaction, e.g. with a followup message.
+type ModalChainHandler func(e *ModalSubmitInteractionCreate, values []string)
+
+// ModalChain chains an application command with a modal. The command context is encoded into the discord.CustomID of the modal,
+// so the ModalSubmitInteractionCreate is routed back to the ModalChainHandler registered for the originating command, even after a restart.
+type ModalChain struct {
+	mu       sync.RWMutex
+	handlers map[string]ModalChainHandler
+}
+
+// Handle registers the ModalChainHandler for modals opened from the application command with the given name.
+// It is meant to be called next to the definition of the command.
+func (c *ModalChain) Handle(commandName string, handler ModalChainHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.handlers[commandName] = handler
+}
+
+// CreateModal responds to the ApplicationCommandInteractionCreate with the given modal. Its discord.CustomID is replaced with one
+// carrying the command name & the given values, which are passed to the ModalChainHandler on submit.
+// The values need to fit into a discord.CustomID, else discord.ErrCustomIDTooLong is returned.
+func (c *ModalChain) CreateModal(e *ApplicationCommandInteractionCreate, modalCreate discord.ModalCreate, values []string, opts ...rest.RequestOpt) error {
+	customID, err := discord.EncodeCustomID(append([]string{modalChainPrefix, e.Data.CommandName()}, values...)...)
+	if err != nil {
+		return err
+	}
+	modalCreate.CustomID = customID
+	return e.CreateModal(modalCreate, opts...)
+}
+
+func (c *ModalChain) OnEvent(event bot.Event) {
+	e, ok := event.(*ModalSubmitInteractionCreate)
+	if !ok {
+		return
+	}
+	parts := e.Data.CustomID.Decode()
+	if len(parts) < 2 || parts[0] != modalChainPrefix {
+		return
+	}
+
+	c.mu.RLock()
+	handler, ok := c.handlers[parts[1]]
+	c.mu.RUnlock()
+	if !ok {
+		e.Client().Logger().Debugf("no modal chain handler registered for command %s", parts[1])
+		return
+	}
+	handler(e, parts[2:])
+}
@@ -0,0 +1,30 @@
+package events
+
+import (
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+)
+
+// OwnerEvent is an event which was triggered by a discord.User, like interaction events.
+type OwnerEvent interface {
+	bot.Event
+	User() discord.User
+}
+
+// OwnerOnly wraps the given handler and only calls it when the user who triggered the event is an owner of the application (see bot.Client.IsOwner).
+// If the user is not an owner, denied is called instead, if set.
+//
+//	client.AddEventListeners(bot.NewListenerFunc(events.OwnerOnly(func(e *events.ApplicationCommandInteractionCreate) {
+//		// only reachable by owners
+//	}, nil)))
+func OwnerOnly[E OwnerEvent](handler func(e E), denied func(e E)) func(e E) {
+	return func(e E) {
+		if e.Client().IsOwner(e.User().ID) {
+			handler(e)
+			return
+		}
+		if denied != nil {
+			denied(e)
+		}
+	}
+}
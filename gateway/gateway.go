@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/disgoorg/log"
+	"github.com/disgoorg/snowflake/v2"
 )
 
 // Version defines which discord API version disgo should use to connect to discord.
@@ -43,7 +44,7 @@ const (
 	// StatusWaitingForReady is the state when the Gateway received sent a OpcodeIdentify or OpcodeResume packet and now waits for a OpcodeDispatch with EventTypeReady packet.
 	StatusWaitingForReady
 
-	// StatusReady is the state when the Gateway received a OpcodeDispatch with EventTypeReady packet.
+	// StatusReady is the state when the Gateway received a OpcodeDispatch with EventTypeReady or EventTypeResumed packet.
 	StatusReady
 
 	// StatusDisconnected is the state when the Gateway is disconnected.
@@ -62,6 +63,16 @@ type (
 	CloseHandlerFunc func(gateway Gateway, err error)
 )
 
+// SessionStartLimiter budgets the OpcodeIdentify commands a Gateway sends by the discord.SessionStartLimit.
+type SessionStartLimiter interface {
+	// UseSessionStart takes one identify from the discord.SessionStartLimit before the Gateway with the given shardID sends an OpcodeIdentify.
+	// It may wait for the limit to reset. If it returns an error, the Gateway closes without identifying.
+	UseSessionStart(ctx context.Context, shardID int) error
+
+	// RefundSessionStart gives back the identify taken by UseSessionStart if the OpcodeIdentify could not be sent.
+	RefundSessionStart(shardID int)
+}
+
 // Gateway is what is used to connect to discord.
 type Gateway interface {
 	// Logger returns the logger that is used by the Gateway.
@@ -81,6 +92,10 @@ type Gateway interface {
 	// This may be nil if the Gateway was never connected to Discord, was gracefully closed with websocket.CloseNormalClosure or websocket.CloseGoingAway.
 	LastSequenceReceived() *int
 
+	// ResumeURL returns the URL which is used to resume the session of this Gateway.
+	// This may be nil if the Gateway was never connected to Discord or the session was cleared.
+	ResumeURL() *string
+
 	// Intents returns the Intents that are used by this Gateway.
 	Intents() Intents
 
@@ -100,8 +115,22 @@ type Gateway interface {
 
 	// Send sends a message to the Discord gateway with the opCode and data.
 	// If context is deadline exceeds, the message sending will be aborted.
+	// Prefer the typed methods like UpdatePresence, as Send does not validate the data.
 	Send(ctx context.Context, op Opcode, data MessageData) error
 
+	// UpdatePresence validates and sends an OpcodePresenceUpdate command.
+	UpdatePresence(ctx context.Context, presence MessageDataPresenceUpdate) error
+
+	// UpdateVoiceState validates and sends an OpcodeVoiceStateUpdate command.
+	UpdateVoiceState(ctx context.Context, voiceState MessageDataVoiceStateUpdate) error
+
+	// RequestGuildMembers validates and sends an OpcodeRequestGuildMembers command.
+	// Either a query or user ids must be set. Requesting all members or presences requires the IntentGuildMembers and IntentGuildPresences respectively.
+	RequestGuildMembers(ctx context.Context, request MessageDataRequestGuildMembers) error
+
+	// RequestSoundboardSounds validates and sends an OpcodeRequestSoundboardSounds command for the given guilds.
+	RequestSoundboardSounds(ctx context.Context, guildIDs ...snowflake.ID) error
+
 	// Latency returns the latency of the Gateway.
 	// This is calculated by the time it takes to send a heartbeat and receive a heartbeat ack by discord.
 	Latency() time.Duration
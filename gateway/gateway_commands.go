@@ -0,0 +1,61 @@
+package gateway
+
+import (
+	"github.com/disgoorg/disgo/discord"
+)
+
+const (
+	requestGuildMembersMaxUserIDs = 100
+	requestGuildMembersMaxNonce   = 32
+)
+
+func (d MessageDataPresenceUpdate) validate() error {
+	switch d.Status {
+	case discord.OnlineStatusOnline, discord.OnlineStatusDND, discord.OnlineStatusIdle, discord.OnlineStatusInvisible, discord.OnlineStatusOffline:
+		return nil
+	default:
+		return discord.ErrInvalidOnlineStatus
+	}
+}
+
+func (d MessageDataVoiceStateUpdate) validate() error {
+	if d.GuildID == 0 {
+		return discord.ErrNoGuildID
+	}
+	return nil
+}
+
+func (d MessageDataRequestGuildMembers) validate(intents Intents) error {
+	if d.GuildID == 0 {
+		return discord.ErrNoGuildID
+	}
+	if (d.Query == nil) == (len(d.UserIDs) == 0) {
+		return discord.ErrInvalidGuildMembersFilter
+	}
+	if len(d.UserIDs) > requestGuildMembersMaxUserIDs {
+		return discord.ErrTooManyUserIDs
+	}
+	if len(d.Nonce) > requestGuildMembersMaxNonce {
+		return discord.ErrNonceTooLong
+	}
+	// requesting all members of a guild requires the GUILD_MEMBERS intent
+	if d.Query != nil && *d.Query == "" && (d.Limit == nil || *d.Limit == 0) && intents.Missing(IntentGuildMembers) {
+		return discord.ErrNoGuildMembersIntent
+	}
+	if d.Presences && intents.Missing(IntentGuildPresences) {
+		return discord.ErrNoGuildPresencesIntent
+	}
+	return nil
+}
+
+func (d MessageDataRequestSoundboardSounds) validate() error {
+	if len(d.GuildIDs) == 0 {
+		return discord.ErrNoGuildID
+	}
+	for _, guildID := range d.GuildIDs {
+		if guildID == 0 {
+			return discord.ErrNoGuildID
+		}
+	}
+	return nil
+}
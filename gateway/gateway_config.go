@@ -1,6 +1,8 @@
 package gateway
 
 import (
+	"context"
+
 	"github.com/disgoorg/log"
 	"github.com/gorilla/websocket"
 )
@@ -18,6 +20,7 @@ func DefaultConfig() *Config {
 		ShardCount:        1,
 		AutoReconnect:     true,
 		MaxReconnectTries: 10,
+		URLResolveAfter:   3,
 	}
 }
 
@@ -29,6 +32,10 @@ type Config struct {
 	Intents                   Intents
 	Compress                  bool
 	URL                       string
+	StaticURL                 bool
+	URLResolver               URLResolver
+	URLResolveAfter           int
+	ResumeURL                 *string
 	ShardID                   int
 	ShardCount                int
 	SessionID                 *string
@@ -42,6 +49,9 @@ type Config struct {
 	OS                        string
 	Browser                   string
 	Device                    string
+	ReidentifyOnEventsLost    bool
+	AsyncDecodeThreshold      int
+	SessionStartLimiter       SessionStartLimiter
 }
 
 // ConfigOpt is a type alias for a function that takes a Config and is used to configure your Server.
@@ -102,6 +112,40 @@ func WithURL(url string) ConfigOpt {
 	}
 }
 
+// WithStaticURL sets the Gateway URL for the Gateway and disables the use of the resume_gateway_url & the URLResolver.
+// This is useful for self-hosted gateway proxies or test servers.
+func WithStaticURL(url string) ConfigOpt {
+	return func(config *Config) {
+		config.URL = url
+		config.StaticURL = true
+	}
+}
+
+// URLResolver is used to discover a new Gateway URL, usually via the /gateway or /gateway/bot endpoint.
+type URLResolver func(ctx context.Context) (string, error)
+
+// WithURLResolver sets the URLResolver which is used to discover a new Gateway URL after repeated failed reconnect attempts.
+func WithURLResolver(resolver URLResolver) ConfigOpt {
+	return func(config *Config) {
+		config.URLResolver = resolver
+	}
+}
+
+// WithURLResolveAfter sets after how many failed reconnect attempts the URLResolver is used. By default, this is 3.
+func WithURLResolveAfter(failures int) ConfigOpt {
+	return func(config *Config) {
+		config.URLResolveAfter = failures
+	}
+}
+
+// WithResumeURL sets the URL used to resume the session of the Gateway.
+// If sessionID, lastSequence and resumeURL are present while connecting, the Gateway will try to resume the session via the resumeURL.
+func WithResumeURL(resumeURL string) ConfigOpt {
+	return func(config *Config) {
+		config.ResumeURL = &resumeURL
+	}
+}
+
 // WithShardID sets the shard ID for the Gateway.
 // See here for more information on sharding: https://discord.com/developers/docs/topics/gateway#sharding
 func WithShardID(shardID int) ConfigOpt {
@@ -199,3 +243,28 @@ func WithDevice(device string) ConfigOpt {
 		config.Device = device
 	}
 }
+
+// WithReidentifyOnEventsLost sets whether the Gateway should drop its session and identify again when it detects lost dispatch events.
+// This makes sure caches are rebuilt from a fresh EventTypeReady & EventTypeGuildCreate events.
+func WithReidentifyOnEventsLost(reidentify bool) ConfigOpt {
+	return func(config *Config) {
+		config.ReidentifyOnEventsLost = reidentify
+	}
+}
+
+// WithAsyncDecodeThreshold sets the payload size in bytes above which EventTypeGuildCreate events are decoded outside the gateway read loop.
+// Dispatch events are then handled by a separate goroutine in the order they were received, so heartbeats are not delayed by huge guilds.
+// 0 disables this.
+func WithAsyncDecodeThreshold(threshold int) ConfigOpt {
+	return func(config *Config) {
+		config.AsyncDecodeThreshold = threshold
+	}
+}
+
+// WithSessionStartLimiter sets the SessionStartLimiter which budgets the OpcodeIdentify commands of the Gateway.
+// Resuming a session doesn't use the budget. The sharding.ShardManager sets its sharding.RateLimiter here.
+func WithSessionStartLimiter(sessionStartLimiter SessionStartLimiter) ConfigOpt {
+	return func(config *Config) {
+		config.SessionStartLimiter = sessionStartLimiter
+	}
+}
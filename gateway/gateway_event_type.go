@@ -66,5 +66,24 @@ const (
 	EventTypeUserUpdate                          EventType = "USER_UPDATE"
 	EventTypeVoiceStateUpdate                    EventType = "VOICE_STATE_UPDATE"
 	EventTypeVoiceServerUpdate                   EventType = "VOICE_SERVER_UPDATE"
+	EventTypeVoiceChannelStatusUpdate            EventType = "VOICE_CHANNEL_STATUS_UPDATE"
 	EventTypeWebhooksUpdate                      EventType = "WEBHOOKS_UPDATE"
 )
+
+// Constants for the events dispatched by the Gateway itself
+const (
+	// EventTypeGatewayDisconnected is not a real event type, but is used to notify the bot.EventManager that the Gateway lost its connection
+	EventTypeGatewayDisconnected EventType = "__GATEWAY_DISCONNECTED__"
+	// EventTypeGatewayReconnected is not a real event type, but is used to notify the bot.EventManager that the Gateway identified with a new session after a disconnect
+	EventTypeGatewayReconnected EventType = "__GATEWAY_RECONNECTED__"
+	// EventTypeGatewayResumed is not a real event type, but is used to notify the bot.EventManager that the Gateway resumed its session after a disconnect
+	EventTypeGatewayResumed EventType = "__GATEWAY_RESUMED__"
+	// EventTypeGatewayEventsLost is not a real event type, but is used to notify the bot.EventManager that the Gateway detected a gap in the dispatch sequence numbers
+	EventTypeGatewayEventsLost EventType = "__GATEWAY_EVENTS_LOST__"
+	// EventTypeGatewayPayloadError is not a real event type, but is used to notify the bot.EventManager that the Gateway failed to decode a payload
+	EventTypeGatewayPayloadError EventType = "__GATEWAY_PAYLOAD_ERROR__"
+	// EventTypeShardRebalance is not a real event type, but is used to notify the bot.EventManager that a shard is drained or re-sharded by the sharding.ShardManager
+	EventTypeShardRebalance EventType = "__SHARD_REBALANCE__"
+	// EventTypeUnknown is not a real event type, but is used to notify the bot.EventManager about dispatch events disgo does not support yet
+	EventTypeUnknown EventType = "__UNKNOWN__"
+)
@@ -16,12 +16,13 @@ type EventData interface {
 
 // EventReady is the event sent by discord when you successfully Identify
 type EventReady struct {
-	Version     int                        `json:"v"`
-	User        discord.OAuth2User         `json:"user"`
-	Guilds      []discord.UnavailableGuild `json:"guilds"`
-	SessionID   string                     `json:"session_id"`
-	Shard       []int                      `json:"shard,omitempty"`
-	Application discord.PartialApplication `json:"application"`
+	Version          int                        `json:"v"`
+	User             discord.OAuth2User         `json:"user"`
+	Guilds           []discord.UnavailableGuild `json:"guilds"`
+	SessionID        string                     `json:"session_id"`
+	ResumeGatewayURL string                     `json:"resume_gateway_url"`
+	Shard            []int                      `json:"shard,omitempty"`
+	Application      discord.PartialApplication `json:"application"`
 }
 
 func (EventReady) messageData() {}
@@ -492,6 +493,15 @@ type EventVoiceServerUpdate struct {
 func (EventVoiceServerUpdate) messageData() {}
 func (EventVoiceServerUpdate) eventData()   {}
 
+type EventVoiceChannelStatusUpdate struct {
+	ID      snowflake.ID `json:"id"`
+	GuildID snowflake.ID `json:"guild_id"`
+	Status  *string      `json:"status"`
+}
+
+func (EventVoiceChannelStatusUpdate) messageData() {}
+func (EventVoiceChannelStatusUpdate) eventData()   {}
+
 type EventWebhooksUpdate struct {
 	GuildID   snowflake.ID `json:"guild_id"`
 	ChannelID snowflake.ID `json:"channel_id"`
@@ -604,3 +614,113 @@ type EventRaw struct {
 
 func (EventRaw) messageData() {}
 func (EventRaw) eventData()   {}
+
+// EventGatewayDisconnected is dispatched when the Gateway lost its connection to discord.
+type EventGatewayDisconnected struct {
+	// CloseCode is the close code discord sent or 0 if the connection was lost without one.
+	CloseCode CloseEventCode
+	// Err is the error which caused the disconnect if any.
+	Err error
+	// Reconnect indicates whether the Gateway will try to reconnect.
+	Reconnect bool
+	// Resume indicates whether the Gateway will try to resume the session when reconnecting.
+	Resume bool
+}
+
+func (EventGatewayDisconnected) messageData() {}
+func (EventGatewayDisconnected) eventData()   {}
+
+// EventGatewayReconnected is dispatched when the Gateway identified with a new session after it was disconnected.
+type EventGatewayReconnected struct {
+	// Downtime is the time between the disconnect and receiving the EventTypeReady event.
+	Downtime time.Duration
+}
+
+func (EventGatewayReconnected) messageData() {}
+func (EventGatewayReconnected) eventData()   {}
+
+// EventGatewayResumed is dispatched when the Gateway resumed its session after it was disconnected.
+type EventGatewayResumed struct {
+	// Downtime is the time between the disconnect and receiving the EventTypeResumed event.
+	Downtime time.Duration
+}
+
+func (EventGatewayResumed) messageData() {}
+func (EventGatewayResumed) eventData()   {}
+
+// EventGatewayEventsLost is dispatched when the Gateway received a dispatch with a sequence number higher than expected, meaning events in between were lost.
+type EventGatewayEventsLost struct {
+	// From is the first lost sequence number.
+	From int
+	// To is the last lost sequence number.
+	To int
+	// Reidentify indicates whether the Gateway drops its session and identifies again to resync.
+	Reidentify bool
+}
+
+func (EventGatewayEventsLost) messageData() {}
+func (EventGatewayEventsLost) eventData()   {}
+
+// EventGatewayPayloadError is dispatched when the Gateway failed to decode the data of a payload, e.g. because discord added new fields disgo can't handle yet.
+type EventGatewayPayloadError struct {
+	// Op is the opcode of the payload.
+	Op Opcode
+	// EventType is the type of the dispatch event. It is empty for non dispatch payloads.
+	EventType EventType
+	// Payload is the raw data of the payload.
+	Payload json.RawMessage
+	// Err is the error which occurred while decoding the payload.
+	Err error
+}
+
+func (EventGatewayPayloadError) messageData() {}
+func (EventGatewayPayloadError) eventData()   {}
+
+// ShardRebalanceState is the state of a shard while it is drained or re-sharded.
+type ShardRebalanceState int
+
+// All ShardRebalanceState(s)
+const (
+	// ShardRebalanceStateDraining means the shard was closed and its in-flight events are still being handled.
+	ShardRebalanceStateDraining ShardRebalanceState = iota + 1
+	// ShardRebalanceStateDrained means all in-flight events of the shard were handled and the shard can be opened again.
+	ShardRebalanceStateDrained
+	// ShardRebalanceStateResharded means the shard was split into multiple new shards because discord requires re-sharding.
+	ShardRebalanceStateResharded
+)
+
+func (s ShardRebalanceState) String() string {
+	switch s {
+	case ShardRebalanceStateDraining:
+		return "draining"
+	case ShardRebalanceStateDrained:
+		return "drained"
+	case ShardRebalanceStateResharded:
+		return "resharded"
+	default:
+		return "unknown"
+	}
+}
+
+// EventShardRebalance is dispatched by the sharding.ShardManager when a shard is drained or re-sharded.
+type EventShardRebalance struct {
+	State ShardRebalanceState
+	// ShardCount is the shard count of the shard. For ShardRebalanceStateResharded it is the new shard count.
+	ShardCount int
+	// NewShardIDs are the IDs of the shards which replace the shard. It is only set for ShardRebalanceStateResharded.
+	NewShardIDs []int
+}
+
+func (EventShardRebalance) messageData() {}
+func (EventShardRebalance) eventData()   {}
+
+// EventUnknown is dispatched for dispatch events disgo does not support yet, so new discord features are not silently dropped.
+type EventUnknown struct {
+	// EventType is the original type of the dispatch event.
+	EventType EventType
+	// Payload is the raw data of the dispatch event.
+	Payload json.RawMessage
+}
+
+func (EventUnknown) messageData() {}
+func (EventUnknown) eventData()   {}
@@ -15,6 +15,7 @@ import (
 	"github.com/disgoorg/disgo/internal/tokenhelper"
 	"github.com/disgoorg/disgo/json"
 	"github.com/disgoorg/log"
+	"github.com/disgoorg/snowflake/v2"
 
 	"github.com/gorilla/websocket"
 )
@@ -49,6 +50,9 @@ type gatewayImpl struct {
 	heartbeatInterval     time.Duration
 	lastHeartbeatSent     time.Time
 	lastHeartbeatReceived time.Time
+
+	// disconnectedAt is the start of the current outage and guarded by connMu
+	disconnectedAt time.Time
 }
 
 func (g *gatewayImpl) Logger() log.Logger {
@@ -71,6 +75,10 @@ func (g *gatewayImpl) LastSequenceReceived() *int {
 	return g.config.LastSequenceReceived
 }
 
+func (g *gatewayImpl) ResumeURL() *string {
+	return g.config.ResumeURL
+}
+
 func (g *gatewayImpl) Intents() Intents {
 	return g.config.Intents
 }
@@ -99,11 +107,12 @@ func (g *gatewayImpl) Open(ctx context.Context) error {
 	}
 	g.status = StatusConnecting
 
-	gatewayURL := fmt.Sprintf("%s?v=%d&encoding=json", g.config.URL, Version)
+	gatewayURL := fmt.Sprintf("%s?v=%d&encoding=json", g.url(), Version)
 	g.lastHeartbeatSent = time.Now().UTC()
 	conn, rs, err := g.config.Dialer.DialContext(ctx, gatewayURL, nil)
 	if err != nil {
-		g.Close(ctx)
+		// there is no connection to close yet, and Close would wait for the connMu we hold
+		g.stopHeartbeat()
 		body := "null"
 		if rs != nil && rs.Body != nil {
 			defer func() {
@@ -136,22 +145,42 @@ func (g *gatewayImpl) Open(ctx context.Context) error {
 	return nil
 }
 
+// url returns the resume URL if the session can be resumed, else the configured URL.
+func (g *gatewayImpl) url() string {
+	if !g.config.StaticURL && g.config.ResumeURL != nil && g.config.SessionID != nil && g.config.LastSequenceReceived != nil {
+		return *g.config.ResumeURL
+	}
+	return g.config.URL
+}
+
+// clearSession clears all data needed to resume the session.
+func (g *gatewayImpl) clearSession() {
+	g.config.SessionID = nil
+	g.config.LastSequenceReceived = nil
+	g.config.ResumeURL = nil
+}
+
 func (g *gatewayImpl) Close(ctx context.Context) {
-	g.CloseWithCode(ctx, websocket.CloseNormalClosure, "Shutting down")
+	g.CloseWithCode(ctx, int(CloseEventCodeNormalClosure), "Shutting down")
 }
 
-func (g *gatewayImpl) CloseWithCode(ctx context.Context, code int, message string) {
+// stopHeartbeat stops the heartbeat goroutine if it's running.
+func (g *gatewayImpl) stopHeartbeat() {
 	if g.heartbeatTicker != nil {
 		g.Logger().Debug(g.formatLogs("closing heartbeat goroutines..."))
 		g.heartbeatTicker.Stop()
 		g.heartbeatTicker = nil
 	}
+}
+
+func (g *gatewayImpl) CloseWithCode(ctx context.Context, code int, message string) {
+	g.stopHeartbeat()
 
 	g.connMu.Lock()
 	defer g.connMu.Unlock()
 	if g.conn != nil {
 		g.config.RateLimiter.Close(ctx)
-		g.Logger().Debug(g.formatLogsf("closing gateway connection with code: %d, message: %s", code, message))
+		g.Logger().Debug(g.formatLogsf("closing gateway connection with code: %s, message: %s", CloseEventCode(code), message))
 		if err := g.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(code, message)); err != nil && err != websocket.ErrCloseSent {
 			g.Logger().Debug(g.formatLogs("error writing close code. error: ", err))
 		}
@@ -159,9 +188,8 @@ func (g *gatewayImpl) CloseWithCode(ctx context.Context, code int, message strin
 		g.conn = nil
 
 		// clear resume data as we closed gracefully
-		if code == websocket.CloseNormalClosure || code == websocket.CloseGoingAway {
-			g.config.SessionID = nil
-			g.config.LastSequenceReceived = nil
+		if closeCode := CloseEventCode(code); closeCode == CloseEventCodeNormalClosure || closeCode == CloseEventCodeGoingAway {
+			g.clearSession()
 		}
 	}
 
@@ -184,10 +212,44 @@ func (g *gatewayImpl) Send(ctx context.Context, op Opcode, d MessageData) error
 	return g.send(ctx, websocket.TextMessage, data)
 }
 
+func (g *gatewayImpl) UpdatePresence(ctx context.Context, presence MessageDataPresenceUpdate) error {
+	if err := presence.validate(); err != nil {
+		return err
+	}
+	return g.Send(ctx, OpcodePresenceUpdate, presence)
+}
+
+func (g *gatewayImpl) UpdateVoiceState(ctx context.Context, voiceState MessageDataVoiceStateUpdate) error {
+	if err := voiceState.validate(); err != nil {
+		return err
+	}
+	return g.Send(ctx, OpcodeVoiceStateUpdate, voiceState)
+}
+
+func (g *gatewayImpl) RequestGuildMembers(ctx context.Context, request MessageDataRequestGuildMembers) error {
+	if err := request.validate(g.config.Intents); err != nil {
+		return err
+	}
+	return g.Send(ctx, OpcodeRequestGuildMembers, request)
+}
+
+func (g *gatewayImpl) RequestSoundboardSounds(ctx context.Context, guildIDs ...snowflake.ID) error {
+	request := MessageDataRequestSoundboardSounds{GuildIDs: guildIDs}
+	if err := request.validate(); err != nil {
+		return err
+	}
+	return g.Send(ctx, OpcodeRequestSoundboardSounds, request)
+}
+
 func (g *gatewayImpl) send(ctx context.Context, messageType int, data []byte) error {
+	return g.sendTo(ctx, nil, messageType, data)
+}
+
+// sendTo sends the data like send, but only if conn is still the current connection. A nil conn matches any connection.
+func (g *gatewayImpl) sendTo(ctx context.Context, conn *websocket.Conn, messageType int, data []byte) error {
 	g.connMu.Lock()
 	defer g.connMu.Unlock()
-	if g.conn == nil {
+	if g.conn == nil || conn != nil && g.conn != conn {
 		return discord.ErrShardNotConnected
 	}
 
@@ -196,7 +258,7 @@ func (g *gatewayImpl) send(ctx context.Context, messageType int, data []byte) er
 	}
 
 	defer g.config.RateLimiter.Unlock()
-	g.Logger().Trace(g.formatLogs("sending gateway command: ", string(data)))
+	g.Logger().Trace(g.formatLogs("sending gateway command: ", tokenhelper.Redact(string(data))))
 	return g.conn.WriteMessage(messageType, data)
 }
 
@@ -204,6 +266,64 @@ func (g *gatewayImpl) Latency() time.Duration {
 	return g.lastHeartbeatReceived.Sub(g.lastHeartbeatSent)
 }
 
+func (g *gatewayImpl) lastSequenceNumber() int {
+	if g.config.LastSequenceReceived == nil {
+		return 0
+	}
+	return *g.config.LastSequenceReceived
+}
+
+// disconnected dispatches an EventGatewayDisconnected once per outage. Further calls until the Gateway is connected again are ignored.
+func (g *gatewayImpl) disconnected(closeCode CloseEventCode, err error, reconnect bool) {
+	if dispatch := g.disconnectedEvent(closeCode, err, reconnect); dispatch != nil {
+		dispatch()
+	}
+}
+
+// disconnectedEvent marks the Gateway as disconnected and returns a func dispatching the EventGatewayDisconnected.
+// It returns nil if the Gateway was already disconnected.
+func (g *gatewayImpl) disconnectedEvent(closeCode CloseEventCode, err error, reconnect bool) func() {
+	g.connMu.Lock()
+	if !g.disconnectedAt.IsZero() {
+		g.connMu.Unlock()
+		return nil
+	}
+	g.disconnectedAt = time.Now()
+	g.connMu.Unlock()
+
+	event := EventGatewayDisconnected{
+		CloseCode: closeCode,
+		Err:       err,
+		Reconnect: reconnect,
+		Resume:    reconnect && g.config.SessionID != nil && g.config.LastSequenceReceived != nil,
+	}
+	sequenceNumber := g.lastSequenceNumber()
+	return func() {
+		g.eventHandlerFunc(EventTypeGatewayDisconnected, sequenceNumber, g.config.ShardID, event)
+	}
+}
+
+func (g *gatewayImpl) connected(eventType EventType) {
+	if eventType != EventTypeReady && eventType != EventTypeResumed {
+		return
+	}
+	g.connMu.Lock()
+	if g.disconnectedAt.IsZero() {
+		g.connMu.Unlock()
+		return
+	}
+	downtime := time.Since(g.disconnectedAt)
+	g.disconnectedAt = time.Time{}
+	g.connMu.Unlock()
+
+	switch eventType {
+	case EventTypeReady:
+		g.eventHandlerFunc(EventTypeGatewayReconnected, g.lastSequenceNumber(), g.config.ShardID, EventGatewayReconnected{Downtime: downtime})
+	case EventTypeResumed:
+		g.eventHandlerFunc(EventTypeGatewayResumed, g.lastSequenceNumber(), g.config.ShardID, EventGatewayResumed{Downtime: downtime})
+	}
+}
+
 func (g *gatewayImpl) reconnectTry(ctx context.Context, try int, delay time.Duration) error {
 	if try >= g.config.MaxReconnectTries-1 {
 		return fmt.Errorf("failed to reconnect. exceeded max reconnect tries of %d reached", g.config.MaxReconnectTries)
@@ -224,11 +344,33 @@ func (g *gatewayImpl) reconnectTry(ctx context.Context, try int, delay time.Dura
 		}
 		g.Logger().Error(g.formatLogs("failed to reconnect gateway. error: ", err))
 		g.status = StatusDisconnected
+		g.failover(ctx, try+1)
 		return g.reconnectTry(ctx, try+1, delay)
 	}
 	return nil
 }
 
+// failover falls back from a possibly stale resume URL to the configured URL and resolves a new URL after the configured number of failed attempts.
+func (g *gatewayImpl) failover(ctx context.Context, failures int) {
+	if g.config.StaticURL {
+		return
+	}
+	if g.config.ResumeURL != nil {
+		g.Logger().Debug(g.formatLogs("falling back from resume url to gateway url"))
+		g.config.ResumeURL = nil
+	}
+	if g.config.URLResolver == nil || failures != g.config.URLResolveAfter {
+		return
+	}
+	url, err := g.config.URLResolver(ctx)
+	if err != nil {
+		g.Logger().Error(g.formatLogs("failed to resolve gateway url. error: ", err))
+		return
+	}
+	g.Logger().Debug(g.formatLogs("resolved new gateway url: ", url))
+	g.config.URL = url
+}
+
 func (g *gatewayImpl) reconnect(ctx context.Context) {
 	err := g.reconnectTry(ctx, 0, time.Second)
 	if err != nil {
@@ -253,15 +395,46 @@ func (g *gatewayImpl) sendHeartbeat() {
 	defer cancel()
 	if err := g.Send(ctx, OpcodeHeartbeat, (*MessageDataHeartbeat)(g.config.LastSequenceReceived)); err != nil && err != discord.ErrShardNotConnected {
 		g.Logger().Error(g.formatLogs("failed to send heartbeat. error: ", err))
-		g.CloseWithCode(context.TODO(), websocket.CloseServiceRestart, "heartbeat timeout")
+		g.CloseWithCode(context.TODO(), int(CloseEventCodeServiceRestart), "heartbeat timeout")
+		g.disconnected(CloseEventCodeServiceRestart, err, true)
 		go g.reconnect(context.TODO())
 		return
 	}
 	g.lastHeartbeatSent = time.Now().UTC()
 }
 
-func (g *gatewayImpl) identify() {
+func (g *gatewayImpl) identify(conn *websocket.Conn) {
 	g.status = StatusIdentifying
+	if g.config.SessionStartLimiter == nil {
+		_ = g.sendIdentify(conn)
+		return
+	}
+
+	// taking an identify from the session start limit might wait for its reset, which must not block reading the heartbeat acks
+	go func() {
+		if err := g.config.SessionStartLimiter.UseSessionStart(context.TODO(), g.ShardID()); err != nil {
+			g.Logger().Error(g.formatLogs("failed to take identify from session start limit: ", err))
+			g.connMu.Lock()
+			sameConnection := g.conn == conn
+			g.connMu.Unlock()
+			if !sameConnection {
+				return
+			}
+			g.Close(context.TODO())
+			g.disconnected(CloseEventCodeNormalClosure, err, false)
+			if g.closeHandlerFunc != nil {
+				go g.closeHandlerFunc(g, err)
+			}
+			return
+		}
+		if err := g.sendIdentify(conn); err != nil {
+			g.config.SessionStartLimiter.RefundSessionStart(g.ShardID())
+		}
+	}()
+}
+
+// sendIdentify sends the OpcodeIdentify on the given connection.
+func (g *gatewayImpl) sendIdentify(conn *websocket.Conn) error {
 	g.Logger().Debug(g.formatLogs("sending Identify command..."))
 
 	identify := MessageDataIdentify{
@@ -280,10 +453,19 @@ func (g *gatewayImpl) identify() {
 		identify.Shard = &[2]int{g.ShardID(), g.ShardCount()}
 	}
 
-	if err := g.Send(context.TODO(), OpcodeIdentify, identify); err != nil {
+	data, err := json.Marshal(Message{
+		Op: OpcodeIdentify,
+		D:  identify,
+	})
+	if err == nil {
+		err = g.sendTo(context.TODO(), conn, websocket.TextMessage, data)
+	}
+	if err != nil {
 		g.Logger().Error(g.formatLogs("error sending Identify command err: ", err))
+		return err
 	}
 	g.status = StatusWaitingForReady
+	return nil
 }
 
 func (g *gatewayImpl) resume() {
@@ -302,6 +484,36 @@ func (g *gatewayImpl) resume() {
 
 func (g *gatewayImpl) listen(conn *websocket.Conn) {
 	defer g.Logger().Debug(g.formatLogs("exiting listen goroutine..."))
+
+	var dispatchQueue chan func()
+	if g.config.AsyncDecodeThreshold > 0 {
+		dispatchQueue = make(chan func(), 64)
+		defer close(dispatchQueue)
+		go func() {
+			for dispatch := range dispatchQueue {
+				dispatch()
+			}
+		}()
+	}
+	dispatch := func(f func()) {
+		if dispatchQueue == nil {
+			f()
+			return
+		}
+		dispatchQueue <- f
+	}
+	// disconnected dispatches the EventGatewayDisconnected after all queued events, and only then reconnects or calls the CloseHandlerFunc,
+	// so the events of the next connection can't overtake it
+	disconnected := func(closeCode CloseEventCode, err error, reconnect bool, then func()) {
+		if event := g.disconnectedEvent(closeCode, err, reconnect); event != nil {
+			dispatch(event)
+		}
+		dispatch(then)
+	}
+	reconnect := func() {
+		go g.reconnect(context.TODO())
+	}
+
 loop:
 	for {
 		mt, reader, err := conn.NextReader()
@@ -315,10 +527,11 @@ loop:
 				return
 			}
 
-			reconnect := true
+			var closeCode CloseEventCode
+			shouldReconnect := true
 			if closeError, ok := err.(*websocket.CloseError); ok {
-				closeCode := CloseEventCode(closeError.Code)
-				reconnect = closeCode.ShouldReconnect()
+				closeCode = CloseEventCode(closeError.Code)
+				shouldReconnect = closeCode.ShouldReconnect()
 
 				if closeCode == CloseEventCodeDisallowedIntents {
 					var intentsURL string
@@ -330,25 +543,26 @@ loop:
 					g.Logger().Error(g.formatLogsf("disallowed gateway intents supplied. go to %s and enable the privileged intent for your application. intents: %d", intentsURL, g.config.Intents))
 				} else if closeCode == CloseEventCodeInvalidSeq {
 					g.Logger().Error(g.formatLogs("invalid sequence provided. reconnecting..."))
-					g.config.LastSequenceReceived = nil
-					g.config.SessionID = nil
+					g.clearSession()
 				} else {
-					g.Logger().Error(g.formatLogsf("gateway close received, reconnect: %t, code: %d, error: %s", g.config.AutoReconnect && reconnect, closeError.Code, closeError.Text))
+					g.Logger().Error(g.formatLogsf("gateway close received, reconnect: %t, code: %s, error: %s", g.config.AutoReconnect && shouldReconnect, closeCode, closeError.Text))
 				}
 			} else if errors.Is(err, net.ErrClosed) {
 				// we closed the connection ourselves. Don't try to reconnect here
-				reconnect = false
+				shouldReconnect = false
 			} else {
 				g.Logger().Debug(g.formatLogs("failed to read next message from gateway. error: ", err))
 			}
 
-			if g.config.AutoReconnect && reconnect {
-				go g.reconnect(context.TODO())
+			if g.config.AutoReconnect && shouldReconnect {
+				disconnected(closeCode, err, true, reconnect)
 			} else {
+				disconnected(closeCode, err, false, func() {
+					if g.closeHandlerFunc != nil {
+						go g.closeHandlerFunc(g, err)
+					}
+				})
 				g.Close(context.TODO())
-				if g.closeHandlerFunc != nil {
-					go g.closeHandlerFunc(g, err)
-				}
 			}
 			break loop
 		}
@@ -356,6 +570,16 @@ loop:
 		event, err := g.parseMessage(mt, reader)
 		if err != nil {
 			g.Logger().Error(g.formatLogs("error while parsing gateway event. error: ", err))
+			var payloadErr *PayloadError
+			if errors.As(err, &payloadErr) {
+				if payloadErr.Op == OpcodeDispatch {
+					// we still received the event, so don't report it as lost later
+					g.config.LastSequenceReceived = &payloadErr.S
+				}
+				dispatch(func() {
+					g.payloadError(payloadErr.Op, payloadErr.S, payloadErr.T, payloadErr.Payload, payloadErr.Err)
+				})
+			}
 			continue
 		}
 
@@ -367,13 +591,31 @@ loop:
 			g.heartbeatInterval = time.Duration(event.D.(MessageDataHello).HeartbeatInterval) * time.Millisecond
 
 			if g.config.LastSequenceReceived == nil || g.config.SessionID == nil {
-				g.identify()
+				g.identify(conn)
 			} else {
 				g.resume()
 			}
 
 		case OpcodeDispatch:
-			g.Logger().Trace(g.formatLogsf("received: OpcodeDispatch %s, data: %s", event.T, string(event.RawD)))
+			g.Logger().Trace(g.formatLogsf("received: OpcodeDispatch %s, data: %s", event.T, tokenhelper.Redact(string(event.RawD))))
+
+			if last := g.config.LastSequenceReceived; last != nil && event.S > *last+1 {
+				g.Logger().Warn(g.formatLogsf("detected lost events with sequence numbers %d to %d", *last+1, event.S-1))
+				eventsLost := EventGatewayEventsLost{
+					From:       *last + 1,
+					To:         event.S - 1,
+					Reidentify: g.config.ReidentifyOnEventsLost,
+				}
+				dispatch(func() {
+					g.eventHandlerFunc(EventTypeGatewayEventsLost, event.S, g.config.ShardID, eventsLost)
+				})
+				if g.config.ReidentifyOnEventsLost {
+					// closing normally clears the session, so we identify again on reconnect
+					g.CloseWithCode(context.TODO(), int(CloseEventCodeNormalClosure), "events lost")
+					disconnected(CloseEventCodeNormalClosure, nil, true, reconnect)
+					break loop
+				}
+			}
 
 			// set last sequence received
 			g.config.LastSequenceReceived = &event.S
@@ -381,18 +623,45 @@ loop:
 			// get session id here
 			if readyEvent, ok := event.D.(EventReady); ok {
 				g.config.SessionID = &readyEvent.SessionID
+				if readyEvent.ResumeGatewayURL != "" {
+					g.config.ResumeURL = &readyEvent.ResumeGatewayURL
+				}
 				g.status = StatusReady
 				g.Logger().Debug(g.formatLogs("ready event received"))
+			} else if event.T == EventTypeResumed {
+				g.status = StatusReady
+				g.Logger().Debug(g.formatLogs("resumed event received"))
 			}
 
-			// push event to the command manager
-			if g.config.EnableRawEvents {
-				g.eventHandlerFunc(EventTypeRaw, event.S, g.config.ShardID, EventRaw{
-					EventType: event.T,
-					Payload:   bytes.NewReader(event.RawD),
-				})
-			}
-			g.eventHandlerFunc(event.T, event.S, g.config.ShardID, event.D.(EventData))
+			dispatch(func() {
+				// the reconnected & resumed events follow the ready & resumed events on the same goroutine
+				defer g.connected(event.T)
+
+				eventData, ok := event.D.(EventData)
+				if !ok {
+					// decoding of large payloads is deferred to here
+					var err error
+					if eventData, err = UnmarshalEventData(event.RawD, event.T); err != nil {
+						g.Logger().Error(g.formatLogsf("error while decoding %s event. error: %s", event.T, err))
+						g.payloadError(event.Op, event.S, event.T, event.RawD, err)
+						return
+					}
+				}
+
+				// push event to the command manager
+				if g.config.EnableRawEvents {
+					g.eventHandlerFunc(EventTypeRaw, event.S, g.config.ShardID, EventRaw{
+						EventType: event.T,
+						Payload:   bytes.NewReader(event.RawD),
+					})
+				}
+				eventType := event.T
+				if _, ok = eventData.(EventUnknown); ok {
+					g.Logger().Debug(g.formatLogsf("received unknown dispatch event %s", event.T))
+					eventType = EventTypeUnknown
+				}
+				g.eventHandlerFunc(eventType, event.S, g.config.ShardID, eventData)
+			})
 
 		case OpcodeHeartbeat:
 			g.Logger().Debug(g.formatLogs("received: OpcodeHeartbeat"))
@@ -400,25 +669,24 @@ loop:
 
 		case OpcodeReconnect:
 			g.Logger().Debug(g.formatLogs("received: OpcodeReconnect"))
-			g.CloseWithCode(context.TODO(), websocket.CloseServiceRestart, "received reconnect")
-			go g.reconnect(context.TODO())
+			g.CloseWithCode(context.TODO(), int(CloseEventCodeServiceRestart), "received reconnect")
+			disconnected(CloseEventCodeServiceRestart, nil, true, reconnect)
 			break loop
 
 		case OpcodeInvalidSession:
 			canResume := event.D.(MessageDataInvalidSession)
 			g.Logger().Debug(g.formatLogs("received: OpcodeInvalidSession, canResume: ", canResume))
 
-			code := websocket.CloseNormalClosure
+			code := CloseEventCodeNormalClosure
 			if canResume {
-				code = websocket.CloseServiceRestart
+				code = CloseEventCodeServiceRestart
 			} else {
 				// clear resume info
-				g.config.SessionID = nil
-				g.config.LastSequenceReceived = nil
+				g.clearSession()
 			}
 
-			g.CloseWithCode(context.TODO(), code, "invalid session")
-			go g.reconnect(context.TODO())
+			g.CloseWithCode(context.TODO(), int(code), "invalid session")
+			disconnected(code, nil, true, reconnect)
 			break loop
 
 		case OpcodeHeartbeatACK:
@@ -428,6 +696,15 @@ loop:
 	}
 }
 
+func (g *gatewayImpl) payloadError(op Opcode, sequenceNumber int, eventType EventType, payload json.RawMessage, err error) {
+	g.eventHandlerFunc(EventTypeGatewayPayloadError, sequenceNumber, g.config.ShardID, EventGatewayPayloadError{
+		Op:        op,
+		EventType: eventType,
+		Payload:   payload,
+		Err:       err,
+	})
+}
+
 func (g *gatewayImpl) parseMessage(mt int, reader io.Reader) (Message, error) {
 	var readCloser io.ReadCloser
 	if mt == websocket.BinaryMessage {
@@ -444,6 +721,22 @@ func (g *gatewayImpl) parseMessage(mt int, reader io.Reader) (Message, error) {
 		_ = readCloser.Close()
 	}()
 
+	if g.config.AsyncDecodeThreshold > 0 {
+		data, err := io.ReadAll(readCloser)
+		if err != nil {
+			return Message{}, fmt.Errorf("failed to read websocket message: %w", err)
+		}
+		if len(data) > g.config.AsyncDecodeThreshold {
+			return parseLargeMessage(data)
+		}
+		var message Message
+		if err = json.Unmarshal(data, &message); err != nil {
+			g.Logger().Error(g.formatLogs("error decoding websocket message: ", err))
+			return Message{}, err
+		}
+		return message, nil
+	}
+
 	var message Message
 	if err := json.NewDecoder(readCloser).Decode(&message); err != nil {
 		g.Logger().Error(g.formatLogs("error decoding websocket message: ", err))
@@ -451,3 +744,27 @@ func (g *gatewayImpl) parseMessage(mt int, reader io.Reader) (Message, error) {
 	}
 	return message, nil
 }
+
+// parseLargeMessage only decodes the envelope of EventTypeGuildCreate messages and leaves Message.D unset, so the event data can be decoded outside the read loop.
+func parseLargeMessage(data []byte) (Message, error) {
+	var v struct {
+		Op Opcode          `json:"op"`
+		S  int             `json:"s,omitempty"`
+		T  EventType       `json:"t,omitempty"`
+		D  json.RawMessage `json:"d"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return Message{}, err
+	}
+	if v.Op != OpcodeDispatch || v.T != EventTypeGuildCreate {
+		var message Message
+		err := json.Unmarshal(data, &message)
+		return message, err
+	}
+	return Message{
+		Op:   v.Op,
+		S:    v.S,
+		T:    v.T,
+		RawD: v.D,
+	}, nil
+}
@@ -0,0 +1,364 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/json"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+// testServer is a websocket server which hands every gateway connection to the test.
+type testServer struct {
+	*httptest.Server
+	conns chan *websocket.Conn
+}
+
+func newTestServer(t *testing.T) *testServer {
+	s := &testServer{conns: make(chan *websocket.Conn, 4)}
+	upgrader := websocket.Upgrader{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		s.conns <- conn
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testServer) url() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+// accept returns the next gateway connection.
+func (s *testServer) accept(t *testing.T) *websocket.Conn {
+	select {
+	case conn := <-s.conns:
+		t.Cleanup(func() {
+			_ = conn.Close()
+		})
+		return conn
+	case <-time.After(time.Second):
+		t.Fatal("gateway did not connect")
+		return nil
+	}
+}
+
+// write sends the given opcode with data to the gateway.
+func write(t *testing.T, conn *websocket.Conn, op Opcode, d any) {
+	data, err := json.Marshal(map[string]any{"op": op, "d": d})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = conn.WriteMessage(websocket.TextMessage, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// writeDispatch sends a dispatch event with the given sequence number to the gateway.
+func writeDispatch(t *testing.T, conn *websocket.Conn, s int, eventType EventType, d any) {
+	data, err := json.Marshal(map[string]any{"op": OpcodeDispatch, "s": s, "t": eventType, "d": d})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = conn.WriteMessage(websocket.TextMessage, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// read returns the opcode of the next command the gateway sent.
+func read(t *testing.T, conn *websocket.Conn) Opcode {
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	var message struct {
+		Op Opcode `json:"op"`
+	}
+	if err := conn.ReadJSON(&message); err != nil {
+		t.Fatal(err)
+	}
+	return message.Op
+}
+
+func hello() map[string]any {
+	return map[string]any{"heartbeat_interval": 45000}
+}
+
+type testSessionStartLimiter struct {
+	mu       sync.Mutex
+	err      error
+	used     int
+	refunded int
+}
+
+func (l *testSessionStartLimiter) UseSessionStart(_ context.Context, _ int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.err != nil {
+		return l.err
+	}
+	l.used++
+	return nil
+}
+
+func (l *testSessionStartLimiter) RefundSessionStart(_ int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refunded++
+}
+
+func (l *testSessionStartLimiter) counts() (int, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.used, l.refunded
+}
+
+func newTestGateway(s *testServer, eventHandlerFunc EventHandlerFunc, opts ...ConfigOpt) Gateway {
+	if eventHandlerFunc == nil {
+		eventHandlerFunc = func(EventType, int, int, EventData) {}
+	}
+	return New("token", eventHandlerFunc, nil, append([]ConfigOpt{WithStaticURL(s.url()), WithCompress(false), WithAutoReconnect(false)}, opts...)...)
+}
+
+func TestGateway_IdentifyUsesSessionStart(t *testing.T) {
+	s := newTestServer(t)
+	limiter := &testSessionStartLimiter{}
+	g := newTestGateway(s, nil, WithSessionStartLimiter(limiter))
+	assert.NoError(t, g.Open(context.Background()))
+	defer g.Close(context.Background())
+
+	conn := s.accept(t)
+	write(t, conn, OpcodeHello, hello())
+	assert.Equal(t, OpcodeIdentify, read(t, conn))
+	used, refunded := limiter.counts()
+	assert.Equal(t, 1, used)
+	assert.Equal(t, 0, refunded)
+}
+
+func TestGateway_ResumeDoesNotUseSessionStart(t *testing.T) {
+	s := newTestServer(t)
+	limiter := &testSessionStartLimiter{}
+	g := newTestGateway(s, nil, WithSessionStartLimiter(limiter), WithSessionID("session"), WithSequence(10))
+	assert.NoError(t, g.Open(context.Background()))
+	defer g.Close(context.Background())
+
+	conn := s.accept(t)
+	write(t, conn, OpcodeHello, hello())
+	assert.Equal(t, OpcodeResume, read(t, conn))
+	used, _ := limiter.counts()
+	assert.Equal(t, 0, used)
+}
+
+func TestGateway_IdentifyRefusedBySessionStart(t *testing.T) {
+	s := newTestServer(t)
+	limiter := &testSessionStartLimiter{err: assert.AnError}
+	closed := make(chan error, 1)
+	g := New("token", func(EventType, int, int, EventData) {}, func(_ Gateway, err error) {
+		closed <- err
+	}, WithStaticURL(s.url()), WithCompress(false), WithSessionStartLimiter(limiter))
+	assert.NoError(t, g.Open(context.Background()))
+
+	conn := s.accept(t)
+	write(t, conn, OpcodeHello, hello())
+	select {
+	case err := <-closed:
+		assert.ErrorIs(t, err, assert.AnError)
+	case <-time.After(time.Second):
+		t.Fatal("gateway was not closed")
+	}
+	_, refunded := limiter.counts()
+	assert.Equal(t, 0, refunded)
+}
+
+func TestGateway_IdentifyRefundedOnSendError(t *testing.T) {
+	s := newTestServer(t)
+	limiter := &testSessionStartLimiter{}
+	g := newTestGateway(s, nil, WithSessionStartLimiter(limiter))
+	impl := g.(*gatewayImpl)
+	assert.NoError(t, g.Open(context.Background()))
+
+	impl.connMu.Lock()
+	conn := impl.conn
+	impl.connMu.Unlock()
+	g.Close(context.Background())
+
+	// the connection was replaced, so the identify can't be sent anymore
+	impl.identify(conn)
+	assert.Eventually(t, func() bool {
+		used, refunded := limiter.counts()
+		return used == 1 && refunded == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestGateway_ReadyAfterResumed(t *testing.T) {
+	s := newTestServer(t)
+	g := newTestGateway(s, nil, WithSessionID("session"), WithSequence(10))
+	assert.NoError(t, g.Open(context.Background()))
+	defer g.Close(context.Background())
+
+	conn := s.accept(t)
+	write(t, conn, OpcodeHello, hello())
+	assert.Equal(t, OpcodeResume, read(t, conn))
+	assert.Equal(t, StatusResuming, g.Status())
+
+	writeDispatch(t, conn, 11, EventTypeResumed, map[string]any{})
+	assert.Eventually(t, func() bool {
+		return g.Status() == StatusReady
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestGateway_DisconnectedDispatchedInOrder(t *testing.T) {
+	s := newTestServer(t)
+	var (
+		mu         sync.Mutex
+		eventTypes []EventType
+	)
+	release := make(chan struct{})
+	g := newTestGateway(s, func(eventType EventType, _ int, _ int, _ EventData) {
+		if eventType == EventTypeUnknown {
+			// the read loop keeps going while this event is handled
+			<-release
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		eventTypes = append(eventTypes, eventType)
+	}, WithAsyncDecodeThreshold(1024))
+	assert.NoError(t, g.Open(context.Background()))
+	defer g.Close(context.Background())
+
+	conn := s.accept(t)
+	write(t, conn, OpcodeHello, hello())
+	assert.Equal(t, OpcodeIdentify, read(t, conn))
+	writeDispatch(t, conn, 1, "UNKNOWN_EVENT", map[string]any{})
+	write(t, conn, OpcodeReconnect, nil)
+
+	// the gateway reconnects only once the queued events were dispatched
+	select {
+	case <-s.conns:
+		t.Fatal("gateway reconnected before the queued events were dispatched")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	s.accept(t)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []EventType{EventTypeUnknown, EventTypeGatewayDisconnected}, eventTypes)
+}
+
+func TestGateway_EventsLost(t *testing.T) {
+	s := newTestServer(t)
+	eventsLost := make(chan EventGatewayEventsLost, 1)
+	g := newTestGateway(s, func(eventType EventType, _ int, _ int, event EventData) {
+		if eventType == EventTypeGatewayEventsLost {
+			eventsLost <- event.(EventGatewayEventsLost)
+		}
+	})
+	assert.NoError(t, g.Open(context.Background()))
+	defer g.Close(context.Background())
+
+	conn := s.accept(t)
+	write(t, conn, OpcodeHello, hello())
+	assert.Equal(t, OpcodeIdentify, read(t, conn))
+	writeDispatch(t, conn, 1, "UNKNOWN_EVENT", map[string]any{})
+	writeDispatch(t, conn, 4, "UNKNOWN_EVENT", map[string]any{})
+
+	select {
+	case event := <-eventsLost:
+		assert.Equal(t, EventGatewayEventsLost{From: 2, To: 3}, event)
+	case <-time.After(time.Second):
+		t.Fatal("EventGatewayEventsLost was not dispatched")
+	}
+	// the gateway keeps its session
+	assert.Eventually(t, func() bool {
+		sequence := g.LastSequenceReceived()
+		return sequence != nil && *sequence == 4
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestGateway_ReidentifyOnEventsLost(t *testing.T) {
+	s := newTestServer(t)
+	eventsLost := make(chan EventGatewayEventsLost, 1)
+	g := newTestGateway(s, func(eventType EventType, _ int, _ int, event EventData) {
+		if eventType == EventTypeGatewayEventsLost {
+			eventsLost <- event.(EventGatewayEventsLost)
+		}
+	}, WithSessionID("session"), WithSequence(10), WithReidentifyOnEventsLost(true))
+	assert.NoError(t, g.Open(context.Background()))
+	defer g.Close(context.Background())
+
+	conn := s.accept(t)
+	write(t, conn, OpcodeHello, hello())
+	assert.Equal(t, OpcodeResume, read(t, conn))
+	writeDispatch(t, conn, 12, "UNKNOWN_EVENT", map[string]any{})
+
+	select {
+	case event := <-eventsLost:
+		assert.Equal(t, EventGatewayEventsLost{From: 11, To: 11, Reidentify: true}, event)
+	case <-time.After(time.Second):
+		t.Fatal("EventGatewayEventsLost was not dispatched")
+	}
+
+	// the session was dropped, so the next connection identifies instead of resuming
+	conn = s.accept(t)
+	write(t, conn, OpcodeHello, hello())
+	assert.Equal(t, OpcodeIdentify, read(t, conn))
+}
+
+// closedURL returns the URL of a websocket server which doesn't accept connections anymore.
+func closedURL() string {
+	s := httptest.NewServer(http.NotFoundHandler())
+	s.Close()
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+func TestGateway_ResumeURLFallback(t *testing.T) {
+	s := newTestServer(t)
+	g := New("token", func(EventType, int, int, EventData) {}, nil,
+		WithURL(s.url()),
+		WithResumeURL(closedURL()),
+		WithSessionID("session"),
+		WithSequence(10),
+		WithCompress(false),
+		WithAutoReconnect(false),
+	)
+	defer g.Close(context.Background())
+
+	// the stale resume url fails once, then the session is resumed via the gateway url
+	assert.Error(t, g.Open(context.Background()))
+	assert.NoError(t, g.(*gatewayImpl).reconnectTry(context.Background(), 0, time.Millisecond))
+	assert.Nil(t, g.ResumeURL())
+
+	conn := s.accept(t)
+	write(t, conn, OpcodeHello, hello())
+	assert.Equal(t, OpcodeResume, read(t, conn))
+}
+
+func TestGateway_URLResolveAfter(t *testing.T) {
+	s := newTestServer(t)
+	var resolved int
+	g := New("token", func(EventType, int, int, EventData) {}, nil,
+		WithURL(closedURL()),
+		WithURLResolver(func(context.Context) (string, error) {
+			resolved++
+			return s.url(), nil
+		}),
+		WithURLResolveAfter(3),
+		WithCompress(false),
+		WithAutoReconnect(false),
+	)
+	defer g.Close(context.Background())
+
+	// the resolver is only used after the third failed attempt
+	assert.NoError(t, g.(*gatewayImpl).reconnectTry(context.Background(), 0, time.Millisecond))
+	assert.Equal(t, 1, resolved)
+	assert.Equal(t, s.url(), g.(*gatewayImpl).config.URL)
+	s.accept(t)
+}
@@ -84,11 +84,16 @@ func (e *Message) UnmarshalJSON(data []byte) error {
 	case OpcodeHeartbeatACK:
 	// no data
 
+	case OpcodeRequestSoundboardSounds:
+		var d MessageDataRequestSoundboardSounds
+		err = json.Unmarshal(v.D, &d)
+		messageData = d
+
 	default:
 		err = fmt.Errorf("unknown opcode %d", v.Op)
 	}
 	if err != nil {
-		return err
+		return &PayloadError{Op: v.Op, S: v.S, T: v.T, Payload: v.D, Err: err}
 	}
 	e.Op = v.Op
 	e.S = v.S
@@ -98,6 +103,26 @@ func (e *Message) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// PayloadError is returned when the envelope of a Message could be decoded, but its data could not.
+type PayloadError struct {
+	Op      Opcode
+	S       int
+	T       EventType
+	Payload json.RawMessage
+	Err     error
+}
+
+func (e *PayloadError) Error() string {
+	if e.T != "" {
+		return fmt.Sprintf("failed to decode %s payload: %s", e.T, e.Err)
+	}
+	return fmt.Sprintf("failed to decode %s payload: %s", e.Op, e.Err)
+}
+
+func (e *PayloadError) Unwrap() error {
+	return e.Err
+}
+
 type MessageData interface {
 	messageData()
 }
@@ -396,10 +421,21 @@ func UnmarshalEventData(data []byte, eventType EventType) (EventData, error) {
 		err = json.Unmarshal(data, &d)
 		eventData = d
 
+	case EventTypeVoiceChannelStatusUpdate:
+		var d EventVoiceChannelStatusUpdate
+		err = json.Unmarshal(data, &d)
+		eventData = d
+
 	case EventTypeWebhooksUpdate:
 		var d EventWebhooksUpdate
 		err = json.Unmarshal(data, &d)
 		eventData = d
+
+	default:
+		eventData = EventUnknown{
+			EventType: eventType,
+			Payload:   data,
+		}
 	}
 
 	return eventData, err
@@ -527,6 +563,13 @@ type MessageDataRequestGuildMembers struct {
 
 func (MessageDataRequestGuildMembers) messageData() {}
 
+// MessageDataRequestSoundboardSounds is used to request the soundboard sounds of the given guilds
+type MessageDataRequestSoundboardSounds struct {
+	GuildIDs []snowflake.ID `json:"guild_ids"`
+}
+
+func (MessageDataRequestSoundboardSounds) messageData() {}
+
 type MessageDataInvalidSession bool
 
 func (MessageDataInvalidSession) messageData() {}
@@ -1,5 +1,7 @@
 package gateway
 
+import "fmt"
+
 // Opcode are opcodes used by discord
 type Opcode int
 
@@ -19,8 +21,43 @@ const (
 	OpcodeHeartbeatACK
 )
 
+// OpcodeRequestSoundboardSounds is used to request the soundboard sounds of guilds.
+const OpcodeRequestSoundboardSounds Opcode = 31
+
+func (o Opcode) String() string {
+	switch o {
+	case OpcodeDispatch:
+		return "Dispatch"
+	case OpcodeHeartbeat:
+		return "Heartbeat"
+	case OpcodeIdentify:
+		return "Identify"
+	case OpcodePresenceUpdate:
+		return "PresenceUpdate"
+	case OpcodeVoiceStateUpdate:
+		return "VoiceStateUpdate"
+	case OpcodeResume:
+		return "Resume"
+	case OpcodeReconnect:
+		return "Reconnect"
+	case OpcodeRequestGuildMembers:
+		return "RequestGuildMembers"
+	case OpcodeInvalidSession:
+		return "InvalidSession"
+	case OpcodeHello:
+		return "Hello"
+	case OpcodeHeartbeatACK:
+		return "HeartbeatACK"
+	case OpcodeRequestSoundboardSounds:
+		return "RequestSoundboardSounds"
+	default:
+		return fmt.Sprintf("Opcode(%d)", int(o))
+	}
+}
+
 type CloseEventCode int
 
+// https://discord.com/developers/docs/topics/opcodes-and-status-codes#gateway-gateway-close-event-codes
 const (
 	CloseEventCodeUnknownError CloseEventCode = iota + 4000
 	CloseEventCodeUnknownOpcode
@@ -39,6 +76,54 @@ const (
 	CloseEventCodeDisallowedIntents
 )
 
+// standard websocket close codes used by the Gateway itself when closing the connection
+const (
+	CloseEventCodeNormalClosure  CloseEventCode = 1000
+	CloseEventCodeGoingAway      CloseEventCode = 1001
+	CloseEventCodeServiceRestart CloseEventCode = 1012
+)
+
+func (c CloseEventCode) String() string {
+	switch c {
+	case CloseEventCodeNormalClosure:
+		return "NormalClosure"
+	case CloseEventCodeGoingAway:
+		return "GoingAway"
+	case CloseEventCodeServiceRestart:
+		return "ServiceRestart"
+	case CloseEventCodeUnknownError:
+		return "UnknownError"
+	case CloseEventCodeUnknownOpcode:
+		return "UnknownOpcode"
+	case CloseEventCodeDecodeError:
+		return "DecodeError"
+	case CloseEventCodeNotAuthenticated:
+		return "NotAuthenticated"
+	case CloseEventCodeAuthenticationFailed:
+		return "AuthenticationFailed"
+	case CloseEventCodeAlreadyAuthenticated:
+		return "AlreadyAuthenticated"
+	case CloseEventCodeInvalidSeq:
+		return "InvalidSeq"
+	case CloseEventCodeRateLimited:
+		return "RateLimited"
+	case CloseEventCodeSessionTimedOut:
+		return "SessionTimedOut"
+	case CloseEventCodeInvalidShard:
+		return "InvalidShard"
+	case CloseEventCodeShardingRequired:
+		return "ShardingRequired"
+	case CloseEventCodeInvalidAPIVersion:
+		return "InvalidAPIVersion"
+	case CloseEventCodeInvalidIntents:
+		return "InvalidIntents"
+	case CloseEventCodeDisallowedIntents:
+		return "DisallowedIntents"
+	default:
+		return fmt.Sprintf("CloseEventCode(%d)", int(c))
+	}
+}
+
 func (c CloseEventCode) ShouldReconnect() bool {
 	switch c {
 	case CloseEventCodeAuthenticationFailed,
@@ -1,6 +1,7 @@
 package gateway
 
 import (
+	"github.com/disgoorg/disgo/clock"
 	"github.com/disgoorg/log"
 )
 
@@ -9,6 +10,7 @@ func DefaultRateLimiterConfig() *RateLimiterConfig {
 	return &RateLimiterConfig{
 		Logger:            log.Default(),
 		CommandsPerMinute: 120,
+		Clock:             clock.Default,
 	}
 }
 
@@ -16,6 +18,7 @@ func DefaultRateLimiterConfig() *RateLimiterConfig {
 type RateLimiterConfig struct {
 	Logger            log.Logger
 	CommandsPerMinute int
+	Clock             clock.Clock
 }
 
 // RateLimiterConfigOpt is a type alias for a function that takes a RateLimiterConfig and is used to configure your Server.
@@ -41,3 +44,10 @@ func WithCommandsPerMinute(commandsPerMinute int) RateLimiterConfigOpt {
 		config.CommandsPerMinute = commandsPerMinute
 	}
 }
+
+// WithRateLimiterClock sets the clock.Clock the RateLimiter uses to track the command window.
+func WithRateLimiterClock(clock clock.Clock) RateLimiterConfigOpt {
+	return func(config *RateLimiterConfig) {
+		config.Clock = clock
+	}
+}
@@ -47,7 +47,7 @@ func (l *rateLimiterImpl) Wait(ctx context.Context) error {
 		return err
 	}
 
-	now := time.Now()
+	now := l.config.Clock.Now()
 
 	var until time.Time
 
@@ -65,7 +65,7 @@ func (l *rateLimiterImpl) Wait(ctx context.Context) error {
 		case <-ctx.Done():
 			l.Unlock()
 			return ctx.Err()
-		case <-time.After(until.Sub(now)):
+		case <-l.config.Clock.After(until.Sub(now)):
 		}
 	}
 	return nil
@@ -73,7 +73,7 @@ func (l *rateLimiterImpl) Wait(ctx context.Context) error {
 
 func (l *rateLimiterImpl) Unlock() {
 	l.Logger().Trace("unlocking gateway rate limiter")
-	now := time.Now()
+	now := l.config.Clock.Now()
 	if l.reset.Before(now) {
 		l.reset = now.Add(time.Minute)
 		l.remaining = l.config.CommandsPerMinute
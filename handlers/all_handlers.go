@@ -34,6 +34,13 @@ var allEventHandlers = []bot.GatewayEventHandler{
 	bot.NewGatewayEventHandler(gateway.EventTypeRaw, gatewayHandlerRaw),
 	bot.NewGatewayEventHandler(gateway.EventTypeReady, gatewayHandlerReady),
 	bot.NewGatewayEventHandler(gateway.EventTypeResumed, gatewayHandlerResumed),
+	bot.NewGatewayEventHandler(gateway.EventTypeGatewayDisconnected, gatewayHandlerGatewayDisconnected),
+	bot.NewGatewayEventHandler(gateway.EventTypeGatewayReconnected, gatewayHandlerGatewayReconnected),
+	bot.NewGatewayEventHandler(gateway.EventTypeGatewayResumed, gatewayHandlerGatewayResumed),
+	bot.NewGatewayEventHandler(gateway.EventTypeGatewayEventsLost, gatewayHandlerGatewayEventsLost),
+	bot.NewGatewayEventHandler(gateway.EventTypeGatewayPayloadError, gatewayHandlerGatewayPayloadError),
+	bot.NewGatewayEventHandler(gateway.EventTypeShardRebalance, gatewayHandlerShardRebalance),
+	bot.NewGatewayEventHandler(gateway.EventTypeUnknown, gatewayHandlerUnknown),
 
 	bot.NewGatewayEventHandler(gateway.EventTypeApplicationCommandPermissionsUpdate, gatewayHandlerApplicationCommandPermissionsUpdate),
 
@@ -110,6 +117,7 @@ var allEventHandlers = []bot.GatewayEventHandler{
 
 	bot.NewGatewayEventHandler(gateway.EventTypeVoiceStateUpdate, gatewayHandlerVoiceStateUpdate),
 	bot.NewGatewayEventHandler(gateway.EventTypeVoiceServerUpdate, gatewayHandlerVoiceServerUpdate),
+	bot.NewGatewayEventHandler(gateway.EventTypeVoiceChannelStatusUpdate, gatewayHandlerVoiceChannelStatusUpdate),
 
 	bot.NewGatewayEventHandler(gateway.EventTypeWebhooksUpdate, gatewayHandlerWebhooksUpdate),
 }
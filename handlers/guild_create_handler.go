@@ -2,9 +2,11 @@ package handlers
 
 import (
 	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/cache"
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
 	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/snowflake/v2"
 )
 
 func gatewayHandlerGuildCreate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventGuildCreate) {
@@ -13,49 +15,95 @@ func gatewayHandlerGuildCreate(client bot.Client, sequenceNumber int, shardID in
 
 	client.Caches().Guilds().Put(event.ID, event.Guild)
 
-	for _, channel := range event.Channels {
-		channel = discord.ApplyGuildIDToChannel(channel, event.ID) // populate unset field
-		client.Caches().Channels().Put(channel.ID(), discord.ApplyGuildIDToChannel(channel, event.ID))
+	var presenceCount int
+	for _, presence := range event.Presences {
+		if presence.Status != discord.OnlineStatusOffline {
+			presenceCount++
+		}
+	}
+	client.Caches().PutGuildMemberCount(event.ID, cache.GuildMemberCount{
+		MemberCount:   event.MemberCount,
+		PresenceCount: presenceCount,
+		ReconciledAt:  client.Clock().Now(),
+	})
+
+	flags := client.Caches().GuildCreateFlags()
+
+	if flags.Has(cache.GuildCreateFlagChannels) {
+		for _, channel := range event.Channels {
+			channel = discord.ApplyGuildIDToChannel(channel, event.ID) // populate unset field
+			client.Caches().Channels().Put(channel.ID(), channel)
+		}
 	}
 
-	for _, thread := range event.Threads {
-		thread = discord.ApplyGuildIDToThread(thread, event.ID) // populate unset field
-		client.Caches().Channels().Put(thread.ID(), discord.ApplyGuildIDToThread(thread, event.ID))
+	if flags.Has(cache.GuildCreateFlagThreads) {
+		for _, thread := range event.Threads {
+			thread = discord.ApplyGuildIDToThread(thread, event.ID) // populate unset field
+			client.Caches().Channels().Put(thread.ID(), thread)
+		}
 	}
 
-	for _, role := range event.Roles {
-		client.Caches().Roles().Put(event.ID, role.ID, role)
+	if flags.Has(cache.GuildCreateFlagRoles) {
+		roles := make(map[snowflake.ID]discord.Role, len(event.Roles))
+		for _, role := range event.Roles {
+			roles[role.ID] = role
+		}
+		client.Caches().Roles().PutAll(event.ID, roles)
 	}
 
-	for _, member := range event.Members {
-		member.GuildID = event.ID // populate unset field
-		client.Caches().Members().Put(event.ID, member.User.ID, member)
+	if flags.Has(cache.GuildCreateFlagMembers) {
+		members := make(map[snowflake.ID]discord.Member, len(event.Members))
+		for _, member := range event.Members {
+			member.GuildID = event.ID // populate unset field
+			members[member.User.ID] = member
+		}
+		client.Caches().Members().PutAll(event.ID, members)
 	}
 
-	for _, voiceState := range event.VoiceStates {
-		voiceState.GuildID = event.ID // populate unset field
-		client.Caches().VoiceStates().Put(voiceState.GuildID, voiceState.UserID, voiceState)
+	if flags.Has(cache.GuildCreateFlagVoiceStates) {
+		voiceStates := make(map[snowflake.ID]discord.VoiceState, len(event.VoiceStates))
+		for _, voiceState := range event.VoiceStates {
+			voiceState.GuildID = event.ID // populate unset field
+			voiceStates[voiceState.UserID] = voiceState
+		}
+		client.Caches().VoiceStates().PutAll(event.ID, voiceStates)
 	}
 
-	for _, emoji := range event.Emojis {
-		client.Caches().Emojis().Put(event.ID, emoji.ID, emoji)
+	if flags.Has(cache.GuildCreateFlagEmojis) {
+		emojis := make(map[snowflake.ID]discord.Emoji, len(event.Emojis))
+		for _, emoji := range event.Emojis {
+			emojis[emoji.ID] = emoji
+		}
+		client.Caches().Emojis().PutAll(event.ID, emojis)
 	}
 
-	for _, sticker := range event.Stickers {
-		client.Caches().Stickers().Put(event.ID, sticker.ID, sticker)
+	if flags.Has(cache.GuildCreateFlagStickers) {
+		stickers := make(map[snowflake.ID]discord.Sticker, len(event.Stickers))
+		for _, sticker := range event.Stickers {
+			stickers[sticker.ID] = sticker
+		}
+		client.Caches().Stickers().PutAll(event.ID, stickers)
 	}
 
-	for _, stageInstance := range event.StageInstances {
-		client.Caches().StageInstances().Put(event.ID, stageInstance.ID, stageInstance)
+	if flags.Has(cache.GuildCreateFlagStageInstances) {
+		for _, stageInstance := range event.StageInstances {
+			client.Caches().StageInstances().Put(event.ID, stageInstance.ID, stageInstance)
+		}
 	}
 
-	for _, guildScheduledEvent := range event.GuildScheduledEvents {
-		client.Caches().GuildScheduledEvents().Put(event.ID, guildScheduledEvent.ID, guildScheduledEvent)
+	if flags.Has(cache.GuildCreateFlagGuildScheduledEvents) {
+		for _, guildScheduledEvent := range event.GuildScheduledEvents {
+			client.Caches().GuildScheduledEvents().Put(event.ID, guildScheduledEvent.ID, guildScheduledEvent)
+		}
 	}
 
-	for _, presence := range event.Presences {
-		presence.GuildID = event.ID // populate unset field
-		client.Caches().Presences().Put(event.ID, presence.PresenceUser.ID, presence)
+	if flags.Has(cache.GuildCreateFlagPresences) {
+		presences := make(map[snowflake.ID]discord.Presence, len(event.Presences))
+		for _, presence := range event.Presences {
+			presence.GuildID = event.ID // populate unset field
+			presences[presence.PresenceUser.ID] = presence
+		}
+		client.Caches().Presences().PutAll(event.ID, presences)
 	}
 
 	genericGuildEvent := &events.GenericGuild{
@@ -65,6 +113,10 @@ func gatewayHandlerGuildCreate(client bot.Client, sequenceNumber int, shardID in
 	}
 
 	if wasUnready {
+		// chunking is registered before the guild is marked ready, so bot.Client.WaitForCacheReady never sees a ready guild without its pending chunk
+		if client.MemberChunkingManager().MemberChunkingFilter()(event.ID) {
+			client.MemberChunkingManager().ChunkGuild(event.ID)
+		}
 		client.Caches().Guilds().SetReady(shardID, event.ID)
 		client.EventManager().DispatchEvent(&events.GuildReady{
 			GenericGuild: genericGuildEvent,
@@ -74,14 +126,6 @@ func gatewayHandlerGuildCreate(client bot.Client, sequenceNumber int, shardID in
 				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
 			})
 		}
-		if client.MemberChunkingManager().MemberChunkingFilter()(event.ID) {
-			go func() {
-				if _, err := client.MemberChunkingManager().RequestMembersWithQuery(event.ID, "", 0); err != nil {
-					client.Logger().Error("failed to chunk guild on guild_create. error: ", err)
-				}
-			}()
-		}
-
 	}
 	if wasUnavailable {
 		client.Caches().Guilds().SetAvailable(event.ID)
@@ -89,6 +133,10 @@ func gatewayHandlerGuildCreate(client bot.Client, sequenceNumber int, shardID in
 			GenericGuild: genericGuildEvent,
 		})
 	} else {
+		// guilds joined after ready are chunked here, the others were chunked above already
+		if !wasUnready && client.MemberChunkingManager().MemberChunkingFilter()(event.ID) {
+			client.MemberChunkingManager().ChunkGuild(event.ID)
+		}
 		client.EventManager().DispatchEvent(&events.GuildJoin{
 			GenericGuild: genericGuildEvent,
 		})
@@ -12,6 +12,7 @@ func gatewayHandlerGuildDelete(client bot.Client, sequenceNumber int, shardID in
 	guild, _ := client.Caches().Guilds().Remove(event.ID)
 	client.Caches().VoiceStates().RemoveAll(event.ID)
 	client.Caches().Presences().RemoveAll(event.ID)
+	client.Caches().RemoveGuildMemberCount(event.ID)
 	client.Caches().ThreadMembers().RemoveIf(func(_ snowflake.ID, threadMember discord.ThreadMember) bool {
 		// TODO: figure out a better way to remove thread members from cache via guild id without requiring cached GuildThreads
 		if thread, ok := client.Caches().Channels().GetGuildThread(threadMember.ThreadID); ok {
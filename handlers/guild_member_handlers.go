@@ -4,6 +4,7 @@ import (
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/events"
 	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/snowflake/v2"
 )
 
 func gatewayHandlerGuildMemberAdd(client bot.Client, sequenceNumber int, shardID int, event gateway.EventGuildMemberAdd) {
@@ -11,6 +12,7 @@ func gatewayHandlerGuildMemberAdd(client bot.Client, sequenceNumber int, shardID
 		guild.MemberCount++
 		client.Caches().Guilds().Put(guild.ID, guild)
 	}
+	client.Caches().AddGuildMemberCount(event.GuildID, 1)
 
 	client.Caches().Members().Put(event.GuildID, event.User.ID, event.Member)
 
@@ -24,17 +26,25 @@ func gatewayHandlerGuildMemberAdd(client bot.Client, sequenceNumber int, shardID
 }
 
 func gatewayHandlerGuildMemberUpdate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventGuildMemberUpdate) {
-	oldMember, _ := client.Caches().Members().Get(event.GuildID, event.User.ID)
+	oldMember, oldOk := client.Caches().Members().Get(event.GuildID, event.User.ID)
 	client.Caches().Members().Put(event.GuildID, event.User.ID, event.Member)
 
+	genericGuildMember := &events.GenericGuildMember{
+		GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+		GuildID:      event.GuildID,
+		Member:       event.Member,
+	}
+
 	client.EventManager().DispatchEvent(&events.GuildMemberUpdate{
-		GenericGuildMember: &events.GenericGuildMember{
-			GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
-			GuildID:      event.GuildID,
-			Member:       event.Member,
-		},
-		OldMember: oldMember,
+		GenericGuildMember: genericGuildMember,
+		OldMember:          oldMember,
 	})
+
+	if oldOk && oldMember.Pending && !event.Pending {
+		client.EventManager().DispatchEvent(&events.GuildMemberGatePassed{
+			GenericGuildMember: genericGuildMember,
+		})
+	}
 }
 
 func gatewayHandlerGuildMemberRemove(client bot.Client, sequenceNumber int, shardID int, event gateway.EventGuildMemberRemove) {
@@ -42,6 +52,7 @@ func gatewayHandlerGuildMemberRemove(client bot.Client, sequenceNumber int, shar
 		guild.MemberCount--
 		client.Caches().Guilds().Put(guild.ID, guild)
 	}
+	client.Caches().AddGuildMemberCount(event.GuildID, -1)
 
 	member, _ := client.Caches().Members().Remove(event.GuildID, event.User.ID)
 
@@ -62,3 +73,12 @@ func gatewayHandlerGuildMembersChunk(client bot.Client, _ int, _ int, event gate
 		client.MemberChunkingManager().HandleChunk(event)
 	}
 }
+
+// NewGuildMembersChunkingDone is the bot.MemberChunkingDoneEventFunc which creates the events.GuildMembersChunkingDone event.
+func NewGuildMembersChunkingDone(client bot.Client, sequenceNumber int, shardID int, guildID snowflake.ID, memberCount int) bot.Event {
+	return &events.GuildMembersChunkingDone{
+		GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+		GuildID:      guildID,
+		MemberCount:  memberCount,
+	}
+}
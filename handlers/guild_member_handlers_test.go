@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeGateway is a gateway.Gateway which records the requested guild members instead of sending them to Discord.
+type fakeGateway struct {
+	gateway.Gateway
+	requests chan gateway.MessageDataRequestGuildMembers
+}
+
+func (g *fakeGateway) ShardID() int {
+	return 0
+}
+
+func (g *fakeGateway) Intents() gateway.Intents {
+	return gateway.IntentGuildMembers
+}
+
+func (g *fakeGateway) LastSequenceReceived() *int {
+	sequence := 42
+	return &sequence
+}
+
+func (g *fakeGateway) RequestGuildMembers(_ context.Context, command gateway.MessageDataRequestGuildMembers) error {
+	g.requests <- command
+	return nil
+}
+
+func TestGuildMembersChunkingDone(t *testing.T) {
+	guildID := snowflake.ID(1)
+	fake := &fakeGateway{requests: make(chan gateway.MessageDataRequestGuildMembers, 1)}
+	done := make(chan *events.GuildMembersChunkingDone, 1)
+	client := newTestClient(t,
+		bot.WithGateway(fake),
+		bot.WithCacheConfigOpts(cache.WithCacheFlags(cache.FlagMembers)),
+		bot.WithMemberChunkingDoneEventFunc(NewGuildMembersChunkingDone),
+		bot.WithEventListenerFunc(func(e *events.GuildMembersChunkingDone) {
+			done <- e
+		}),
+	)
+
+	client.MemberChunkingManager().ChunkGuild(guildID)
+
+	var command gateway.MessageDataRequestGuildMembers
+	select {
+	case command = <-fake.requests:
+	case <-time.After(time.Second):
+		t.Fatal("guild members were not requested")
+	}
+	assert.Equal(t, guildID, command.GuildID)
+
+	chunks := [][]discord.Member{
+		{{User: discord.User{ID: 2}}, {User: discord.User{ID: 3}}},
+		{{User: discord.User{ID: 4}}},
+	}
+	for i, members := range chunks {
+		gatewayHandlerGuildMembersChunk(client, 0, 0, gateway.EventGuildMembersChunk{
+			GuildID:    guildID,
+			Members:    members,
+			ChunkIndex: i,
+			ChunkCount: len(chunks),
+			Nonce:      command.Nonce,
+		})
+		if i < len(chunks)-1 {
+			assert.Len(t, done, 0, "GuildMembersChunkingDone was dispatched before the last chunk")
+		}
+	}
+
+	select {
+	case e := <-done:
+		assert.Equal(t, guildID, e.GuildID)
+		assert.Equal(t, 3, e.MemberCount)
+		assert.Equal(t, 42, e.SequenceNumber())
+		assert.Equal(t, 0, e.ShardID())
+	case <-time.After(time.Second):
+		t.Fatal("GuildMembersChunkingDone was not dispatched")
+	}
+}
+
+func TestGuildMembersChunkingDoneNotDispatchedForRequests(t *testing.T) {
+	guildID := snowflake.ID(1)
+	fake := &fakeGateway{requests: make(chan gateway.MessageDataRequestGuildMembers, 1)}
+	done := make(chan *events.GuildMembersChunkingDone, 1)
+	client := newTestClient(t,
+		bot.WithGateway(fake),
+		bot.WithMemberChunkingDoneEventFunc(NewGuildMembersChunkingDone),
+		bot.WithEventListenerFunc(func(e *events.GuildMembersChunkingDone) {
+			done <- e
+		}),
+	)
+
+	memberChan, _, err := client.MemberChunkingManager().RequestMembersChan(guildID, 2)
+	assert.NoError(t, err)
+	command := <-fake.requests
+
+	handled := make(chan struct{})
+	go func() {
+		defer close(handled)
+		gatewayHandlerGuildMembersChunk(client, 0, 0, gateway.EventGuildMembersChunk{
+			GuildID:    guildID,
+			Members:    []discord.Member{{User: discord.User{ID: 2}}},
+			ChunkCount: 1,
+			Nonce:      command.Nonce,
+		})
+	}()
+	for range memberChan {
+	}
+	<-handled
+
+	assert.Len(t, done, 0)
+}
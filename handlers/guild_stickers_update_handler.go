@@ -40,33 +40,36 @@ func gatewayHandlerGuildStickersUpdate(client bot.Client, sequenceNumber int, sh
 		createdStickers[newSticker.ID] = newSticker
 	}
 
-	for _, emoji := range createdStickers {
+	for _, sticker := range createdStickers {
+		client.Caches().Stickers().Put(event.GuildID, sticker.ID, sticker)
 		client.EventManager().DispatchEvent(&events.StickerCreate{
 			GenericSticker: &events.GenericSticker{
 				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
 				GuildID:      event.GuildID,
-				Sticker:      emoji,
+				Sticker:      sticker,
 			},
 		})
 	}
 
-	for _, emoji := range updatedStickers {
+	for _, sticker := range updatedStickers {
+		client.Caches().Stickers().Put(event.GuildID, sticker.new.ID, sticker.new)
 		client.EventManager().DispatchEvent(&events.StickerUpdate{
 			GenericSticker: &events.GenericSticker{
 				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
 				GuildID:      event.GuildID,
-				Sticker:      emoji.new,
+				Sticker:      sticker.new,
 			},
-			OldSticker: emoji.old,
+			OldSticker: sticker.old,
 		})
 	}
 
-	for _, emoji := range deletedStickers {
+	for _, sticker := range deletedStickers {
+		client.Caches().Stickers().Remove(event.GuildID, sticker.ID)
 		client.EventManager().DispatchEvent(&events.StickerDelete{
 			GenericSticker: &events.GenericSticker{
 				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
 				GuildID:      event.GuildID,
-				Sticker:      emoji,
+				Sticker:      sticker,
 			},
 		})
 	}
@@ -13,16 +13,31 @@ func gatewayHandlerInteractionCreate(client bot.Client, sequenceNumber int, shar
 	handleInteraction(client, sequenceNumber, shardID, nil, event.Interaction)
 }
 
-func respond(client bot.Client, respondFunc httpserver.RespondFunc, interaction discord.BaseInteraction) events.InteractionResponderFunc {
+func respond(genericEvent *events.GenericEvent, respondFunc httpserver.RespondFunc, interaction discord.Interaction) events.InteractionResponderFunc {
 	return func(responseType discord.InteractionResponseType, data discord.InteractionResponseData, opts ...rest.RequestOpt) error {
 		response := discord.InteractionResponse{
 			Type: responseType,
 			Data: data,
 		}
+		var err error
 		if respondFunc != nil {
-			return respondFunc(response)
+			err = respondFunc(response)
+		} else {
+			err = genericEvent.Client().Rest().CreateInteractionResponse(interaction.ID(), interaction.Token(), response, opts...)
 		}
-		return client.Rest().CreateInteractionResponse(interaction.ID(), interaction.Token(), response, opts...)
+		if err != nil {
+			failedEvent := &events.InteractionResponseFailed{
+				GenericEvent: genericEvent,
+				Interaction:  interaction,
+				Response:     response,
+				Err:          err,
+			}
+			if failedEvent.RateLimited() || failedEvent.Expired() {
+				// dispatch in a new goroutine as we are usually called from within the bot.EventManager which holds its listener lock
+				go genericEvent.Client().EventManager().DispatchEvent(failedEvent)
+			}
+		}
+		return err
 	}
 }
 
@@ -33,7 +48,7 @@ func handleInteraction(client bot.Client, sequenceNumber int, shardID int, respo
 	client.EventManager().DispatchEvent(&events.InteractionCreate{
 		GenericEvent: genericEvent,
 		Interaction:  interaction,
-		Respond:      respond(client, respondFunc, interaction),
+		Respond:      respond(genericEvent, respondFunc, interaction),
 	})
 
 	switch i := interaction.(type) {
@@ -41,28 +56,28 @@ func handleInteraction(client bot.Client, sequenceNumber int, shardID int, respo
 		client.EventManager().DispatchEvent(&events.ApplicationCommandInteractionCreate{
 			GenericEvent:                  genericEvent,
 			ApplicationCommandInteraction: i,
-			Respond:                       respond(client, respondFunc, interaction),
+			Respond:                       respond(genericEvent, respondFunc, interaction),
 		})
 
 	case discord.ComponentInteraction:
 		client.EventManager().DispatchEvent(&events.ComponentInteractionCreate{
 			GenericEvent:         genericEvent,
 			ComponentInteraction: i,
-			Respond:              respond(client, respondFunc, interaction),
+			Respond:              respond(genericEvent, respondFunc, interaction),
 		})
 
 	case discord.AutocompleteInteraction:
 		client.EventManager().DispatchEvent(&events.AutocompleteInteractionCreate{
 			GenericEvent:            genericEvent,
 			AutocompleteInteraction: i,
-			Respond:                 respond(client, respondFunc, interaction),
+			Respond:                 respond(genericEvent, respondFunc, interaction),
 		})
 
 	case discord.ModalSubmitInteraction:
 		client.EventManager().DispatchEvent(&events.ModalSubmitInteractionCreate{
 			GenericEvent:           genericEvent,
 			ModalSubmitInteraction: i,
-			Respond:                respond(client, respondFunc, interaction),
+			Respond:                respond(genericEvent, respondFunc, interaction),
 		})
 
 	default:
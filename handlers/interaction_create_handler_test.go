@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/disgo/httpserver"
+	"github.com/stretchr/testify/assert"
+)
+
+// testToken is a syntactically valid bot token for the application id 123456789012345678.
+const testToken = "MTIzNDU2Nzg5MDEyMzQ1Njc4.token.secret"
+
+func newTestClient(t *testing.T, opts ...bot.ConfigOpt) bot.Client {
+	config := bot.DefaultConfig(GetGatewayHandlers(), GetHTTPServerHandler())
+	config.Apply(opts)
+	client, err := bot.BuildClient(testToken, *config, DefaultGatewayEventHandler, DefaultHTTPServerEventHandler, "linux", "disgo", "", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestRespondFailedFromListener(t *testing.T) {
+	failed := make(chan *events.InteractionResponseFailed, 1)
+	responded := make(chan error, 1)
+	client := newTestClient(t,
+		bot.WithEventListenerFunc(func(e *events.ApplicationCommandInteractionCreate) {
+			responded <- e.CreateMessage(discord.MessageCreate{Content: "pong"})
+		}),
+		bot.WithEventListenerFunc(func(e *events.InteractionResponseFailed) {
+			failed <- e
+		}),
+	)
+
+	var respondFunc httpserver.RespondFunc = func(response discord.InteractionResponse) error {
+		return discord.ErrInteractionExpired
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handleInteraction(client, -1, -1, respondFunc, discord.ApplicationCommandInteraction{})
+	}()
+
+	select {
+	case err := <-responded:
+		assert.ErrorIs(t, err, discord.ErrInteractionExpired)
+	case <-time.After(time.Second):
+		t.Fatal("listener did not respond")
+	}
+	select {
+	case e := <-failed:
+		assert.True(t, e.Expired())
+		assert.Equal(t, discord.InteractionResponseTypeCreateMessage, e.Response.Type)
+	case <-time.After(time.Second):
+		t.Fatal("InteractionResponseFailed was not dispatched")
+	}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("dispatching the interaction deadlocked")
+	}
+}
@@ -1,9 +1,13 @@
 package handlers
 
 import (
+	"context"
+	"errors"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/events"
 	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/snowflake/v2"
 )
 
 func gatewayHandlerRaw(client bot.Client, sequenceNumber int, shardID int, event gateway.EventRaw) {
@@ -24,6 +28,38 @@ func gatewayHandlerReady(client bot.Client, sequenceNumber int, shardID int, eve
 		GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
 		EventReady:   event,
 	})
+
+	if cacheWarmer := client.CacheWarmer(); cacheWarmer != nil {
+		guildIDs := make([]snowflake.ID, len(event.Guilds))
+		for i, guild := range event.Guilds {
+			guildIDs[i] = guild.ID
+		}
+		go func() {
+			warmedGuildIDs := guildIDs
+			var failedGuildIDs []snowflake.ID
+			err := cacheWarmer.WarmGuilds(context.TODO(), guildIDs...)
+			if err != nil {
+				client.Logger().Error("failed to warm caches: ", err)
+				warmedGuildIDs = nil
+				failedGuildIDs = guildIDs
+				var warmErr *bot.CacheWarmError
+				if errors.As(err, &warmErr) {
+					failedGuildIDs = warmErr.GuildIDs()
+					for _, guildID := range guildIDs {
+						if _, ok := warmErr.Errors[guildID]; !ok {
+							warmedGuildIDs = append(warmedGuildIDs, guildID)
+						}
+					}
+				}
+			}
+			client.EventManager().DispatchEvent(&events.CacheReady{
+				GenericEvent:   events.NewGenericEvent(client, sequenceNumber, shardID),
+				GuildIDs:       warmedGuildIDs,
+				FailedGuildIDs: failedGuildIDs,
+				Err:            err,
+			})
+		}()
+	}
 }
 
 func gatewayHandlerResumed(client bot.Client, sequenceNumber int, shardID int, _ gateway.EventData) {
@@ -31,3 +67,52 @@ func gatewayHandlerResumed(client bot.Client, sequenceNumber int, shardID int, _
 		GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
 	})
 }
+
+func gatewayHandlerGatewayDisconnected(client bot.Client, sequenceNumber int, shardID int, event gateway.EventGatewayDisconnected) {
+	client.EventManager().DispatchEvent(&events.GatewayDisconnected{
+		GenericEvent:             events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventGatewayDisconnected: event,
+	})
+}
+
+func gatewayHandlerGatewayReconnected(client bot.Client, sequenceNumber int, shardID int, event gateway.EventGatewayReconnected) {
+	client.EventManager().DispatchEvent(&events.GatewayReconnected{
+		GenericEvent:            events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventGatewayReconnected: event,
+	})
+}
+
+func gatewayHandlerGatewayResumed(client bot.Client, sequenceNumber int, shardID int, event gateway.EventGatewayResumed) {
+	client.EventManager().DispatchEvent(&events.GatewayResumed{
+		GenericEvent:        events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventGatewayResumed: event,
+	})
+}
+
+func gatewayHandlerGatewayEventsLost(client bot.Client, sequenceNumber int, shardID int, event gateway.EventGatewayEventsLost) {
+	client.EventManager().DispatchEvent(&events.GatewayEventsLost{
+		GenericEvent:           events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventGatewayEventsLost: event,
+	})
+}
+
+func gatewayHandlerGatewayPayloadError(client bot.Client, sequenceNumber int, shardID int, event gateway.EventGatewayPayloadError) {
+	client.EventManager().DispatchEvent(&events.GatewayPayloadError{
+		GenericEvent:             events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventGatewayPayloadError: event,
+	})
+}
+
+func gatewayHandlerShardRebalance(client bot.Client, sequenceNumber int, shardID int, event gateway.EventShardRebalance) {
+	client.EventManager().DispatchEvent(&events.ShardRebalance{
+		GenericEvent:        events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventShardRebalance: event,
+	})
+}
+
+func gatewayHandlerUnknown(client bot.Client, sequenceNumber int, shardID int, event gateway.EventUnknown) {
+	client.EventManager().DispatchEvent(&events.UnknownGatewayEvent{
+		GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventUnknown: event,
+	})
+}
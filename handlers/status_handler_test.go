@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// roundTripperFunc answers rest requests without sending them to Discord.
+type roundTripperFunc func(r *http.Request) *http.Response
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r), nil
+}
+
+func jsonResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestCacheWarming(t *testing.T) {
+	cacheReady := make(chan *events.CacheReady, 1)
+	client := newTestClient(t,
+		bot.WithCacheWarming(),
+		bot.WithCacheConfigOpts(cache.WithCacheFlags(cache.FlagChannels, cache.FlagRoles, cache.FlagEmojis)),
+		bot.WithRestClientConfigOpts(rest.WithHTTPClient(&http.Client{Transport: roundTripperFunc(func(r *http.Request) *http.Response {
+			switch {
+			case strings.Contains(r.URL.Path, "/guilds/2/"):
+				return jsonResponse(http.StatusNotFound, `{"code":10004,"message":"Unknown Guild"}`)
+			case strings.HasSuffix(r.URL.Path, "/channels"):
+				return jsonResponse(http.StatusOK, `[{"id":"10","type":0,"name":"rest"}]`)
+			case strings.HasSuffix(r.URL.Path, "/roles"):
+				return jsonResponse(http.StatusOK, `[{"id":"1","name":"rest"},{"id":"11","name":"rest"}]`)
+			default:
+				return jsonResponse(http.StatusOK, `[]`)
+			}
+		})})),
+		bot.WithEventListenerFunc(func(e *events.CacheReady) {
+			cacheReady <- e
+		}),
+	)
+	// received via the gateway while warming, so it is newer than the rest response
+	client.Caches().Roles().Put(1, 1, discord.Role{ID: 1, Name: "gateway"})
+
+	gatewayHandlerReady(client, 0, 0, gateway.EventReady{Guilds: []discord.UnavailableGuild{{ID: 1}, {ID: 2}}})
+
+	var e *events.CacheReady
+	select {
+	case e = <-cacheReady:
+	case <-time.After(time.Second):
+		t.Fatal("CacheReady was not dispatched")
+	}
+	assert.Equal(t, []snowflake.ID{1}, e.GuildIDs)
+	assert.Equal(t, []snowflake.ID{2}, e.FailedGuildIDs)
+	var warmErr *bot.CacheWarmError
+	if assert.ErrorAs(t, e.Err, &warmErr) {
+		assert.Len(t, warmErr.Errors, 1)
+	}
+
+	channel, ok := client.Caches().Channels().Get(10)
+	if assert.True(t, ok) {
+		assert.Equal(t, snowflake.ID(1), channel.(discord.GuildChannel).GuildID())
+	}
+	role, _ := client.Caches().Roles().Get(1, 1)
+	assert.Equal(t, "gateway", role.Name, "warming replaced a cached entity")
+	role, _ = client.Caches().Roles().Get(1, 11)
+	assert.Equal(t, "rest", role.Name)
+}
@@ -45,6 +45,17 @@ func gatewayHandlerVoiceStateUpdate(client bot.Client, sequenceNumber int, shard
 	} else {
 		client.Logger().Warnf("could not decide which GuildVoice to fire")
 	}
+
+	if event.UserID != client.ID() {
+		return
+	}
+	client.VoiceManager().HandleVoiceStateUpdate(event.VoiceState)
+	if oldOk && event.ChannelID != nil && (oldVoiceState.GuildMute != event.GuildMute || oldVoiceState.GuildDeaf != event.GuildDeaf) {
+		client.EventManager().DispatchEvent(&events.GuildVoiceSelfServerStateUpdate{
+			GenericGuildVoiceState: genericGuildVoiceEvent,
+			OldVoiceState:          oldVoiceState,
+		})
+	}
 }
 
 func gatewayHandlerVoiceServerUpdate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventVoiceServerUpdate) {
@@ -53,3 +64,20 @@ func gatewayHandlerVoiceServerUpdate(client bot.Client, sequenceNumber int, shar
 		EventVoiceServerUpdate: event,
 	})
 }
+
+func gatewayHandlerVoiceChannelStatusUpdate(client bot.Client, sequenceNumber int, shardID int, event gateway.EventVoiceChannelStatusUpdate) {
+	var oldStatus *string
+	if channel, ok := client.Caches().Channels().GetGuildVoiceChannel(event.ID); ok {
+		oldStatus = channel.Status
+		channel.Status = event.Status
+		client.Caches().Channels().Put(channel.ID(), channel)
+	}
+
+	client.EventManager().DispatchEvent(&events.GuildVoiceChannelStatusUpdate{
+		GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+		ChannelID:    event.ID,
+		GuildID:      event.GuildID,
+		Status:       event.Status,
+		OldStatus:    oldStatus,
+	})
+}
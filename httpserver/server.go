@@ -36,6 +36,9 @@ type Server interface {
 
 	// Handle passes a payload to the Server for processing
 	Handle(respondFunc RespondFunc, event gateway.EventInteractionCreate)
+
+	// Handler returns the http.Handler which handles interactions. Mount it on your own http.ServeMux to share it with other endpoints instead of calling Start.
+	Handler() http.Handler
 }
 
 // VerifyRequest implements the verification side of the discord interactions api signing algorithm, as documented here: https://discord.com/developers/docs/interactions/slash-commands#security-and-authorization
@@ -13,6 +13,7 @@ import (
 	"github.com/disgoorg/disgo/json"
 
 	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/internal/tokenhelper"
 	"github.com/disgoorg/log"
 )
 
@@ -53,8 +54,12 @@ func (s *serverImpl) Handle(respondFunc RespondFunc, event gateway.EventInteract
 	s.eventHandlerFunc(respondFunc, event)
 }
 
+func (s *serverImpl) Handler() http.Handler {
+	return &WebhookInteractionHandler{server: s}
+}
+
 func (s *serverImpl) Start() {
-	s.config.ServeMux.Handle(s.config.URL, &WebhookInteractionHandler{server: s})
+	s.config.ServeMux.Handle(s.config.URL, s.Handler())
 	s.config.HTTPServer.Addr = s.config.Address
 	s.config.HTTPServer.Handler = s.config.ServeMux
 
@@ -92,7 +97,7 @@ func (h *WebhookInteractionHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 	if ok := VerifyRequest(r, h.server.PublicKey()); !ok {
 		w.WriteHeader(http.StatusUnauthorized)
 		data, _ := io.ReadAll(r.Body)
-		h.server.Logger().Trace("received http interaction with invalid signature. body: ", string(data))
+		h.server.Logger().Trace("received http interaction with invalid signature. body: ", tokenhelper.Redact(string(data)))
 		return
 	}
 
@@ -102,7 +107,7 @@ func (h *WebhookInteractionHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 
 	buff := new(bytes.Buffer)
 	rqData, _ := io.ReadAll(io.TeeReader(r.Body, buff))
-	h.server.Logger().Trace("received http interaction. body: ", string(rqData))
+	h.server.Logger().Trace("received http interaction. body: ", tokenhelper.Redact(string(rqData)))
 
 	var v gateway.EventInteractionCreate
 	if err := json.NewDecoder(buff).Decode(&v); err != nil {
@@ -0,0 +1,30 @@
+// Package bufferpool provides a pool of bytes.Buffer used for encoding request bodies.
+package bufferpool
+
+import (
+	"bytes"
+	"sync"
+)
+
+// maxSize is the maximum capacity of a bytes.Buffer which is put back into the pool, so big uploads don't stay in memory.
+const maxSize = 1 << 20
+
+var pool = sync.Pool{
+	New: func() any {
+		return new(bytes.Buffer)
+	},
+}
+
+// Get returns an empty bytes.Buffer from the pool.
+func Get() *bytes.Buffer {
+	return pool.Get().(*bytes.Buffer)
+}
+
+// Put resets the given bytes.Buffer and puts it back into the pool. The buffer must not be used afterwards.
+func Put(buf *bytes.Buffer) {
+	if buf == nil || buf.Cap() > maxSize {
+		return
+	}
+	buf.Reset()
+	pool.Put(buf)
+}
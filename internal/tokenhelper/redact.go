@@ -0,0 +1,22 @@
+package tokenhelper
+
+import (
+	"regexp"
+)
+
+const redacted = "[REDACTED]"
+
+var (
+	botTokenRegex   = regexp.MustCompile(`[\w-]{23,28}\.[\w-]{6,7}\.[\w-]{27,38}`)
+	jsonTokenRegex  = regexp.MustCompile(`("token"\s*:\s*")[^"]*(")`)
+	webhookURLRegex = regexp.MustCompile(`(/webhooks/\d+/)[\w.-]+`)
+	callbackRegex   = regexp.MustCompile(`(/interactions/\d+/)[\w.-]+(/callback)`)
+)
+
+// Redact replaces bot, webhook & interaction tokens in the given log payload or url with [REDACTED].
+func Redact(s string) string {
+	s = botTokenRegex.ReplaceAllString(s, redacted)
+	s = jsonTokenRegex.ReplaceAllString(s, "${1}"+redacted+"${2}")
+	s = webhookURLRegex.ReplaceAllString(s, "${1}"+redacted)
+	return callbackRegex.ReplaceAllString(s, "${1}"+redacted+"${2}")
+}
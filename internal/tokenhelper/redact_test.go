@@ -0,0 +1,15 @@
+package tokenhelper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	assert.Equal(t, `{"op":2,"d":{"token":"[REDACTED]","intents":513}}`, Redact(`{"op":2,"d":{"token":"MTA0NDUzNzI3ODY2MzQ4MDQ3Mw.GxYzAb.abcdefghijklmnopqrstuvwxyz0123456","intents":513}}`))
+	assert.Equal(t, "Bot [REDACTED]", Redact("Bot MTA0NDUzNzI3ODY2MzQ4MDQ3Mw.GxYzAb.abcdefghijklmnopqrstuvwxyz0123456"))
+	assert.Equal(t, "https://discord.com/api/v10/webhooks/123/[REDACTED]?wait=true", Redact("https://discord.com/api/v10/webhooks/123/aW50ZXJhY3Rpb246MTIz-abc_def?wait=true"))
+	assert.Equal(t, "https://discord.com/api/v10/webhooks/123/[REDACTED]/messages/@original", Redact("https://discord.com/api/v10/webhooks/123/aW50ZXJhY3Rpb24.abc/messages/@original"))
+	assert.Equal(t, "https://discord.com/api/v10/interactions/123/[REDACTED]/callback", Redact("https://discord.com/api/v10/interactions/123/aW50ZXJhY3Rpb24.abc/callback"))
+}
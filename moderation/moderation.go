@@ -0,0 +1,167 @@
+// Package moderation provides a Moderator which notifies users via direct message before banning or kicking them.
+package moderation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/json"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// jsonErrorCodeCannotDMUser is the json error code discord responds with if the user does not accept direct messages from the bot.
+const jsonErrorCodeCannotDMUser = 50007
+
+// Action is a moderation action executed by the Moderator.
+type Action int
+
+// All Action(s)
+const (
+	ActionBan Action = iota
+	ActionKick
+)
+
+func (a Action) String() string {
+	switch a {
+	case ActionBan:
+		return "ban"
+	case ActionKick:
+		return "kick"
+	default:
+		return "unknown"
+	}
+}
+
+func (a Action) pastTense() string {
+	switch a {
+	case ActionBan:
+		return "banned"
+	case ActionKick:
+		return "kicked"
+	default:
+		return "removed"
+	}
+}
+
+// Result is the combined outcome of notifying the user and executing the Action.
+type Result struct {
+	Action  Action
+	GuildID snowflake.ID
+	UserID  snowflake.ID
+
+	// Notified reports whether the notice was delivered to the user.
+	Notified bool
+	// NoticeErr is the error which prevented delivering the notice. It matches discord.ErrCannotDMUser if the user does not accept direct messages.
+	NoticeErr error
+
+	// Err is the error of the Action itself. If it is not nil, the user was not banned or kicked.
+	Err error
+}
+
+// CannotDM reports whether the notice could not be delivered because the user does not accept direct messages from the bot.
+func (r Result) CannotDM() bool {
+	return errors.Is(r.NoticeErr, discord.ErrCannotDMUser)
+}
+
+// New returns a new Moderator with the given ConfigOpt(s) applied.
+// The bot requires the discord.PermissionBanMembers or discord.PermissionKickMembers permission for the respective Action.
+func New(client bot.Client, opts ...ConfigOpt) *Moderator {
+	config := DefaultConfig()
+	config.Apply(opts)
+
+	return &Moderator{
+		client: client,
+		config: *config,
+	}
+}
+
+// Moderator sends a structured notice to a user via direct message, executes the Action and reports both outcomes in a Result.
+// The notice is sent first, as the bot can't message users anymore after they left all mutual guilds.
+type Moderator struct {
+	client bot.Client
+	config Config
+}
+
+// Ban notifies the user and bans them from the guild. deleteMessageDays is the number of days of messages to delete.
+// The reason is included in the notice and the audit log.
+func (m *Moderator) Ban(ctx context.Context, guildID snowflake.ID, userID snowflake.ID, reason string, deleteMessageDays int) Result {
+	return m.execute(ctx, ActionBan, guildID, userID, reason, func(opts ...rest.RequestOpt) error {
+		return m.client.Rest().AddBan(guildID, userID, deleteMessageDays, opts...)
+	})
+}
+
+// Kick notifies the user and kicks them from the guild. The reason is included in the notice and the audit log.
+func (m *Moderator) Kick(ctx context.Context, guildID snowflake.ID, userID snowflake.ID, reason string) Result {
+	return m.execute(ctx, ActionKick, guildID, userID, reason, func(opts ...rest.RequestOpt) error {
+		return m.client.Rest().RemoveMember(guildID, userID, opts...)
+	})
+}
+
+func (m *Moderator) execute(ctx context.Context, action Action, guildID snowflake.ID, userID snowflake.ID, reason string, do func(opts ...rest.RequestOpt) error) Result {
+	result := Result{
+		Action:  action,
+		GuildID: guildID,
+		UserID:  userID,
+	}
+
+	notice, err := m.notify(ctx, action, guildID, userID, reason)
+	if err != nil {
+		result.NoticeErr = err
+		m.client.Logger().Debugf("failed to notify user %s about %s in guild %s: %s", userID, action, guildID, err)
+	} else {
+		result.Notified = true
+	}
+
+	opts := []rest.RequestOpt{rest.WithCtx(ctx)}
+	if reason != "" {
+		opts = append(opts, rest.WithReason(reason))
+	}
+	if result.Err = do(opts...); result.Err != nil && notice != nil && m.config.DeleteNoticeOnFailure {
+		// the user should not be told about an action which did not happen
+		if err = m.client.Rest().DeleteMessage(notice.ChannelID, notice.ID, rest.WithCtx(ctx)); err != nil {
+			m.client.Logger().Errorf("failed to delete notice for failed %s of user %s: %s", action, userID, err)
+		} else {
+			result.Notified = false
+		}
+	}
+	return result
+}
+
+func (m *Moderator) notify(ctx context.Context, action Action, guildID snowflake.ID, userID snowflake.ID, reason string) (*discord.Message, error) {
+	if m.config.NoticeFunc == nil {
+		return nil, nil
+	}
+	guildName := guildID.String()
+	if guild, ok := m.client.Caches().Guilds().Get(guildID); ok {
+		guildName = guild.Name
+	}
+
+	channel, err := m.client.Rest().CreateDMChannel(userID, rest.WithCtx(ctx))
+	if err != nil {
+		return nil, wrapCannotDM(err)
+	}
+	message, err := m.client.Rest().CreateMessage(channel.ID(), m.config.NoticeFunc(action, guildName, reason), rest.WithCtx(ctx))
+	if err != nil {
+		return nil, wrapCannotDM(err)
+	}
+	return message, nil
+}
+
+// wrapCannotDM wraps the error with discord.ErrCannotDMUser if discord refused the direct message because of the privacy settings of the user.
+func wrapCannotDM(err error) error {
+	var restErr *rest.Error
+	if !errors.As(err, &restErr) {
+		return err
+	}
+	var body struct {
+		Code int `json:"code"`
+	}
+	if json.Unmarshal(restErr.RsBody, &body) == nil && body.Code == jsonErrorCodeCannotDMUser {
+		return fmt.Errorf("%w: %s", discord.ErrCannotDMUser, err)
+	}
+	return err
+}
@@ -0,0 +1,59 @@
+package moderation
+
+import (
+	"github.com/disgoorg/disgo/discord"
+)
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() *Config {
+	return &Config{
+		NoticeFunc:            DefaultNotice,
+		DeleteNoticeOnFailure: true,
+	}
+}
+
+// Config lets you configure your Moderator instance.
+type Config struct {
+	NoticeFunc            NoticeFunc
+	DeleteNoticeOnFailure bool
+}
+
+// NoticeFunc builds the direct message sent to a user before a moderation Action is executed.
+// guildName is the name of the guild if it is cached, else the guild id. reason may be empty.
+type NoticeFunc func(action Action, guildName string, reason string) discord.MessageCreate
+
+// DefaultNotice is the default NoticeFunc. It sends an embed naming the Action, the guild & the reason.
+func DefaultNotice(action Action, guildName string, reason string) discord.MessageCreate {
+	embed := discord.NewEmbedBuilder().
+		SetTitlef("You have been %s from %s", action.pastTense(), guildName)
+	if reason != "" {
+		embed.AddField("Reason", reason, false)
+	}
+	return discord.NewMessageCreateBuilder().
+		AddEmbeds(embed.Build()).
+		Build()
+}
+
+// ConfigOpt is a type alias for a function that takes a Config and is used to configure your Moderator.
+type ConfigOpt func(config *Config)
+
+// Apply applies the given ConfigOpt(s) to the Config
+func (c *Config) Apply(opts []ConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithNoticeFunc sets the NoticeFunc which builds the direct message sent before an Action. A nil NoticeFunc disables notices.
+func WithNoticeFunc(noticeFunc NoticeFunc) ConfigOpt {
+	return func(config *Config) {
+		config.NoticeFunc = noticeFunc
+	}
+}
+
+// WithDeleteNoticeOnFailure sets whether an already sent notice is deleted again if the Action fails. By default, this is true.
+func WithDeleteNoticeOnFailure(deleteNoticeOnFailure bool) ConfigOpt {
+	return func(config *Config) {
+		config.DeleteNoticeOnFailure = deleteNoticeOnFailure
+	}
+}
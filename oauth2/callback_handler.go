@@ -0,0 +1,79 @@
+package oauth2
+
+import (
+	"errors"
+	"net/http"
+)
+
+// ErrMissingCallbackParams is returned when the callback request is missing the code or state query parameter.
+var ErrMissingCallbackParams = errors.New("missing code or state query parameter")
+
+type (
+	// IdentifierFunc returns the identifier used to store the Session of the given request.
+	IdentifierFunc func(r *http.Request) string
+
+	// SessionFunc is called after a Session was successfully started in the callback.
+	SessionFunc func(w http.ResponseWriter, r *http.Request, identifier string, session Session)
+
+	// ErrorFunc is called when starting a Session failed in the callback.
+	ErrorFunc func(w http.ResponseWriter, r *http.Request, err error)
+)
+
+// NewCallbackHandler returns a http.Handler for the redirect URI of your application which starts a Session from the code & state query parameters.
+// If identifierFunc is nil, the state is used as identifier. If errorFunc is nil, a plain 400 or 500 response is written.
+func NewCallbackHandler(client Client, identifierFunc IdentifierFunc, sessionFunc SessionFunc, errorFunc ErrorFunc) http.Handler {
+	if errorFunc == nil {
+		errorFunc = defaultErrorFunc
+	}
+	return &callbackHandler{
+		client:         client,
+		identifierFunc: identifierFunc,
+		sessionFunc:    sessionFunc,
+		errorFunc:      errorFunc,
+	}
+}
+
+type callbackHandler struct {
+	client         Client
+	identifierFunc IdentifierFunc
+	sessionFunc    SessionFunc
+	errorFunc      ErrorFunc
+}
+
+func (h *callbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if errStr := query.Get("error"); errStr != "" {
+		if description := query.Get("error_description"); description != "" {
+			errStr += ": " + description
+		}
+		h.errorFunc(w, r, errors.New(errStr))
+		return
+	}
+
+	code := query.Get("code")
+	state := query.Get("state")
+	if code == "" || state == "" {
+		h.errorFunc(w, r, ErrMissingCallbackParams)
+		return
+	}
+
+	identifier := state
+	if h.identifierFunc != nil {
+		identifier = h.identifierFunc(r)
+	}
+
+	session, err := h.client.StartSession(code, state, identifier)
+	if err != nil {
+		h.errorFunc(w, r, err)
+		return
+	}
+	h.sessionFunc(w, r, identifier, session)
+}
+
+func defaultErrorFunc(w http.ResponseWriter, _ *http.Request, err error) {
+	status := http.StatusInternalServerError
+	if errors.Is(err, ErrStateNotFound) || errors.Is(err, ErrMissingCallbackParams) {
+		status = http.StatusBadRequest
+	}
+	http.Error(w, err.Error(), status)
+}
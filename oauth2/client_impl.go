@@ -58,7 +58,7 @@ func (c *clientImpl) GenerateAuthorizationURLState(redirectURI string, permissio
 		"state":         state,
 	}
 	if permissions != discord.PermissionsNone {
-		values["permissions"] = permissions
+		values["permissions"] = int64(permissions)
 	}
 	if guildID != 0 {
 		values["guild_id"] = guildID
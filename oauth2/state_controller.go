@@ -18,7 +18,7 @@ func NewStateController(opts ...StateControllerConfigOpt) StateController {
 	config := DefaultStateControllerConfig()
 	config.Apply(opts)
 
-	states := newTTLMap(config.MaxTTL)
+	states := newTTLMap(config.MaxTTL, config.Clock)
 	for state, url := range config.States {
 		states.put(state, url)
 	}
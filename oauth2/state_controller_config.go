@@ -3,6 +3,7 @@ package oauth2
 import (
 	"time"
 
+	"github.com/disgoorg/disgo/clock"
 	"github.com/disgoorg/disgo/internal/insecurerandstr"
 )
 
@@ -12,6 +13,7 @@ func DefaultStateControllerConfig() *StateControllerConfig {
 		States:       map[string]string{},
 		NewStateFunc: func() string { return insecurerandstr.RandStr(32) },
 		MaxTTL:       time.Hour,
+		Clock:        clock.Default,
 	}
 }
 
@@ -20,6 +22,7 @@ type StateControllerConfig struct {
 	States       map[string]string
 	NewStateFunc func() string
 	MaxTTL       time.Duration
+	Clock        clock.Clock
 }
 
 // StateControllerConfigOpt is used to pass optional parameters to NewStateController
@@ -52,3 +55,10 @@ func WithMaxTTL(maxTTL time.Duration) StateControllerConfigOpt {
 		config.MaxTTL = maxTTL
 	}
 }
+
+// WithStateControllerClock sets the clock.Clock used to expire states
+func WithStateControllerClock(clock clock.Clock) StateControllerConfigOpt {
+	return func(config *StateControllerConfig) {
+		config.Clock = clock
+	}
+}
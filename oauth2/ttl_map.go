@@ -3,27 +3,30 @@ package oauth2
 import (
 	"sync"
 	"time"
+
+	"github.com/disgoorg/disgo/clock"
 )
 
 type value struct {
 	value      string
-	insertedAt int64
+	insertedAt time.Time
 }
 
-func newTTLMap(maxTTL time.Duration) *ttlMap {
+func newTTLMap(maxTTL time.Duration, clock clock.Clock) *ttlMap {
 	m := &ttlMap{
 		maxTTL: maxTTL,
+		clock:  clock,
 		m:      map[string]value{},
 	}
 
 	if maxTTL > 0 {
 		go func() {
-			ticker := time.NewTicker(10 * time.Second)
+			ticker := clock.NewTicker(10 * time.Second)
 			defer ticker.Stop()
-			for now := range ticker.C {
+			for now := range ticker.C() {
 				m.mu.Lock()
 				for k, v := range m.m {
-					if now.Unix()-v.insertedAt > int64(m.maxTTL) {
+					if now.Sub(v.insertedAt) > m.maxTTL {
 						delete(m.m, k)
 					}
 				}
@@ -37,13 +40,14 @@ func newTTLMap(maxTTL time.Duration) *ttlMap {
 
 type ttlMap struct {
 	maxTTL time.Duration
+	clock  clock.Clock
 	m      map[string]value
 	mu     sync.Mutex
 }
 
 func (m *ttlMap) put(k string, v string) {
 	m.mu.Lock()
-	m.m[k] = value{v, time.Now().Unix()}
+	m.m[k] = value{v, m.clock.Now()}
 	m.mu.Unlock()
 }
 
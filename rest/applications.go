@@ -13,6 +13,9 @@ func NewApplications(client Client) Applications {
 }
 
 type Applications interface {
+	// GetCurrentApplication returns the discord.Application of the bot including its discord.ApplicationFlags and approximate counts.
+	GetCurrentApplication(opts ...RequestOpt) (*discord.Application, error)
+
 	GetGlobalCommands(applicationID snowflake.ID, withLocalizations bool, opts ...RequestOpt) ([]discord.ApplicationCommand, error)
 	GetGlobalCommand(applicationID snowflake.ID, commandID snowflake.ID, opts ...RequestOpt) (discord.ApplicationCommand, error)
 	CreateGlobalCommand(applicationID snowflake.ID, commandCreate discord.ApplicationCommandCreate, opts ...RequestOpt) (discord.ApplicationCommand, error)
@@ -35,6 +38,16 @@ type applicationsImpl struct {
 	client Client
 }
 
+func (s *applicationsImpl) GetCurrentApplication(opts ...RequestOpt) (application *discord.Application, err error) {
+	var compiledRoute *route.CompiledAPIRoute
+	compiledRoute, err = route.GetCurrentApplication.Compile(nil)
+	if err != nil {
+		return
+	}
+	err = s.client.Do(compiledRoute, nil, &application, opts...)
+	return
+}
+
 func (s *applicationsImpl) GetGlobalCommands(applicationID snowflake.ID, withLocalizations bool, opts ...RequestOpt) (commands []discord.ApplicationCommand, err error) {
 	var compiledRoute *route.CompiledAPIRoute
 	compiledRoute, err = route.GetGlobalCommands.Compile(route.QueryValues{"with_localizations": withLocalizations}, applicationID)
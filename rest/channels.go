@@ -1,6 +1,9 @@
 package rest
 
 import (
+	"context"
+	"net/url"
+
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/rest/route"
 	"github.com/disgoorg/snowflake/v2"
@@ -16,6 +19,12 @@ type Channels interface {
 	GetChannel(channelID snowflake.ID, opts ...RequestOpt) (discord.Channel, error)
 	UpdateChannel(channelID snowflake.ID, channelUpdate discord.ChannelUpdate, opts ...RequestOpt) (discord.Channel, error)
 	DeleteChannel(channelID snowflake.ID, opts ...RequestOpt) error
+	// SyncPermissionsWithCategory copies the discord.PermissionOverwrites of the parent category to the given channel.
+	// It returns discord.ErrChannelNoParent if the channel has no parent.
+	SyncPermissionsWithCategory(channelID snowflake.ID, opts ...RequestOpt) (discord.Channel, error)
+	// SetVoiceChannelStatus sets the status of a discord.GuildVoiceChannel the bot is connected to. An empty status clears it.
+	// This requires discord.PermissionSetVoiceChannelStatus and additionally discord.PermissionManageChannels if the bot is not connected to the channel.
+	SetVoiceChannelStatus(channelID snowflake.ID, status string, opts ...RequestOpt) error
 
 	GetWebhooks(channelID snowflake.ID, opts ...RequestOpt) ([]discord.Webhook, error)
 	CreateWebhook(channelID snowflake.ID, webhookCreate discord.WebhookCreate, opts ...RequestOpt) (*discord.IncomingWebhook, error)
@@ -27,8 +36,17 @@ type Channels interface {
 
 	SendTyping(channelID snowflake.ID, opts ...RequestOpt) error
 
+	// RefreshAttachmentURLs returns new signed URLs for the given expired discord.Attachment URLs.
+	RefreshAttachmentURLs(urls []string, opts ...RequestOpt) ([]discord.RefreshedAttachmentURL, error)
+	// RefreshAttachmentURL returns a new signed URL for the given expired discord.Attachment URL.
+	// It can be used as discord.AttachmentURLRefresher.
+	RefreshAttachmentURL(ctx context.Context, url string) (string, error)
+
 	GetMessage(channelID snowflake.ID, messageID snowflake.ID, opts ...RequestOpt) (*discord.Message, error)
 	GetMessages(channelID snowflake.ID, around snowflake.ID, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) ([]discord.Message, error)
+	// GetMessagesPage returns a Page iterating over the messages of a channel starting after/before startID.
+	// Use Page.StartAt, Page.Since & Page.Until to paginate by time.
+	GetMessagesPage(channelID snowflake.ID, startID snowflake.ID, limit int, opts ...RequestOpt) Page[discord.Message]
 	CreateMessage(channelID snowflake.ID, messageCreate discord.MessageCreate, opts ...RequestOpt) (*discord.Message, error)
 	UpdateMessage(channelID snowflake.ID, messageID snowflake.ID, messageUpdate discord.MessageUpdate, opts ...RequestOpt) (*discord.Message, error)
 	DeleteMessage(channelID snowflake.ID, messageID snowflake.ID, opts ...RequestOpt) error
@@ -88,13 +106,81 @@ func (s *channelImpl) DeleteChannel(channelID snowflake.ID, opts ...RequestOpt)
 	return s.client.Do(compiledRoute, nil, nil, opts...)
 }
 
+func (s *channelImpl) SetVoiceChannelStatus(channelID snowflake.ID, status string, opts ...RequestOpt) error {
+	compiledRoute, err := route.SetVoiceChannelStatus.Compile(nil, channelID)
+	if err != nil {
+		return err
+	}
+	return s.client.Do(compiledRoute, discord.VoiceChannelStatusUpdate{Status: status}, nil, opts...)
+}
+
+func (s *channelImpl) RefreshAttachmentURLs(urls []string, opts ...RequestOpt) (refreshedURLs []discord.RefreshedAttachmentURL, err error) {
+	var compiledRoute *route.CompiledAPIRoute
+	compiledRoute, err = route.RefreshAttachmentURLs.Compile(nil)
+	if err != nil {
+		return
+	}
+	var rs discord.RefreshAttachmentURLsResponse
+	err = s.client.Do(compiledRoute, discord.RefreshAttachmentURLs{AttachmentURLs: urls}, &rs, opts...)
+	if err == nil {
+		refreshedURLs = rs.RefreshedURLs
+	}
+	return
+}
+
+func (s *channelImpl) RefreshAttachmentURL(ctx context.Context, url string) (string, error) {
+	refreshedURLs, err := s.RefreshAttachmentURLs([]string{url}, WithCtx(ctx))
+	if err != nil {
+		return "", err
+	}
+	if len(refreshedURLs) == 0 {
+		return "", discord.ErrAttachmentURLExpired
+	}
+	return refreshedURLs[0].Refreshed, nil
+}
+
+func (s *channelImpl) SyncPermissionsWithCategory(channelID snowflake.ID, opts ...RequestOpt) (discord.Channel, error) {
+	channel, err := s.GetChannel(channelID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	guildChannel, ok := channel.(discord.GuildChannel)
+	if !ok || guildChannel.ParentID() == nil {
+		return nil, discord.ErrChannelNoParent
+	}
+	if _, ok = channel.(discord.GuildThread); ok {
+		return nil, discord.ErrChannelNoParent
+	}
+
+	parent, err := s.GetChannel(*guildChannel.ParentID(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	category, ok := parent.(discord.GuildCategoryChannel)
+	if !ok {
+		return nil, discord.ErrChannelNoParent
+	}
+
+	overwrites := category.PermissionOverwrites()
+	if overwrites == nil {
+		overwrites = discord.PermissionOverwrites{}
+	}
+	return s.UpdateChannel(channelID, discord.GuildChannelPermissionOverwritesUpdate{PermissionOverwrites: overwrites}, opts...)
+}
+
 func (s *channelImpl) GetWebhooks(channelID snowflake.ID, opts ...RequestOpt) (webhooks []discord.Webhook, err error) {
 	var compiledRoute *route.CompiledAPIRoute
 	compiledRoute, err = route.GetChannelWebhooks.Compile(nil, channelID)
 	if err != nil {
 		return
 	}
-	err = s.client.Do(compiledRoute, nil, &webhooks, opts...)
+	var unmarshalWebhooks []discord.UnmarshalWebhook
+	if err = s.client.Do(compiledRoute, nil, &unmarshalWebhooks, opts...); err == nil {
+		webhooks = make([]discord.Webhook, len(unmarshalWebhooks))
+		for i := range unmarshalWebhooks {
+			webhooks[i] = unmarshalWebhooks[i].Webhook
+		}
+	}
 	return
 }
 
@@ -186,12 +272,29 @@ func (s *channelImpl) GetMessages(channelID snowflake.ID, around snowflake.ID, b
 	return
 }
 
+func (s *channelImpl) GetMessagesPage(channelID snowflake.ID, startID snowflake.ID, limit int, opts ...RequestOpt) Page[discord.Message] {
+	return Page[discord.Message]{
+		getItemsFunc: func(before snowflake.ID, after snowflake.ID) ([]discord.Message, error) {
+			return s.GetMessages(channelID, 0, before, after, limit, opts...)
+		},
+		getIDFunc: func(message discord.Message) snowflake.ID {
+			return message.ID
+		},
+		firstID: startID,
+		lastID:  startID,
+	}
+}
+
 func (s *channelImpl) CreateMessage(channelID snowflake.ID, messageCreate discord.MessageCreate, opts ...RequestOpt) (message *discord.Message, err error) {
 	var compiledRoute *route.CompiledAPIRoute
 	compiledRoute, err = route.CreateMessage.Compile(nil, channelID)
 	if err != nil {
 		return
 	}
+	if messageCreate.EnforceNonce && messageCreate.Nonce == "" {
+		// generate the nonce once, so retries of this request are de-duplicated by discord
+		messageCreate.Nonce = discord.NewNonce()
+	}
 	body, err := messageCreate.ToBody()
 	if err != nil {
 		return
@@ -242,7 +345,7 @@ func (s *channelImpl) CrosspostMessage(channelID snowflake.ID, messageID snowfla
 
 func (s *channelImpl) GetReactions(channelID snowflake.ID, messageID snowflake.ID, emoji string, opts ...RequestOpt) (users []discord.User, err error) {
 	var compiledRoute *route.CompiledAPIRoute
-	compiledRoute, err = route.GetReactions.Compile(nil, channelID, messageID, emoji)
+	compiledRoute, err = route.GetReactions.Compile(nil, channelID, messageID, reactionParam(emoji))
 	if err != nil {
 		return
 	}
@@ -251,7 +354,7 @@ func (s *channelImpl) GetReactions(channelID snowflake.ID, messageID snowflake.I
 }
 
 func (s *channelImpl) AddReaction(channelID snowflake.ID, messageID snowflake.ID, emoji string, opts ...RequestOpt) error {
-	compiledRoute, err := route.AddReaction.Compile(nil, channelID, messageID, emoji)
+	compiledRoute, err := route.AddReaction.Compile(nil, channelID, messageID, reactionParam(emoji))
 	if err != nil {
 		return err
 	}
@@ -259,7 +362,7 @@ func (s *channelImpl) AddReaction(channelID snowflake.ID, messageID snowflake.ID
 }
 
 func (s *channelImpl) RemoveOwnReaction(channelID snowflake.ID, messageID snowflake.ID, emoji string, opts ...RequestOpt) error {
-	compiledRoute, err := route.RemoveOwnReaction.Compile(nil, channelID, messageID, emoji)
+	compiledRoute, err := route.RemoveOwnReaction.Compile(nil, channelID, messageID, reactionParam(emoji))
 	if err != nil {
 		return err
 	}
@@ -267,7 +370,7 @@ func (s *channelImpl) RemoveOwnReaction(channelID snowflake.ID, messageID snowfl
 }
 
 func (s *channelImpl) RemoveUserReaction(channelID snowflake.ID, messageID snowflake.ID, emoji string, userID snowflake.ID, opts ...RequestOpt) error {
-	compiledRoute, err := route.RemoveUserReaction.Compile(nil, channelID, messageID, emoji, userID)
+	compiledRoute, err := route.RemoveUserReaction.Compile(nil, channelID, messageID, reactionParam(emoji), userID)
 	if err != nil {
 		return err
 	}
@@ -283,7 +386,7 @@ func (s *channelImpl) RemoveAllReactions(channelID snowflake.ID, messageID snowf
 }
 
 func (s *channelImpl) RemoveAllReactionsForEmoji(channelID snowflake.ID, messageID snowflake.ID, emoji string, opts ...RequestOpt) error {
-	compiledRoute, err := route.RemoveAllReactionsForEmoji.Compile(nil, channelID, messageID, emoji)
+	compiledRoute, err := route.RemoveAllReactionsForEmoji.Compile(nil, channelID, messageID, reactionParam(emoji))
 	if err != nil {
 		return err
 	}
@@ -325,3 +428,12 @@ func (s *channelImpl) Follow(channelID snowflake.ID, targetChannelID snowflake.I
 	err = s.client.Do(compiledRoute, discord.FollowChannel{ChannelID: targetChannelID}, &followedChannel, opts...)
 	return
 }
+
+// reactionParam normalizes the given emoji to the format the API expects and escapes it for the url path.
+// Already escaped emojis are unescaped first, so they don't get escaped twice.
+func reactionParam(emoji string) string {
+	if unescaped, err := url.PathUnescape(emoji); err == nil {
+		emoji = unescaped
+	}
+	return url.PathEscape(discord.NormalizeReaction(emoji))
+}
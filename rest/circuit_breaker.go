@@ -0,0 +1,141 @@
+package rest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+// CircuitState is the state of a CircuitBreaker for a host.
+type CircuitState int
+
+const (
+	// CircuitStateClosed lets all requests through.
+	CircuitStateClosed CircuitState = iota
+	// CircuitStateOpen fails all requests fast with discord.ErrCircuitOpen.
+	CircuitStateOpen
+	// CircuitStateHalfOpen lets a single probe request through to check whether the host recovered.
+	CircuitStateHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitStateClosed:
+		return "closed"
+	case CircuitStateOpen:
+		return "open"
+	case CircuitStateHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreaker stops requests to a host after sustained 5xx responses or connection failures, so an API outage fails fast instead of piling up requests waiting for timeouts.
+type CircuitBreaker interface {
+	// Allow returns discord.ErrCircuitOpen if requests to the host should not be made right now.
+	Allow(host string) error
+
+	// Report reports the outcome of a request to the host. success should be false for connection failures and 5xx responses.
+	Report(host string, success bool)
+
+	// State returns the CircuitState of the host.
+	State(host string) CircuitState
+}
+
+var _ CircuitBreaker = (*circuitBreakerImpl)(nil)
+
+// NewCircuitBreaker returns a new default CircuitBreaker with the given CircuitBreakerConfigOpt(s).
+func NewCircuitBreaker(opts ...CircuitBreakerConfigOpt) CircuitBreaker {
+	config := DefaultCircuitBreakerConfig()
+	config.Apply(opts)
+
+	return &circuitBreakerImpl{
+		config:   *config,
+		circuits: map[string]*circuit{},
+	}
+}
+
+type circuitBreakerImpl struct {
+	config CircuitBreakerConfig
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+type circuit struct {
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	probeAt  time.Time
+}
+
+func (b *circuitBreakerImpl) getCircuit(host string) *circuit {
+	c, ok := b.circuits[host]
+	if !ok {
+		c = &circuit{}
+		b.circuits[host] = c
+	}
+	return c
+}
+
+func (b *circuitBreakerImpl) Allow(host string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.getCircuit(host)
+
+	now := b.config.Clock.Now()
+	switch c.state {
+	case CircuitStateOpen:
+		if now.Sub(c.openedAt) < b.config.OpenTimeout {
+			return discord.ErrCircuitOpen
+		}
+		b.config.Logger.Debugf("circuit for %s is half-open, sending probe request", host)
+		c.state = CircuitStateHalfOpen
+		c.probeAt = now
+		return nil
+
+	case CircuitStateHalfOpen:
+		// only one probe at a time, but don't wait forever on a probe which never reported back
+		if now.Sub(c.probeAt) < b.config.OpenTimeout {
+			return discord.ErrCircuitOpen
+		}
+		c.probeAt = now
+		return nil
+
+	default:
+		return nil
+	}
+}
+
+func (b *circuitBreakerImpl) Report(host string, success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.getCircuit(host)
+
+	if success {
+		if c.state != CircuitStateClosed {
+			b.config.Logger.Infof("circuit for %s closed, host recovered", host)
+		}
+		c.state = CircuitStateClosed
+		c.failures = 0
+		return
+	}
+
+	c.failures++
+	if c.state == CircuitStateHalfOpen || (c.state == CircuitStateClosed && c.failures >= b.config.FailureThreshold) {
+		b.config.Logger.Warnf("circuit for %s opened after %d failures", host, c.failures)
+		c.state = CircuitStateOpen
+		c.openedAt = b.config.Clock.Now()
+	}
+}
+
+func (b *circuitBreakerImpl) State(host string) CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if c, ok := b.circuits[host]; ok {
+		return c.state
+	}
+	return CircuitStateClosed
+}
@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/disgoorg/disgo/clock"
+	"github.com/disgoorg/log"
+)
+
+// DefaultCircuitBreakerConfig is the configuration which is used by default.
+func DefaultCircuitBreakerConfig() *CircuitBreakerConfig {
+	return &CircuitBreakerConfig{
+		Logger:           log.Default(),
+		FailureThreshold: 5,
+		OpenTimeout:      30 * time.Second,
+		Clock:            clock.Default,
+	}
+}
+
+// CircuitBreakerConfig is the configuration for the CircuitBreaker.
+type CircuitBreakerConfig struct {
+	Logger           log.Logger
+	FailureThreshold int
+	OpenTimeout      time.Duration
+	Clock            clock.Clock
+}
+
+// CircuitBreakerConfigOpt can be used to supply optional parameters to NewCircuitBreaker.
+type CircuitBreakerConfigOpt func(config *CircuitBreakerConfig)
+
+// Apply applies the given CircuitBreakerConfigOpt(s) to the CircuitBreakerConfig.
+func (c *CircuitBreakerConfig) Apply(opts []CircuitBreakerConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithCircuitBreakerLogger sets the logger for the CircuitBreaker.
+func WithCircuitBreakerLogger(logger log.Logger) CircuitBreakerConfigOpt {
+	return func(config *CircuitBreakerConfig) {
+		config.Logger = logger
+	}
+}
+
+// WithFailureThreshold sets the number of consecutive 5xx responses or connection failures after which the CircuitBreaker opens.
+func WithFailureThreshold(failureThreshold int) CircuitBreakerConfigOpt {
+	return func(config *CircuitBreakerConfig) {
+		config.FailureThreshold = failureThreshold
+	}
+}
+
+// WithOpenTimeout sets how long the CircuitBreaker stays open before it lets a probe request through.
+func WithOpenTimeout(openTimeout time.Duration) CircuitBreakerConfigOpt {
+	return func(config *CircuitBreakerConfig) {
+		config.OpenTimeout = openTimeout
+	}
+}
+
+// WithCircuitBreakerClock sets the clock.Clock the CircuitBreaker uses to time the OpenTimeout.
+func WithCircuitBreakerClock(clock clock.Clock) CircuitBreakerConfigOpt {
+	return func(config *CircuitBreakerConfig) {
+		config.Clock = clock
+	}
+}
@@ -0,0 +1,36 @@
+package rest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	const host = "discord.com"
+	breaker := NewCircuitBreaker(WithFailureThreshold(2), WithOpenTimeout(20*time.Millisecond))
+
+	assert.NoError(t, breaker.Allow(host))
+	breaker.Report(host, false)
+	assert.Equal(t, CircuitStateClosed, breaker.State(host))
+	breaker.Report(host, false)
+	assert.Equal(t, CircuitStateOpen, breaker.State(host))
+	assert.ErrorIs(t, breaker.Allow(host), discord.ErrCircuitOpen)
+
+	// a failed probe opens the circuit again
+	time.Sleep(25 * time.Millisecond)
+	assert.NoError(t, breaker.Allow(host))
+	assert.Equal(t, CircuitStateHalfOpen, breaker.State(host))
+	assert.ErrorIs(t, breaker.Allow(host), discord.ErrCircuitOpen)
+	breaker.Report(host, false)
+	assert.Equal(t, CircuitStateOpen, breaker.State(host))
+
+	// a successful probe closes it
+	time.Sleep(25 * time.Millisecond)
+	assert.NoError(t, breaker.Allow(host))
+	breaker.Report(host, true)
+	assert.Equal(t, CircuitStateClosed, breaker.State(host))
+	assert.NoError(t, breaker.Allow(host))
+}
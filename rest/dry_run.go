@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/rest/route"
+)
+
+// DryRunRequest is a mutating request which was not sent to discord because the Client runs in dry run mode.
+type DryRunRequest struct {
+	Method      route.Method
+	URL         string
+	ContentType string
+	Body        []byte
+	Time        time.Time
+}
+
+// DryRunFunc is called for every DryRunRequest.
+type DryRunFunc func(rq DryRunRequest)
+
+// NewDryRunRecorder returns a new empty DryRunRecorder.
+func NewDryRunRecorder() *DryRunRecorder {
+	return &DryRunRecorder{}
+}
+
+// DryRunRecorder records all DryRunRequest(s). Pass DryRunRecorder.Record to WithDryRun.
+type DryRunRecorder struct {
+	mu       sync.Mutex
+	requests []DryRunRequest
+}
+
+// Record records the given DryRunRequest.
+func (r *DryRunRecorder) Record(rq DryRunRequest) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, rq)
+}
+
+// Requests returns a copy of all recorded DryRunRequest(s) in the order they were made.
+func (r *DryRunRecorder) Requests() []DryRunRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	requests := make([]DryRunRequest, len(r.requests))
+	copy(requests, r.requests)
+	return requests
+}
+
+// Reset removes all recorded DryRunRequest(s).
+func (r *DryRunRecorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = nil
+}
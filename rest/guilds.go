@@ -40,6 +40,9 @@ type Guilds interface {
 
 	GetAllWebhooks(guildID snowflake.ID, opts ...RequestOpt) ([]discord.Webhook, error)
 
+	GetMemberVerification(guildID snowflake.ID, opts ...RequestOpt) (*discord.MemberVerification, error)
+	UpdateMemberVerification(guildID snowflake.ID, memberVerificationUpdate discord.MemberVerificationUpdate, opts ...RequestOpt) (*discord.MemberVerification, error)
+
 	GetAuditLog(guildID snowflake.ID, userID snowflake.ID, actionType discord.AuditLogEvent, before snowflake.ID, limit int, opts ...RequestOpt) (*discord.AuditLog, error)
 }
 
@@ -119,7 +122,14 @@ func (s *guildImpl) GetGuildChannels(guildID snowflake.ID, opts ...RequestOpt) (
 	if err != nil {
 		return
 	}
-	err = s.client.Do(compiledRoute, nil, &channels, opts...)
+	var chs []discord.UnmarshalChannel
+	err = s.client.Do(compiledRoute, nil, &chs, opts...)
+	if err == nil {
+		channels = make([]discord.GuildChannel, len(chs))
+		for i := range chs {
+			channels[i] = chs[i].Channel.(discord.GuildChannel)
+		}
+	}
 	return
 }
 
@@ -259,7 +269,33 @@ func (s *guildImpl) GetAllWebhooks(guildID snowflake.ID, opts ...RequestOpt) (we
 	if err != nil {
 		return
 	}
-	err = s.client.Do(compiledRoute, nil, &webhooks, opts...)
+	var unmarshalWebhooks []discord.UnmarshalWebhook
+	if err = s.client.Do(compiledRoute, nil, &unmarshalWebhooks, opts...); err == nil {
+		webhooks = make([]discord.Webhook, len(unmarshalWebhooks))
+		for i := range unmarshalWebhooks {
+			webhooks[i] = unmarshalWebhooks[i].Webhook
+		}
+	}
+	return
+}
+
+func (s *guildImpl) GetMemberVerification(guildID snowflake.ID, opts ...RequestOpt) (memberVerification *discord.MemberVerification, err error) {
+	var compiledRoute *route.CompiledAPIRoute
+	compiledRoute, err = route.GetMemberVerification.Compile(nil, guildID)
+	if err != nil {
+		return
+	}
+	err = s.client.Do(compiledRoute, nil, &memberVerification, opts...)
+	return
+}
+
+func (s *guildImpl) UpdateMemberVerification(guildID snowflake.ID, memberVerificationUpdate discord.MemberVerificationUpdate, opts ...RequestOpt) (memberVerification *discord.MemberVerification, err error) {
+	var compiledRoute *route.CompiledAPIRoute
+	compiledRoute, err = route.UpdateMemberVerification.Compile(nil, guildID)
+	if err != nil {
+		return
+	}
+	err = s.client.Do(compiledRoute, memberVerificationUpdate, &memberVerification, opts...)
 	return
 }
 
@@ -28,13 +28,18 @@ type interactionImpl struct {
 	client Client
 }
 
+// do sends the request with PriorityHigh, so interactions are answered in time even when other requests are queued up. It can be overridden with WithPriority.
+func (s *interactionImpl) do(compiledRoute *route.CompiledAPIRoute, rqBody any, rsBody any, opts ...RequestOpt) error {
+	return s.client.Do(compiledRoute, rqBody, rsBody, append([]RequestOpt{WithPriority(PriorityHigh)}, opts...)...)
+}
+
 func (s *interactionImpl) GetInteractionResponse(interactionID snowflake.ID, interactionToken string, opts ...RequestOpt) (message *discord.Message, err error) {
 	var compiledRoute *route.CompiledAPIRoute
 	compiledRoute, err = route.GetInteractionResponse.Compile(nil, interactionID, interactionToken)
 	if err != nil {
 		return
 	}
-	err = s.client.Do(compiledRoute, nil, &message, opts...)
+	err = s.do(compiledRoute, nil, &message, opts...)
 	return
 }
 
@@ -49,7 +54,7 @@ func (s *interactionImpl) CreateInteractionResponse(interactionID snowflake.ID,
 		return err
 	}
 
-	return s.client.Do(compiledRoute, body, nil, opts...)
+	return s.do(compiledRoute, body, nil, opts...)
 }
 
 func (s *interactionImpl) UpdateInteractionResponse(applicationID snowflake.ID, interactionToken string, messageUpdate discord.MessageUpdate, opts ...RequestOpt) (message *discord.Message, err error) {
@@ -64,7 +69,7 @@ func (s *interactionImpl) UpdateInteractionResponse(applicationID snowflake.ID,
 		return
 	}
 
-	err = s.client.Do(compiledRoute, body, &message, opts...)
+	err = s.do(compiledRoute, body, &message, opts...)
 	return
 }
 
@@ -73,7 +78,7 @@ func (s *interactionImpl) DeleteInteractionResponse(applicationID snowflake.ID,
 	if err != nil {
 		return err
 	}
-	return s.client.Do(compiledRoute, nil, nil, opts...)
+	return s.do(compiledRoute, nil, nil, opts...)
 }
 
 func (s *interactionImpl) GetFollowupMessage(applicationID snowflake.ID, interactionToken string, messageID snowflake.ID, opts ...RequestOpt) (message *discord.Message, err error) {
@@ -83,7 +88,7 @@ func (s *interactionImpl) GetFollowupMessage(applicationID snowflake.ID, interac
 		return
 	}
 
-	err = s.client.Do(compiledRoute, nil, &message, opts...)
+	err = s.do(compiledRoute, nil, &message, opts...)
 	return
 }
 
@@ -99,7 +104,7 @@ func (s *interactionImpl) CreateFollowupMessage(applicationID snowflake.ID, inte
 		return
 	}
 
-	err = s.client.Do(compiledRoute, body, &message, opts...)
+	err = s.do(compiledRoute, body, &message, opts...)
 	return
 }
 
@@ -115,7 +120,7 @@ func (s *interactionImpl) UpdateFollowupMessage(applicationID snowflake.ID, inte
 		return
 	}
 
-	err = s.client.Do(compiledRoute, body, &message, opts...)
+	err = s.do(compiledRoute, body, &message, opts...)
 	return
 }
 
@@ -124,5 +129,5 @@ func (s *interactionImpl) DeleteFollowupMessage(applicationID snowflake.ID, inte
 	if err != nil {
 		return err
 	}
-	return s.client.Do(compiledRoute, nil, nil, opts...)
+	return s.do(compiledRoute, nil, nil, opts...)
 }
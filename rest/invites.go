@@ -13,20 +13,31 @@ func NewInvites(client Client) Invites {
 }
 
 type Invites interface {
-	GetInvite(code string, opts ...RequestOpt) (*discord.Invite, error)
-	CreateInvite(channelID snowflake.ID, inviteCreate discord.InviteCreate, opts ...RequestOpt) (*discord.Invite, error)
+	// GetInvite returns the discord.Invite of the given code. withCounts includes the approximate member & presence counts. guildScheduledEventID is optional.
+	GetInvite(code string, withCounts bool, guildScheduledEventID snowflake.ID, opts ...RequestOpt) (*discord.Invite, error)
+	// ResolveInvite works like GetInvite but accepts invite urls like https://discord.gg/code as well.
+	ResolveInvite(invite string, withCounts bool, opts ...RequestOpt) (*discord.Invite, error)
+	CreateInvite(channelID snowflake.ID, inviteCreate discord.InviteCreate, opts ...RequestOpt) (*discord.ExtendedInvite, error)
 	DeleteInvite(code string, opts ...RequestOpt) (*discord.Invite, error)
-	GetGuildInvites(guildID snowflake.ID, opts ...RequestOpt) ([]discord.Invite, error)
-	GetChannelInvites(channelID snowflake.ID, opts ...RequestOpt) ([]discord.Invite, error)
+	GetGuildInvites(guildID snowflake.ID, opts ...RequestOpt) ([]discord.ExtendedInvite, error)
+	GetChannelInvites(channelID snowflake.ID, opts ...RequestOpt) ([]discord.ExtendedInvite, error)
+	GetGuildVanityInvite(guildID snowflake.ID, opts ...RequestOpt) (*discord.VanityInvite, error)
 }
 
 type inviteImpl struct {
 	client Client
 }
 
-func (s *inviteImpl) GetInvite(code string, opts ...RequestOpt) (invite *discord.Invite, err error) {
+func (s *inviteImpl) GetInvite(code string, withCounts bool, guildScheduledEventID snowflake.ID, opts ...RequestOpt) (invite *discord.Invite, err error) {
+	values := route.QueryValues{}
+	if withCounts {
+		values["with_counts"] = true
+	}
+	if guildScheduledEventID != 0 {
+		values["guild_scheduled_event_id"] = guildScheduledEventID
+	}
 	var compiledRoute *route.CompiledAPIRoute
-	compiledRoute, err = route.GetInvite.Compile(nil, code)
+	compiledRoute, err = route.GetInvite.Compile(values, code)
 	if err != nil {
 		return
 	}
@@ -34,7 +45,11 @@ func (s *inviteImpl) GetInvite(code string, opts ...RequestOpt) (invite *discord
 	return
 }
 
-func (s *inviteImpl) CreateInvite(channelID snowflake.ID, inviteCreate discord.InviteCreate, opts ...RequestOpt) (invite *discord.Invite, err error) {
+func (s *inviteImpl) ResolveInvite(invite string, withCounts bool, opts ...RequestOpt) (*discord.Invite, error) {
+	return s.GetInvite(discord.ParseInviteCode(invite), withCounts, 0, opts...)
+}
+
+func (s *inviteImpl) CreateInvite(channelID snowflake.ID, inviteCreate discord.InviteCreate, opts ...RequestOpt) (invite *discord.ExtendedInvite, err error) {
 	var compiledRoute *route.CompiledAPIRoute
 	compiledRoute, err = route.CreateInvite.Compile(nil, channelID)
 	if err != nil {
@@ -54,7 +69,7 @@ func (s *inviteImpl) DeleteInvite(code string, opts ...RequestOpt) (invite *disc
 	return
 }
 
-func (s *inviteImpl) GetGuildInvites(guildID snowflake.ID, opts ...RequestOpt) (invites []discord.Invite, err error) {
+func (s *inviteImpl) GetGuildInvites(guildID snowflake.ID, opts ...RequestOpt) (invites []discord.ExtendedInvite, err error) {
 	var compiledRoute *route.CompiledAPIRoute
 	compiledRoute, err = route.GetGuildInvites.Compile(nil, guildID)
 	if err != nil {
@@ -64,7 +79,7 @@ func (s *inviteImpl) GetGuildInvites(guildID snowflake.ID, opts ...RequestOpt) (
 	return
 }
 
-func (s *inviteImpl) GetChannelInvites(channelID snowflake.ID, opts ...RequestOpt) (invites []discord.Invite, err error) {
+func (s *inviteImpl) GetChannelInvites(channelID snowflake.ID, opts ...RequestOpt) (invites []discord.ExtendedInvite, err error) {
 	var compiledRoute *route.CompiledAPIRoute
 	compiledRoute, err = route.GetChannelInvites.Compile(nil, channelID)
 	if err != nil {
@@ -73,3 +88,13 @@ func (s *inviteImpl) GetChannelInvites(channelID snowflake.ID, opts ...RequestOp
 	err = s.client.Do(compiledRoute, nil, &invites, opts...)
 	return
 }
+
+func (s *inviteImpl) GetGuildVanityInvite(guildID snowflake.ID, opts ...RequestOpt) (invite *discord.VanityInvite, err error) {
+	var compiledRoute *route.CompiledAPIRoute
+	compiledRoute, err = route.GetGuildVanityURL.Compile(nil, guildID)
+	if err != nil {
+		return
+	}
+	err = s.client.Do(compiledRoute, nil, &invite, opts...)
+	return
+}
@@ -1,7 +1,10 @@
 package rest
 
 import (
+	"time"
+
 	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/json"
 	"github.com/disgoorg/disgo/rest/route"
 	"github.com/disgoorg/snowflake/v2"
 )
@@ -15,6 +18,9 @@ func NewMembers(client Client) Members {
 type Members interface {
 	GetMember(guildID snowflake.ID, userID snowflake.ID, opts ...RequestOpt) (*discord.Member, error)
 	GetMembers(guildID snowflake.ID, opts ...RequestOpt) ([]discord.Member, error)
+	// GetMembersPage returns a Page iterating over the members of a guild sorted by their user id starting after startID.
+	// Discord only supports fetching members after a user id, so Page.Previous always returns ErrNoMorePages.
+	GetMembersPage(guildID snowflake.ID, startID snowflake.ID, limit int, opts ...RequestOpt) Page[discord.Member]
 	SearchMembers(guildID snowflake.ID, query string, limit int, opts ...RequestOpt) ([]discord.Member, error)
 	AddMember(guildID snowflake.ID, userID snowflake.ID, memberAdd discord.MemberAdd, opts ...RequestOpt) (*discord.Member, error)
 	RemoveMember(guildID snowflake.ID, userID snowflake.ID, opts ...RequestOpt) error
@@ -27,6 +33,16 @@ type Members interface {
 
 	UpdateCurrentUserVoiceState(guildID snowflake.ID, currentUserVoiceStateUpdate discord.UserVoiceStateUpdate, opts ...RequestOpt) error
 	UpdateUserVoiceState(guildID snowflake.ID, userID snowflake.ID, userVoiceStateUpdate discord.UserVoiceStateUpdate, opts ...RequestOpt) error
+
+	// RequestToSpeak raises the hand of the bot in the given stage channel.
+	RequestToSpeak(guildID snowflake.ID, channelID snowflake.ID, opts ...RequestOpt) error
+	// CancelRequestToSpeak lowers the hand of the bot in the given stage channel.
+	CancelRequestToSpeak(guildID snowflake.ID, channelID snowflake.ID, opts ...RequestOpt) error
+	// SetSelfSuppressed moves the bot to the audience or the speakers of the given stage channel.
+	SetSelfSuppressed(guildID snowflake.ID, channelID snowflake.ID, suppress bool, opts ...RequestOpt) error
+	// SetUserSuppressed moves the user to the audience or the speakers of the given stage channel.
+	// Notice: Moving a user to the speakers requires the discord.PermissionMuteMembers.
+	SetUserSuppressed(guildID snowflake.ID, userID snowflake.ID, channelID snowflake.ID, suppress bool, opts ...RequestOpt) error
 }
 
 type memberImpl struct {
@@ -61,6 +77,40 @@ func (s *memberImpl) GetMembers(guildID snowflake.ID, opts ...RequestOpt) (membe
 	return
 }
 
+func (s *memberImpl) GetMembersPage(guildID snowflake.ID, startID snowflake.ID, limit int, opts ...RequestOpt) Page[discord.Member] {
+	return Page[discord.Member]{
+		getItemsFunc: func(before snowflake.ID, after snowflake.ID) (members []discord.Member, err error) {
+			if before != 0 {
+				return nil, ErrNoMorePages
+			}
+			values := route.QueryValues{}
+			if after != 0 {
+				values["after"] = after
+			}
+			if limit != 0 {
+				values["limit"] = limit
+			}
+			var compiledRoute *route.CompiledAPIRoute
+			compiledRoute, err = route.GetMembers.Compile(values, guildID)
+			if err != nil {
+				return
+			}
+			err = s.client.Do(compiledRoute, nil, &members, opts...)
+			if err == nil {
+				for i := range members {
+					members[i].GuildID = guildID
+				}
+			}
+			return
+		},
+		getIDFunc: func(member discord.Member) snowflake.ID {
+			return member.User.ID
+		},
+		firstID: startID,
+		lastID:  startID,
+	}
+}
+
 func (s *memberImpl) SearchMembers(guildID snowflake.ID, query string, limit int, opts ...RequestOpt) (members []discord.Member, err error) {
 	values := route.QueryValues{}
 	if query != "" {
@@ -158,3 +208,36 @@ func (s *memberImpl) UpdateUserVoiceState(guildID snowflake.ID, userID snowflake
 	}
 	return s.client.Do(compiledRoute, userVoiceStateUpdate, nil, opts...)
 }
+
+func (s *memberImpl) RequestToSpeak(guildID snowflake.ID, channelID snowflake.ID, opts ...RequestOpt) error {
+	return s.UpdateCurrentUserVoiceState(guildID, discord.UserVoiceStateUpdate{
+		ChannelID:               channelID,
+		RequestToSpeakTimestamp: json.NewOptional(time.Now()),
+	}, opts...)
+}
+
+func (s *memberImpl) CancelRequestToSpeak(guildID snowflake.ID, channelID snowflake.ID, opts ...RequestOpt) error {
+	return s.UpdateCurrentUserVoiceState(guildID, discord.UserVoiceStateUpdate{
+		ChannelID:               channelID,
+		RequestToSpeakTimestamp: json.OptionalNull[time.Time](),
+	}, opts...)
+}
+
+func (s *memberImpl) SetSelfSuppressed(guildID snowflake.ID, channelID snowflake.ID, suppress bool, opts ...RequestOpt) error {
+	update := discord.UserVoiceStateUpdate{
+		ChannelID: channelID,
+		Suppress:  &suppress,
+	}
+	if !suppress {
+		// clear the raised hand once we are a speaker
+		update.RequestToSpeakTimestamp = json.OptionalNull[time.Time]()
+	}
+	return s.UpdateCurrentUserVoiceState(guildID, update, opts...)
+}
+
+func (s *memberImpl) SetUserSuppressed(guildID snowflake.ID, userID snowflake.ID, channelID snowflake.ID, suppress bool, opts ...RequestOpt) error {
+	return s.UpdateUserVoiceState(guildID, userID, discord.UserVoiceStateUpdate{
+		ChannelID: channelID,
+		Suppress:  &suppress,
+	}, opts...)
+}
@@ -0,0 +1,99 @@
+package rest
+
+import (
+	"errors"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// ErrNoMorePages is returned by Page when there are no more pages to fetch.
+var ErrNoMorePages = errors.New("no more pages")
+
+// Page is used to iterate over snowflake.ID paginated endpoints.
+// Call Next or Previous to fetch the next or previous page. Items holds the entities of the current page.
+// If Next or Previous return false, Err holds the reason. When the end is reached Err is ErrNoMorePages.
+type Page[T any] struct {
+	getItemsFunc func(before snowflake.ID, after snowflake.ID) ([]T, error)
+	getIDFunc    func(t T) snowflake.ID
+
+	Items []T
+	Err   error
+
+	firstID snowflake.ID
+	lastID  snowflake.ID
+
+	since snowflake.ID
+	until snowflake.ID
+}
+
+// StartAt moves the Page to the given time. Next fetches the items created after t and Previous the items created before t.
+func (p *Page[T]) StartAt(t time.Time) *Page[T] {
+	id := snowflake.New(t)
+	p.firstID = id
+	p.lastID = id
+	return p
+}
+
+// Since only returns items created at or after t. Previous stops with ErrNoMorePages once it passes t.
+func (p *Page[T]) Since(t time.Time) *Page[T] {
+	p.since = snowflake.New(t)
+	return p
+}
+
+// Until only returns items created before t. Next stops with ErrNoMorePages once it passes t.
+func (p *Page[T]) Until(t time.Time) *Page[T] {
+	p.until = snowflake.New(t)
+	return p
+}
+
+// Next fetches the page after the current one and returns whether it was successful.
+func (p *Page[T]) Next() bool {
+	if p.Err != nil {
+		return false
+	}
+	return p.fetch(0, p.lastID)
+}
+
+// Previous fetches the page before the current one and returns whether it was successful.
+func (p *Page[T]) Previous() bool {
+	if p.Err != nil {
+		return false
+	}
+	return p.fetch(p.firstID, 0)
+}
+
+func (p *Page[T]) fetch(before snowflake.ID, after snowflake.ID) bool {
+	p.Items, p.Err = p.getItemsFunc(before, after)
+	if p.Err == nil && (p.since != 0 || p.until != 0) {
+		items := p.Items[:0]
+		for _, item := range p.Items {
+			id := p.getIDFunc(item)
+			if (p.since != 0 && id < p.since) || (p.until != 0 && id >= p.until) {
+				continue
+			}
+			items = append(items, item)
+		}
+		p.Items = items
+	}
+	if p.Err == nil && len(p.Items) == 0 {
+		p.Err = ErrNoMorePages
+	}
+	if p.Err != nil {
+		return false
+	}
+
+	// some endpoints return the newest items first, so we can't rely on the order
+	p.firstID = p.getIDFunc(p.Items[0])
+	p.lastID = p.firstID
+	for _, item := range p.Items[1:] {
+		id := p.getIDFunc(item)
+		if id < p.firstID {
+			p.firstID = id
+		}
+		if id > p.lastID {
+			p.lastID = id
+		}
+	}
+	return true
+}
@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"context"
+	"sync"
+)
+
+// Priority is the priority of a request when it waits for its rate limit bucket.
+// Under contention, waiting requests with a higher Priority acquire the bucket first.
+type Priority int
+
+const (
+	// PriorityBackground is meant for bulk jobs like mass bans or message purges.
+	PriorityBackground Priority = iota - 1
+	// PriorityNormal is the default Priority of requests.
+	PriorityNormal
+	// PriorityHigh is used for interaction responses & followups by default.
+	PriorityHigh
+)
+
+type priorityKey struct{}
+
+// ContextWithPriority returns a copy of the context.Context with the given Priority.
+func ContextWithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFromContext returns the Priority of the context.Context or PriorityNormal if none is set.
+// Custom RateLimiter(s) can use this in RateLimiter.WaitBucket.
+func PriorityFromContext(ctx context.Context) Priority {
+	if priority, ok := ctx.Value(priorityKey{}).(Priority); ok {
+		return priority
+	}
+	return PriorityNormal
+}
+
+func (p Priority) index() int {
+	switch {
+	case p < PriorityNormal:
+		return 0
+	case p > PriorityNormal:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// priorityMutex is a context aware mutex which hands the lock to the waiter with the highest Priority first.
+// Waiters with the same Priority acquire the lock in FIFO order.
+type priorityMutex struct {
+	mu      sync.Mutex
+	locked  bool
+	waiters [3][]chan struct{}
+}
+
+func (m *priorityMutex) CLock(ctx context.Context, priority Priority) error {
+	m.mu.Lock()
+	if !m.locked {
+		m.locked = true
+		m.mu.Unlock()
+		return nil
+	}
+	i := priority.index()
+	ch := make(chan struct{})
+	m.waiters[i] = append(m.waiters[i], ch)
+	m.mu.Unlock()
+
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		m.mu.Lock()
+		for j, waiter := range m.waiters[i] {
+			if waiter == ch {
+				m.waiters[i] = append(m.waiters[i][:j], m.waiters[i][j+1:]...)
+				m.mu.Unlock()
+				return ctx.Err()
+			}
+		}
+		m.mu.Unlock()
+		// the lock was handed to us in the meantime, so we pass it on
+		m.Unlock()
+		return ctx.Err()
+	}
+}
+
+func (m *priorityMutex) TryLock() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.locked {
+		return false
+	}
+	m.locked = true
+	return true
+}
+
+func (m *priorityMutex) Unlock() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := len(m.waiters) - 1; i >= 0; i-- {
+		if len(m.waiters[i]) > 0 {
+			ch := m.waiters[i][0]
+			m.waiters[i] = m.waiters[i][1:]
+			close(ch)
+			return
+		}
+	}
+	m.locked = false
+}
@@ -0,0 +1,49 @@
+package rest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityMutex(t *testing.T) {
+	var m priorityMutex
+	assert.NoError(t, m.CLock(context.Background(), PriorityNormal))
+	assert.False(t, m.TryLock())
+
+	order := make(chan Priority, 3)
+	for _, priority := range []Priority{PriorityBackground, PriorityNormal, PriorityHigh} {
+		priority := priority
+		go func() {
+			_ = m.CLock(context.Background(), priority)
+			order <- priority
+			m.Unlock()
+		}()
+		// make sure the waiters are queued in the order above
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	m.Unlock()
+	assert.Equal(t, PriorityHigh, <-order)
+	assert.Equal(t, PriorityNormal, <-order)
+	assert.Equal(t, PriorityBackground, <-order)
+}
+
+func TestPriorityMutexCancel(t *testing.T) {
+	var m priorityMutex
+	assert.True(t, m.TryLock())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, m.CLock(ctx, PriorityHigh), context.DeadlineExceeded)
+
+	m.Unlock()
+	assert.True(t, m.TryLock())
+}
+
+func TestPriorityFromContext(t *testing.T) {
+	assert.Equal(t, PriorityNormal, PriorityFromContext(context.Background()))
+	assert.Equal(t, PriorityHigh, PriorityFromContext(ContextWithPriority(context.Background(), PriorityHigh)))
+}
@@ -18,10 +18,11 @@ func DefaultRequestConfig(rq *http.Request) *RequestConfig {
 
 // RequestConfig are additional options for the request
 type RequestConfig struct {
-	Request *http.Request
-	Ctx     context.Context
-	Checks  []Check
-	Delay   time.Duration
+	Request  *http.Request
+	Ctx      context.Context
+	Checks   []Check
+	Delay    time.Duration
+	Priority Priority
 }
 
 // Check is a function which gets executed right before a request is made
@@ -61,6 +62,13 @@ func WithDelay(delay time.Duration) RequestOpt {
 	}
 }
 
+// WithPriority sets the Priority of the request, which decides the order requests acquire their rate limit bucket in under contention.
+func WithPriority(priority Priority) RequestOpt {
+	return func(config *RequestConfig) {
+		config.Priority = priority
+	}
+}
+
 // WithReason adds a reason header to the request. Not all discord endpoints support this
 func WithReason(reason string) RequestOpt {
 	return func(config *RequestConfig) {
@@ -89,6 +97,17 @@ func WithQueryParam(param string, value any) RequestOpt {
 	}
 }
 
+// WithToken overrides the Authorization header of the request with the given discord.TokenType & token.
 func WithToken(tokenType discord.TokenType, token string) RequestOpt {
 	return WithHeader("Authorization", tokenType.Apply(token))
 }
+
+// WithUploadProgress reports the progress of uploading the request body to the given discord.ProgressFunc, useful for large file uploads.
+func WithUploadProgress(progress discord.ProgressFunc) RequestOpt {
+	return func(config *RequestConfig) {
+		if config.Request.Body == nil || config.Request.Body == http.NoBody {
+			return
+		}
+		config.Request.Body = discord.NewProgressReader(config.Request.Body, 0, config.Request.ContentLength, progress)
+	}
+}
@@ -21,6 +21,7 @@ type Rest interface {
 	StageInstances
 	Emojis
 	Stickers
+	SoundboardSounds
 	GuildScheduledEvents
 }
 
@@ -47,6 +48,7 @@ func New(client Client) Rest {
 		StageInstances:       NewStageInstances(client),
 		Emojis:               NewEmojis(client),
 		Stickers:             NewStickers(client),
+		SoundboardSounds:     NewSoundboardSounds(client),
 		GuildScheduledEvents: NewGuildScheduledEvents(client),
 	}
 }
@@ -71,5 +73,6 @@ type restImpl struct {
 	StageInstances
 	Emojis
 	Stickers
+	SoundboardSounds
 	GuildScheduledEvents
 }
@@ -7,11 +7,15 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/disgoorg/disgo/json"
 
 	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/internal/bufferpool"
+	"github.com/disgoorg/disgo/internal/tokenhelper"
 	"github.com/disgoorg/disgo/rest/route"
 	"github.com/disgoorg/log"
 )
@@ -66,46 +70,30 @@ func (c *clientImpl) RateLimiter() RateLimiter {
 	return c.config.RateLimiter
 }
 
-func (c *clientImpl) retry(cRoute *route.CompiledAPIRoute, rqBody any, rsBody any, tries int, opts []RequestOpt) error {
-	var (
-		rqURL       = cRoute.URL()
-		rawRqBody   []byte
-		err         error
-		contentType string
-	)
-
-	if rqBody != nil {
-		switch v := rqBody.(type) {
-		case *discord.MultipartBuffer:
-			contentType = v.ContentType
-			rawRqBody = v.Buffer.Bytes()
-
-		case url.Values:
-			contentType = "application/x-www-form-urlencoded"
-			rawRqBody = []byte(v.Encode())
-
-		default:
-			contentType = "application/json"
-			if rawRqBody, err = json.Marshal(rqBody); err != nil {
-				return fmt.Errorf("failed to marshal request body: %w", err)
-			}
-		}
-		c.Logger().Tracef("request to %s, body: %s", rqURL, string(rawRqBody))
-	}
+func (c *clientImpl) retry(cRoute *route.CompiledAPIRoute, contentType string, body *requestBody, rsBody any, tries int, opts []RequestOpt) error {
+	rqURL := cRoute.URL()
+	rawRqBody := body.raw
 
-	rq, err := http.NewRequest(cRoute.APIRoute.Method().String(), rqURL, bytes.NewReader(rawRqBody))
+	rq, err := http.NewRequest(cRoute.APIRoute.Method().String(), rqURL, nil)
 	if err != nil {
 		return err
 	}
+	if rawRqBody != nil {
+		rq.Body = body.reader()
+		rq.GetBody = func() (io.ReadCloser, error) {
+			return body.reader(), nil
+		}
+		rq.ContentLength = int64(len(rawRqBody))
+	}
 
 	rq.Header.Set("User-Agent", c.config.UserAgent)
 	if contentType != "" {
 		rq.Header.Set("Content-Type", contentType)
 	}
 
-	if cRoute.APIRoute.NeedsBotAuth() {
-		// add token opt to the start, so you can override it
-		opts = append([]RequestOpt{WithToken(discord.TokenTypeBot, c.botToken)}, opts...)
+	if cRoute.APIRoute.NeedsBotAuth() && c.botToken != "" {
+		// add token opt to the start, so you can override it per request with WithToken
+		opts = append([]RequestOpt{WithToken(c.config.TokenType, c.botToken)}, opts...)
 	}
 
 	config := DefaultRequestConfig(rq)
@@ -121,8 +109,16 @@ func (c *clientImpl) retry(cRoute *route.CompiledAPIRoute, rqBody any, rsBody an
 		}
 	}
 
+	// fail fast before waiting for rate limits if the api is down
+	circuitBreaker := c.config.CircuitBreaker
+	if circuitBreaker != nil {
+		if err = circuitBreaker.Allow(rq.URL.Host); err != nil {
+			return err
+		}
+	}
+
 	// wait for rate limits
-	err = c.RateLimiter().WaitBucket(config.Ctx, cRoute)
+	err = c.RateLimiter().WaitBucket(ContextWithPriority(config.Ctx, config.Priority), cRoute)
 	if err != nil {
 		return fmt.Errorf("error locking bucket in rest client: %w", err)
 	}
@@ -136,6 +132,9 @@ func (c *clientImpl) retry(cRoute *route.CompiledAPIRoute, rqBody any, rsBody an
 	}
 
 	rs, err := c.HTTPClient().Do(config.Request)
+	if circuitBreaker != nil && (err == nil || config.Ctx.Err() == nil) {
+		circuitBreaker.Report(rq.URL.Host, err == nil && rs.StatusCode < http.StatusInternalServerError)
+	}
 	if err != nil {
 		_ = c.RateLimiter().UnlockBucket(cRoute, nil)
 		return fmt.Errorf("error doing request in rest client: %w", err)
@@ -150,7 +149,11 @@ func (c *clientImpl) retry(cRoute *route.CompiledAPIRoute, rqBody any, rsBody an
 		if rawRsBody, err = io.ReadAll(rs.Body); err != nil {
 			return fmt.Errorf("error reading response body in rest client: %w", err)
 		}
-		c.Logger().Tracef("response from %s, code %d, body: %s", rqURL, rs.StatusCode, string(rawRsBody))
+		if shardID, ok := ShardIDFromContext(config.Ctx); ok {
+			c.Logger().Tracef("response from %s for shard %d, code %d, body: %s", tokenhelper.Redact(rqURL), shardID, rs.StatusCode, tokenhelper.Redact(string(rawRsBody)))
+		} else {
+			c.Logger().Tracef("response from %s, code %d, body: %s", tokenhelper.Redact(rqURL), rs.StatusCode, tokenhelper.Redact(string(rawRsBody)))
+		}
 	}
 
 	switch rs.StatusCode {
@@ -168,7 +171,7 @@ func (c *clientImpl) retry(cRoute *route.CompiledAPIRoute, rqBody any, rsBody an
 		if tries >= c.RateLimiter().MaxRetries() {
 			return NewError(rq, rawRqBody, rs, rawRsBody)
 		}
-		return c.retry(cRoute, rqBody, rsBody, tries+1, opts)
+		return c.retry(cRoute, contentType, body, rsBody, tries+1, opts)
 
 	default:
 		return NewError(rq, rawRqBody, rs, rawRsBody)
@@ -176,5 +179,97 @@ func (c *clientImpl) retry(cRoute *route.CompiledAPIRoute, rqBody any, rsBody an
 }
 
 func (c *clientImpl) Do(cRoute *route.CompiledAPIRoute, rqBody any, rsBody any, opts ...RequestOpt) error {
-	return c.retry(cRoute, rqBody, rsBody, 1, opts)
+	var (
+		body        = &requestBody{}
+		contentType string
+		release     func()
+	)
+
+	if rqBody != nil {
+		switch v := rqBody.(type) {
+		case *discord.MultipartBuffer:
+			contentType = v.ContentType
+			body.raw = v.Buffer.Bytes()
+			release = v.Release
+
+		case url.Values:
+			contentType = "application/x-www-form-urlencoded"
+			body.raw = []byte(v.Encode())
+
+		default:
+			contentType = "application/json"
+			buf := bufferpool.Get()
+			if err := json.NewEncoder(buf).Encode(rqBody); err != nil {
+				bufferpool.Put(buf)
+				return fmt.Errorf("failed to marshal request body: %w", err)
+			}
+			body.raw = bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+			release = func() {
+				bufferpool.Put(buf)
+			}
+		}
+		c.Logger().Tracef("request to %s, body: %s", tokenhelper.Redact(cRoute.URL()), tokenhelper.Redact(string(body.raw)))
+	}
+
+	if c.config.DryRun && cRoute.APIRoute.Method() != route.GET {
+		c.dryRun(cRoute, contentType, body.raw)
+		if release != nil {
+			release()
+		}
+		return nil
+	}
+
+	err := c.retry(cRoute, contentType, body, rsBody, 1, opts)
+	// errors keep a reference to the request body, and the http.Transport might still read it, so only reuse the buffer if it's safe
+	if err == nil && release != nil && body.closed() {
+		release()
+	}
+	return err
+}
+
+func (c *clientImpl) dryRun(cRoute *route.CompiledAPIRoute, contentType string, body []byte) {
+	c.Logger().Infof("dry run: skipping %s %s", cRoute.APIRoute.Method(), tokenhelper.Redact(cRoute.URL()))
+	if c.config.DryRunFunc == nil {
+		return
+	}
+	var rqBody []byte
+	if body != nil {
+		// the body buffer is reused after the request
+		rqBody = append([]byte(nil), body...)
+	}
+	c.config.DryRunFunc(DryRunRequest{
+		Method:      cRoute.APIRoute.Method(),
+		URL:         tokenhelper.Redact(cRoute.URL()),
+		ContentType: contentType,
+		Body:        rqBody,
+		Time:        time.Now(),
+	})
+}
+
+// requestBody tracks the readers handed to the http.Transport, so the underlying buffer is only reused once all of them are closed.
+type requestBody struct {
+	raw  []byte
+	open int32
+}
+
+func (b *requestBody) reader() io.ReadCloser {
+	atomic.AddInt32(&b.open, 1)
+	return &requestBodyReader{Reader: bytes.NewReader(b.raw), body: b}
+}
+
+func (b *requestBody) closed() bool {
+	return atomic.LoadInt32(&b.open) == 0
+}
+
+type requestBodyReader struct {
+	*bytes.Reader
+	body *requestBody
+	once sync.Once
+}
+
+func (r *requestBodyReader) Close() error {
+	r.once.Do(func() {
+		atomic.AddInt32(&r.body.open, -1)
+	})
+	return nil
 }
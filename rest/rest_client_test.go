@@ -0,0 +1,102 @@
+package rest
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest/route"
+	"github.com/disgoorg/log"
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripperFunc func(rq *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(rq *http.Request) (*http.Response, error) {
+	return f(rq)
+}
+
+func newTestClient(f roundTripperFunc, opts ...ConfigOpt) Client {
+	logger := log.New(log.LstdFlags)
+	logger.SetLevel(log.LevelError)
+	return NewClient("", append([]ConfigOpt{WithLogger(logger), WithHTTPClient(&http.Client{Transport: f})}, opts...)...)
+}
+
+func okResponse(rq *http.Request) *http.Response {
+	_, _ = io.Copy(io.Discard, rq.Body)
+	_ = rq.Body.Close()
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewReader([]byte(`{}`))),
+		Request:    rq,
+	}
+}
+
+func TestClientDoBody(t *testing.T) {
+	var body []byte
+	client := newTestClient(func(rq *http.Request) (*http.Response, error) {
+		body, _ = io.ReadAll(rq.Body)
+		assert.Equal(t, int64(len(body)), rq.ContentLength)
+		assert.Equal(t, "application/json", rq.Header.Get("Content-Type"))
+		rq.Body = io.NopCloser(bytes.NewReader(body))
+		return okResponse(rq), nil
+	})
+
+	compiledRoute, err := route.CreateMessage.Compile(nil, 1)
+	assert.NoError(t, err)
+
+	for _, content := range []string{"first message", "second"} {
+		err = client.Do(compiledRoute, discord.MessageCreate{Content: content}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"content":"`+content+`"}`, string(body))
+	}
+}
+
+func TestClientDryRun(t *testing.T) {
+	var methods []string
+	recorder := NewDryRunRecorder()
+	client := newTestClient(func(rq *http.Request) (*http.Response, error) {
+		methods = append(methods, rq.Method)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(bytes.NewReader([]byte(`{"id":"1"}`))),
+			Request:    rq,
+		}, nil
+	}, WithDryRun(recorder.Record))
+
+	getRoute, err := route.GetMessage.Compile(nil, 1, 2)
+	assert.NoError(t, err)
+	var message *discord.Message
+	assert.NoError(t, client.Do(getRoute, nil, &message))
+	assert.NotNil(t, message)
+
+	createRoute, err := route.CreateMessage.Compile(nil, 1)
+	assert.NoError(t, err)
+	assert.NoError(t, client.Do(createRoute, discord.MessageCreate{Content: "hello"}, nil))
+
+	assert.Equal(t, []string{http.MethodGet}, methods)
+	requests := recorder.Requests()
+	assert.Len(t, requests, 1)
+	assert.Equal(t, route.POST, requests[0].Method)
+	assert.Equal(t, `{"content":"hello"}`, string(requests[0].Body))
+}
+
+func BenchmarkClientDo(b *testing.B) {
+	client := newTestClient(func(rq *http.Request) (*http.Response, error) {
+		return okResponse(rq), nil
+	})
+	compiledRoute, _ := route.CreateMessage.Compile(nil, 1)
+	messageCreate := discord.NewMessageCreateBuilder().SetContent("hello world").Build()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := client.Do(compiledRoute, messageCreate, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
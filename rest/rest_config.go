@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/log"
 )
 
@@ -12,6 +13,7 @@ func DefaultConfig() *Config {
 	return &Config{
 		Logger:     log.Default(),
 		HTTPClient: &http.Client{Timeout: 20 * time.Second},
+		TokenType:  discord.TokenTypeBot,
 	}
 }
 
@@ -22,6 +24,10 @@ type Config struct {
 	RateLimiter               RateLimiter
 	RateRateLimiterConfigOpts []RateLimiterConfigOpt
 	UserAgent                 string
+	TokenType                 discord.TokenType
+	CircuitBreaker            CircuitBreaker
+	DryRun                    bool
+	DryRunFunc                DryRunFunc
 }
 
 // ConfigOpt can be used to supply optional parameters to NewClient
@@ -71,3 +77,27 @@ func WithUserAgent(userAgent string) ConfigOpt {
 		config.UserAgent = userAgent
 	}
 }
+
+// WithTokenType sets the discord.TokenType used for the Authorization header of all requests which require authentication.
+// Use discord.TokenTypeBearer to make requests with an OAuth2 access token. Defaults to discord.TokenTypeBot.
+func WithTokenType(tokenType discord.TokenType) ConfigOpt {
+	return func(config *Config) {
+		config.TokenType = tokenType
+	}
+}
+
+// WithCircuitBreaker enables the given CircuitBreaker for all requests. Use NewCircuitBreaker for the default implementation.
+func WithCircuitBreaker(circuitBreaker CircuitBreaker) ConfigOpt {
+	return func(config *Config) {
+		config.CircuitBreaker = circuitBreaker
+	}
+}
+
+// WithDryRun enables the dry run mode. All requests which are not GET requests are logged and passed to the optional DryRunFunc instead of being sent to discord, while reads work normally.
+// Mutating requests return no error and leave the response empty, so code relying on their response like the created discord.Message has to handle nil values.
+func WithDryRun(dryRunFunc DryRunFunc) ConfigOpt {
+	return func(config *Config) {
+		config.DryRun = true
+		config.DryRunFunc = dryRunFunc
+	}
+}
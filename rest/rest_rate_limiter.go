@@ -23,7 +23,8 @@ type RateLimiter interface {
 	// Reset resets the rate limiter to its initial state
 	Reset()
 
-	// WaitBucket waits for the given bucket to be available for new requests & locks it
+	// WaitBucket waits for the given bucket to be available for new requests & locks it.
+	// The Priority of the request can be retrieved with PriorityFromContext.
 	WaitBucket(ctx context.Context, route *route.CompiledAPIRoute) error
 
 	// UnlockBucket unlocks the given bucket and calculates the rate limit for the next request
@@ -3,6 +3,7 @@ package rest
 import (
 	"time"
 
+	"github.com/disgoorg/disgo/clock"
 	"github.com/disgoorg/log"
 )
 
@@ -12,6 +13,7 @@ func DefaultRateLimiterConfig() *RateLimiterConfig {
 		Logger:          log.Default(),
 		MaxRetries:      10,
 		CleanupInterval: time.Second * 10,
+		Clock:           clock.Default,
 	}
 }
 
@@ -20,6 +22,7 @@ type RateLimiterConfig struct {
 	Logger          log.Logger
 	MaxRetries      int
 	CleanupInterval time.Duration
+	Clock           clock.Clock
 }
 
 // RateLimiterConfigOpt can be used to supply optional parameters to NewRateLimiter.
@@ -52,3 +55,10 @@ func WithCleanupInterval(cleanupInterval time.Duration) RateLimiterConfigOpt {
 		config.CleanupInterval = cleanupInterval
 	}
 }
+
+// WithRateLimiterClock sets the clock.Clock the rest rate limiter uses to track bucket resets.
+func WithRateLimiterClock(clock clock.Clock) RateLimiterConfigOpt {
+	return func(config *RateLimiterConfig) {
+		config.Clock = clock
+	}
+}
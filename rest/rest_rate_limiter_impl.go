@@ -10,7 +10,6 @@ import (
 
 	"github.com/disgoorg/disgo/rest/route"
 	"github.com/disgoorg/log"
-	"github.com/sasha-s/go-csync"
 )
 
 // NewRateLimiter return a new default RateLimiter with the given RateLimiterConfigOpt(s).
@@ -57,8 +56,8 @@ func (l *rateLimiterImpl) MaxRetries() int {
 }
 
 func (l *rateLimiterImpl) cleanup() {
-	ticker := time.NewTicker(l.config.CleanupInterval)
-	for range ticker.C {
+	ticker := l.config.Clock.NewTicker(l.config.CleanupInterval)
+	for range ticker.C() {
 		l.doCleanup()
 	}
 }
@@ -67,7 +66,7 @@ func (l *rateLimiterImpl) doCleanup() {
 	l.bucketsMu.Lock()
 	defer l.bucketsMu.Unlock()
 	before := len(l.buckets)
-	now := time.Now()
+	now := l.config.Clock.Now()
 	for hash, b := range l.buckets {
 		if !b.mu.TryLock() {
 			continue
@@ -89,7 +88,7 @@ func (l *rateLimiterImpl) Close(ctx context.Context) {
 		wg.Add(1)
 		b := l.buckets[i]
 		go func() {
-			_ = b.mu.CLock(ctx)
+			_ = b.mu.CLock(ctx, PriorityHigh)
 			wg.Done()
 		}()
 	}
@@ -147,12 +146,12 @@ func (l *rateLimiterImpl) getBucket(route *route.CompiledAPIRoute, create bool)
 func (l *rateLimiterImpl) WaitBucket(ctx context.Context, route *route.CompiledAPIRoute) error {
 	b := l.getBucket(route, true)
 	l.Logger().Tracef("locking rest bucket, ID: %s, Limit: %d, Remaining: %d, Reset: %s", b.ID, b.Limit, b.Remaining, b.Reset)
-	if err := b.mu.CLock(ctx); err != nil {
+	if err := b.mu.CLock(ctx, PriorityFromContext(ctx)); err != nil {
 		return err
 	}
 
 	var until time.Time
-	now := time.Now()
+	now := l.config.Clock.Now()
 
 	if b.Remaining == 0 && b.Reset.After(now) {
 		until = b.Reset
@@ -163,6 +162,7 @@ func (l *rateLimiterImpl) WaitBucket(ctx context.Context, route *route.CompiledA
 	if until.After(now) {
 		// TODO: do we want to return early when we know srate limit bigger than ctx deadline?
 		if deadline, ok := ctx.Deadline(); ok && until.After(deadline) {
+			b.mu.Unlock()
 			return context.DeadlineExceeded
 		}
 
@@ -170,7 +170,7 @@ func (l *rateLimiterImpl) WaitBucket(ctx context.Context, route *route.CompiledA
 		case <-ctx.Done():
 			b.mu.Unlock()
 			return ctx.Err()
-		case <-time.After(until.Sub(now)):
+		case <-l.config.Clock.After(until.Sub(now)):
 		}
 	}
 	return nil
@@ -214,7 +214,7 @@ func (l *rateLimiterImpl) UnlockBucket(route *route.CompiledAPIRoute, rs *http.R
 		if err != nil {
 			return fmt.Errorf("invalid retryAfter %s: %w", retryAfterHeader, err)
 		}
-		reset := time.Now().Add(time.Second * time.Duration(retryAfter))
+		reset := l.config.Clock.Now().Add(time.Second * time.Duration(retryAfter))
 		if global {
 			l.global = reset
 			l.Logger().Warnf("global rate limit exceeded, retry after: %ds", retryAfter)
@@ -259,7 +259,7 @@ func (l *rateLimiterImpl) UnlockBucket(route *route.CompiledAPIRoute, rs *http.R
 			return fmt.Errorf("invalid reset after %s: %s", resetAfterHeader, err)
 		}
 
-		b.Reset = time.Now().Add(time.Duration(resetAfter) * time.Second)
+		b.Reset = l.config.Clock.Now().Add(time.Duration(resetAfter) * time.Second)
 	} else {
 		return fmt.Errorf("no reset or reset after header found in response")
 	}
@@ -267,7 +267,7 @@ func (l *rateLimiterImpl) UnlockBucket(route *route.CompiledAPIRoute, rs *http.R
 }
 
 type bucket struct {
-	mu        csync.Mutex
+	mu        priorityMutex
 	ID        string
 	Reset     time.Time
 	Remaining int
@@ -30,7 +30,7 @@ var (
 	GetCurrentMember          = NewAPIRoute(GET, "/users/@me/guilds/{guild.id}/member")
 	UpdateSelfUser            = NewAPIRoute(PATCH, "/users/@me")
 	GetCurrentUserConnections = NewAPIRouteNoAuth(GET, "/users/@me/connections")
-	GetCurrentUserGuilds      = NewAPIRouteNoAuth(GET, "/users/@me/guilds", "before", "after", "limit")
+	GetCurrentUserGuilds      = NewAPIRoute(GET, "/users/@me/guilds", "before", "after", "limit", "with_counts")
 	LeaveGuild                = NewAPIRoute(DELETE, "/users/@me/guilds/{guild.id}")
 	GetDMChannels             = NewAPIRoute(GET, "/users/@me/channels")
 	CreateDMChannel           = NewAPIRoute(POST, "/users/@me/channels")
@@ -55,7 +55,7 @@ var (
 	DeleteBan = NewAPIRoute(DELETE, "/guilds/{guild.id}/bans/{user.id}")
 
 	GetMember        = NewAPIRoute(GET, "/guilds/{guild.id}/members/{user.id}")
-	GetMembers       = NewAPIRoute(GET, "/guilds/{guild.id}/members")
+	GetMembers       = NewAPIRoute(GET, "/guilds/{guild.id}/members", "limit", "after")
 	SearchMembers    = NewAPIRoute(GET, "/guilds/{guild.id}/members/search", "query", "limit")
 	AddMember        = NewAPIRoute(PUT, "/guilds/{guild.id}/members/{user.id}")
 	UpdateMember     = NewAPIRoute(PATCH, "/guilds/{guild.id}/members/{user.id}")
@@ -70,6 +70,9 @@ var (
 
 	GetGuildWebhooks = NewAPIRoute(GET, "/guilds/{guild.id}/webhooks")
 
+	GetMemberVerification    = NewAPIRoute(GET, "/guilds/{guild.id}/member-verification")
+	UpdateMemberVerification = NewAPIRoute(PATCH, "/guilds/{guild.id}/member-verification")
+
 	GetAuditLogs = NewAPIRoute(GET, "/guilds/{guild.id}/audit-logs", "user_id", "action_type", "before", "limit")
 
 	GetGuildVoiceRegions = NewAPIRoute(GET, "/guilds/{guild.id}/regions")
@@ -142,6 +145,8 @@ var (
 	UpdateChannel = NewAPIRoute(PATCH, "/channels/{channel.id}")
 	DeleteChannel = NewAPIRoute(DELETE, "/channels/{channel.id}")
 
+	SetVoiceChannelStatus = NewAPIRoute(PUT, "/channels/{channel.id}/voice-status")
+
 	GetChannelWebhooks = NewAPIRoute(GET, "/channels/{channel.id}/webhooks")
 	CreateWebhook      = NewAPIRoute(POST, "/channels/{channel.id}/webhooks")
 
@@ -152,6 +157,8 @@ var (
 
 	SendTyping    = NewAPIRoute(POST, "/channels/{channel.id}/typing")
 	FollowChannel = NewAPIRoute(POST, "/channels/{channel.id}/followers")
+
+	RefreshAttachmentURLs = NewAPIRoute(POST, "/attachments/refresh-urls")
 )
 
 // Threads
@@ -172,7 +179,7 @@ var (
 
 // Messages
 var (
-	GetMessages        = NewAPIRoute(GET, "/channels/{channel.id}/messages")
+	GetMessages        = NewAPIRoute(GET, "/channels/{channel.id}/messages", "around", "before", "after", "limit")
 	GetMessage         = NewAPIRoute(GET, "/channels/{channel.id}/messages/{message.id}")
 	CreateMessage      = NewAPIRoute(POST, "/channels/{channel.id}/messages")
 	UpdateMessage      = NewAPIRoute(PATCH, "/channels/{channel.id}/messages/{message.id}")
@@ -212,6 +219,14 @@ var (
 	DeleteGuildSticker   = NewAPIRoute(DELETE, "/guilds/{guild.id}/stickers/{sticker.id}")
 )
 
+// Soundboard
+var (
+	GetSoundboardSounds   = NewAPIRoute(GET, "/guilds/{guild.id}/soundboard-sounds")
+	GetSoundboardSound    = NewAPIRoute(GET, "/guilds/{guild.id}/soundboard-sounds/{sound.id}")
+	CreateSoundboardSound = NewAPIRoute(POST, "/guilds/{guild.id}/soundboard-sounds")
+	DeleteSoundboardSound = NewAPIRoute(DELETE, "/guilds/{guild.id}/soundboard-sounds/{sound.id}")
+)
+
 // Webhooks
 var (
 	GetWebhook    = NewAPIRoute(GET, "/webhooks/{webhook.id}")
@@ -225,6 +240,7 @@ var (
 	CreateWebhookMessage       = NewAPIRouteNoAuth(POST, "/webhooks/{webhook.id}/{webhook.token}", "wait", "thread_id")
 	CreateWebhookMessageSlack  = NewAPIRouteNoAuth(POST, "/webhooks/{webhook.id}/{webhook.token}/slack", "wait", "thread_id")
 	CreateWebhookMessageGitHub = NewAPIRouteNoAuth(POST, "/webhooks/{webhook.id}/{webhook.token}/github", "wait", "thread_id")
+	GetWebhookMessage          = NewAPIRouteNoAuth(GET, "/webhooks/{webhook.id}/{webhook.token}/messages/{message.id}", "thread_id")
 	UpdateWebhookMessage       = NewAPIRouteNoAuth(PATCH, "/webhooks/{webhook.id}/{webhook.token}/messages/{message.id}", "thread_id")
 	DeleteWebhookMessage       = NewAPIRouteNoAuth(DELETE, "/webhooks/{webhook.id}/{webhook.token}/messages/{message.id}", "thread_id")
 )
@@ -241,6 +257,8 @@ var (
 
 // Interactions
 var (
+	GetCurrentApplication = NewAPIRoute(GET, "/applications/@me")
+
 	GetGlobalCommands   = NewAPIRoute(GET, "/applications/{application.id}/commands", "with_localizations")
 	GetGlobalCommand    = NewAPIRoute(GET, "/applications/{application.id}/command/{command.id}")
 	CreateGlobalCommand = NewAPIRoute(POST, "/applications/{application.id}/commands")
@@ -280,7 +298,7 @@ var (
 	GuildDiscoverySplash = NewCDNRoute("/discovery-splashes/{guild.id}/{guild.discovery.splash.hash}", PNG, JPEG, WebP)
 	GuildBanner          = NewCDNRoute("/banners/{guild.id}/{guild.banner.hash}", PNG, JPEG, WebP, GIF)
 
-	RoleIcon = NewCDNRoute("/role-icons/{role.id}/{role.icon.hash}", PNG, JPEG)
+	RoleIcon = NewCDNRoute("/role-icons/{role.id}/{role.icon.hash}", PNG, JPEG, WebP)
 
 	UserBanner        = NewCDNRoute("/banners/{user.id}/{user.banner.hash}", PNG, JPEG, WebP, GIF)
 	UserAvatar        = NewCDNRoute("/avatars/{user.id}/{user.avatar.hash}", PNG, JPEG, WebP, GIF)
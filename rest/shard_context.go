@@ -0,0 +1,28 @@
+package rest
+
+import "context"
+
+type shardIDKey struct{}
+
+// ContextWithShardID returns a copy of the context.Context with the given shard ID attached.
+// Requests made with this context are attributed to the shard in logs and can be attributed by custom RateLimiter(s) via ShardIDFromContext.
+func ContextWithShardID(ctx context.Context, shardID int) context.Context {
+	return context.WithValue(ctx, shardIDKey{}, shardID)
+}
+
+// ShardIDFromContext returns the shard ID attached to the context.Context via ContextWithShardID.
+func ShardIDFromContext(ctx context.Context) (int, bool) {
+	shardID, ok := ctx.Value(shardIDKey{}).(int)
+	return shardID, ok
+}
+
+// WithShardID attributes the request to the given shard. It has to be passed after WithCtx as it wraps the current context of the request.
+func WithShardID(shardID int) RequestOpt {
+	return func(config *RequestConfig) {
+		ctx := config.Ctx
+		if ctx == nil {
+			ctx = context.TODO()
+		}
+		config.Ctx = ContextWithShardID(ctx, shardID)
+	}
+}
@@ -0,0 +1,67 @@
+package rest
+
+import (
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest/route"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var _ SoundboardSounds = (*soundboardSoundImpl)(nil)
+
+func NewSoundboardSounds(client Client) SoundboardSounds {
+	return &soundboardSoundImpl{client: client}
+}
+
+type SoundboardSounds interface {
+	GetSoundboardSounds(guildID snowflake.ID, opts ...RequestOpt) ([]discord.SoundboardSound, error)
+	GetSoundboardSound(guildID snowflake.ID, soundID snowflake.ID, opts ...RequestOpt) (*discord.SoundboardSound, error)
+	CreateSoundboardSound(guildID snowflake.ID, soundCreate discord.SoundboardSoundCreate, opts ...RequestOpt) (*discord.SoundboardSound, error)
+	DeleteSoundboardSound(guildID snowflake.ID, soundID snowflake.ID, opts ...RequestOpt) error
+}
+
+type soundboardSoundImpl struct {
+	client Client
+}
+
+func (s *soundboardSoundImpl) GetSoundboardSounds(guildID snowflake.ID, opts ...RequestOpt) (sounds []discord.SoundboardSound, err error) {
+	var compiledRoute *route.CompiledAPIRoute
+	compiledRoute, err = route.GetSoundboardSounds.Compile(nil, guildID)
+	if err != nil {
+		return
+	}
+	var rs struct {
+		Items []discord.SoundboardSound `json:"items"`
+	}
+	if err = s.client.Do(compiledRoute, nil, &rs, opts...); err == nil {
+		sounds = rs.Items
+	}
+	return
+}
+
+func (s *soundboardSoundImpl) GetSoundboardSound(guildID snowflake.ID, soundID snowflake.ID, opts ...RequestOpt) (sound *discord.SoundboardSound, err error) {
+	var compiledRoute *route.CompiledAPIRoute
+	compiledRoute, err = route.GetSoundboardSound.Compile(nil, guildID, soundID)
+	if err != nil {
+		return
+	}
+	err = s.client.Do(compiledRoute, nil, &sound, opts...)
+	return
+}
+
+func (s *soundboardSoundImpl) CreateSoundboardSound(guildID snowflake.ID, soundCreate discord.SoundboardSoundCreate, opts ...RequestOpt) (sound *discord.SoundboardSound, err error) {
+	var compiledRoute *route.CompiledAPIRoute
+	compiledRoute, err = route.CreateSoundboardSound.Compile(nil, guildID)
+	if err != nil {
+		return
+	}
+	err = s.client.Do(compiledRoute, soundCreate, &sound, opts...)
+	return
+}
+
+func (s *soundboardSoundImpl) DeleteSoundboardSound(guildID snowflake.ID, soundID snowflake.ID, opts ...RequestOpt) error {
+	compiledRoute, err := route.DeleteSoundboardSound.Compile(nil, guildID, soundID)
+	if err != nil {
+		return err
+	}
+	return s.client.Do(compiledRoute, nil, nil, opts...)
+}
@@ -16,6 +16,9 @@ type Users interface {
 	GetUser(userID snowflake.ID, opts ...RequestOpt) (*discord.User, error)
 	UpdateSelfUser(selfUserUpdate discord.SelfUserUpdate, opts ...RequestOpt) (*discord.OAuth2User, error)
 	GetGuilds(before int, after int, limit int, opts ...RequestOpt) ([]discord.OAuth2Guild, error)
+	// GetCurrentUserGuildsPage returns a Page iterating over the guilds of the current user starting after/before startID.
+	// Pass WithToken(discord.TokenTypeBearer, token) to iterate over the guilds of an OAuth2 user.
+	GetCurrentUserGuildsPage(startID snowflake.ID, limit int, withCounts bool, opts ...RequestOpt) Page[discord.OAuth2Guild]
 	LeaveGuild(guildID snowflake.ID, opts ...RequestOpt) error
 	GetDMChannels(opts ...RequestOpt) ([]discord.Channel, error)
 	CreateDMChannel(userID snowflake.ID, opts ...RequestOpt) (*discord.DMChannel, error)
@@ -67,6 +70,43 @@ func (s *userImpl) GetGuilds(before int, after int, limit int, opts ...RequestOp
 	return
 }
 
+func (s *userImpl) GetCurrentUserGuildsPage(startID snowflake.ID, limit int, withCounts bool, opts ...RequestOpt) Page[discord.OAuth2Guild] {
+	return newCurrentUserGuildsPage(s.client, startID, limit, withCounts, opts)
+}
+
+func newCurrentUserGuildsPage(client Client, startID snowflake.ID, limit int, withCounts bool, opts []RequestOpt) Page[discord.OAuth2Guild] {
+	return Page[discord.OAuth2Guild]{
+		getItemsFunc: func(before snowflake.ID, after snowflake.ID) (guilds []discord.OAuth2Guild, err error) {
+			queryParams := route.QueryValues{}
+			if before != 0 {
+				queryParams["before"] = before
+			}
+			if after != 0 {
+				queryParams["after"] = after
+			}
+			if limit != 0 {
+				queryParams["limit"] = limit
+			}
+			if withCounts {
+				queryParams["with_counts"] = true
+			}
+
+			var compiledRoute *route.CompiledAPIRoute
+			compiledRoute, err = route.GetCurrentUserGuilds.Compile(queryParams)
+			if err != nil {
+				return
+			}
+			err = client.Do(compiledRoute, nil, &guilds, opts...)
+			return
+		},
+		getIDFunc: func(guild discord.OAuth2Guild) snowflake.ID {
+			return guild.ID
+		},
+		firstID: startID,
+		lastID:  startID,
+	}
+}
+
 func (s *userImpl) LeaveGuild(guildID snowflake.ID, opts ...RequestOpt) error {
 	compiledRoute, err := route.LeaveGuild.Compile(nil, guildID)
 	if err != nil {
@@ -3,6 +3,7 @@ package rest
 import (
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/rest/route"
+	"github.com/disgoorg/snowflake/v2"
 )
 
 var _ Voice = (*voiceImpl)(nil)
@@ -12,14 +13,21 @@ func NewVoice(client Client) Voice {
 }
 
 type Voice interface {
+	// GetRegions returns all available discord.VoiceRegion(s) which can be used as RTC region of a voice or stage channel.
+	GetRegions(opts ...RequestOpt) ([]discord.VoiceRegion, error)
+	// GetVoiceRegions returns all available discord.VoiceRegion(s).
+	//
+	// Deprecated: Use GetRegions instead
 	GetVoiceRegions(opts ...RequestOpt) ([]discord.VoiceRegion, error)
+	// GetGuildRegions returns all discord.VoiceRegion(s) available for the given guild.
+	GetGuildRegions(guildID snowflake.ID, opts ...RequestOpt) ([]discord.VoiceRegion, error)
 }
 
 type voiceImpl struct {
 	client Client
 }
 
-func (s *voiceImpl) GetVoiceRegions(opts ...RequestOpt) (regions []discord.VoiceRegion, err error) {
+func (s *voiceImpl) GetRegions(opts ...RequestOpt) (regions []discord.VoiceRegion, err error) {
 	var compiledRoute *route.CompiledAPIRoute
 	compiledRoute, err = route.GetVoiceRegions.Compile(nil)
 	if err != nil {
@@ -28,3 +36,20 @@ func (s *voiceImpl) GetVoiceRegions(opts ...RequestOpt) (regions []discord.Voice
 	err = s.client.Do(compiledRoute, nil, &regions, opts...)
 	return
 }
+
+// GetVoiceRegions returns all available discord.VoiceRegion(s).
+//
+// Deprecated: Use GetRegions instead
+func (s *voiceImpl) GetVoiceRegions(opts ...RequestOpt) ([]discord.VoiceRegion, error) {
+	return s.GetRegions(opts...)
+}
+
+func (s *voiceImpl) GetGuildRegions(guildID snowflake.ID, opts ...RequestOpt) (regions []discord.VoiceRegion, err error) {
+	var compiledRoute *route.CompiledAPIRoute
+	compiledRoute, err = route.GetGuildVoiceRegions.Compile(nil, guildID)
+	if err != nil {
+		return
+	}
+	err = s.client.Do(compiledRoute, nil, &regions, opts...)
+	return
+}
@@ -24,6 +24,7 @@ type Webhooks interface {
 	CreateWebhookMessage(webhookID snowflake.ID, webhookToken string, messageCreate discord.WebhookMessageCreate, wait bool, threadID snowflake.ID, opts ...RequestOpt) (*discord.Message, error)
 	CreateWebhookMessageSlack(webhookID snowflake.ID, webhookToken string, messageCreate discord.Payload, wait bool, threadID snowflake.ID, opts ...RequestOpt) (*discord.Message, error)
 	CreateWebhookMessageGitHub(webhookID snowflake.ID, webhookToken string, messageCreate discord.Payload, wait bool, threadID snowflake.ID, opts ...RequestOpt) (*discord.Message, error)
+	GetWebhookMessage(webhookID snowflake.ID, webhookToken string, messageID snowflake.ID, threadID snowflake.ID, opts ...RequestOpt) (*discord.Message, error)
 	UpdateWebhookMessage(webhookID snowflake.ID, webhookToken string, messageID snowflake.ID, messageUpdate discord.WebhookMessageUpdate, threadID snowflake.ID, opts ...RequestOpt) (*discord.Message, error)
 	DeleteWebhookMessage(webhookID snowflake.ID, webhookToken string, messageID snowflake.ID, threadID snowflake.ID, opts ...RequestOpt) error
 }
@@ -151,6 +152,21 @@ func (s *webhookImpl) CreateWebhookMessageGitHub(webhookID snowflake.ID, webhook
 	return s.createWebhookMessage(webhookID, webhookToken, messageCreate, wait, threadID, route.CreateWebhookMessageGitHub, opts)
 }
 
+func (s *webhookImpl) GetWebhookMessage(webhookID snowflake.ID, webhookToken string, messageID snowflake.ID, threadID snowflake.ID, opts ...RequestOpt) (message *discord.Message, err error) {
+	params := route.QueryValues{}
+	if threadID != 0 {
+		params["thread_id"] = threadID
+	}
+
+	var compiledRoute *route.CompiledAPIRoute
+	compiledRoute, err = route.GetWebhookMessage.Compile(params, webhookID, webhookToken, messageID)
+	if err != nil {
+		return
+	}
+	err = s.client.Do(compiledRoute, nil, &message, opts...)
+	return
+}
+
 func (s *webhookImpl) UpdateWebhookMessage(webhookID snowflake.ID, webhookToken string, messageID snowflake.ID, messageUpdate discord.WebhookMessageUpdate, threadID snowflake.ID, opts ...RequestOpt) (message *discord.Message, err error) {
 	params := route.QueryValues{}
 	if threadID != 0 {
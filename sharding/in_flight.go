@@ -0,0 +1,58 @@
+package sharding
+
+import (
+	"context"
+	"sync"
+)
+
+// inFlightEvents counts the events of each shard which are currently handled by the gateway.EventHandlerFunc.
+type inFlightEvents struct {
+	mu      sync.Mutex
+	count   map[int]int
+	waiters map[int][]chan struct{}
+}
+
+func (e *inFlightEvents) begin(shardID int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.count == nil {
+		e.count = map[int]int{}
+	}
+	e.count[shardID]++
+}
+
+func (e *inFlightEvents) end(shardID int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.count[shardID]--
+	if e.count[shardID] > 0 {
+		return
+	}
+	delete(e.count, shardID)
+	for _, waiter := range e.waiters[shardID] {
+		close(waiter)
+	}
+	delete(e.waiters, shardID)
+}
+
+// wait blocks until no events of the shard are handled anymore or the context is done.
+func (e *inFlightEvents) wait(ctx context.Context, shardID int) error {
+	e.mu.Lock()
+	if e.count[shardID] == 0 {
+		e.mu.Unlock()
+		return nil
+	}
+	if e.waiters == nil {
+		e.waiters = map[int][]chan struct{}{}
+	}
+	waiter := make(chan struct{})
+	e.waiters[shardID] = append(e.waiters[shardID], waiter)
+	e.mu.Unlock()
+
+	select {
+	case <-waiter:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -3,6 +3,7 @@ package sharding
 import (
 	"context"
 
+	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/gateway"
 	"github.com/disgoorg/log"
 	"github.com/disgoorg/snowflake/v2"
@@ -25,6 +26,12 @@ type ShardManager interface {
 	// CloseShard closes a specific shard.
 	CloseShard(ctx context.Context, shardID int)
 
+	// Drain closes a specific shard and waits until all of its in-flight events are handled or the context is done.
+	// It dispatches gateway.EventTypeShardRebalance events before and after draining.
+	// The shard stays configured and can be opened again with OpenShard, which allows rolling restarts of single shards.
+	// Drain must not be called from an event listener of the same shard, as it would wait for itself.
+	Drain(ctx context.Context, shardID int) error
+
 	// ShardByGuildID returns the gateway.Gateway for the shard that contains the given guild.
 	ShardByGuildID(guildId snowflake.ID) gateway.Gateway
 
@@ -33,6 +40,9 @@ type ShardManager interface {
 
 	// Shards returns a copy of all shards as a map.
 	Shards() map[int]gateway.Gateway
+
+	// SessionStartLimit returns the current discord.SessionStartLimit of the RateLimiter or nil if it is unknown.
+	SessionStartLimit() *discord.SessionStartLimit
 }
 
 // ShardIDByGuild returns the shard ID for the given guildID and shardCount.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/gateway"
 	"github.com/disgoorg/log"
 	"github.com/disgoorg/snowflake/v2"
@@ -31,6 +32,7 @@ type shardManagerImpl struct {
 
 	token            string
 	eventHandlerFunc gateway.EventHandlerFunc
+	inFlight         inFlightEvents
 	config           Config
 }
 
@@ -38,6 +40,20 @@ func (m *shardManagerImpl) Logger() log.Logger {
 	return m.config.Logger
 }
 
+func (m *shardManagerImpl) handleEvent(eventType gateway.EventType, sequenceNumber int, shardID int, event gateway.EventData) {
+	m.inFlight.begin(shardID)
+	defer m.inFlight.end(shardID)
+	m.eventHandlerFunc(eventType, sequenceNumber, shardID, event)
+}
+
+func (m *shardManagerImpl) dispatchRebalance(shard gateway.Gateway, event gateway.EventShardRebalance) {
+	var sequenceNumber int
+	if sequence := shard.LastSequenceReceived(); sequence != nil {
+		sequenceNumber = *sequence
+	}
+	m.handleEvent(gateway.EventTypeShardRebalance, sequenceNumber, shard.ShardID(), event)
+}
+
 func (m *shardManagerImpl) closeHandler(shard gateway.Gateway, err error) {
 	if closeError, ok := err.(*websocket.CloseError); !m.config.AutoScaling || !ok || gateway.CloseEventCode(closeError.Code) != gateway.CloseEventCodeShardingRequired {
 		return
@@ -77,7 +93,7 @@ func (m *shardManagerImpl) closeHandler(shard gateway.Gateway, err error) {
 			}
 			defer m.config.RateLimiter.UnlockBucket(shardID)
 
-			newShard := m.config.GatewayCreateFunc(m.token, m.eventHandlerFunc, m.closeHandler, append(m.config.GatewayConfigOpts, gateway.WithShardID(shardID), gateway.WithShardCount(newShardCount))...)
+			newShard := m.config.GatewayCreateFunc(m.token, m.handleEvent, m.closeHandler, append(m.config.GatewayConfigOpts, gateway.WithShardID(shardID), gateway.WithShardCount(newShardCount), gateway.WithSessionStartLimiter(m.config.RateLimiter))...)
 			m.shards[shardID] = newShard
 			if err := newShard.Open(context.TODO()); err != nil {
 				m.Logger().Errorf("failed to re shard %d, error: %s", shardID, err)
@@ -85,6 +101,11 @@ func (m *shardManagerImpl) closeHandler(shard gateway.Gateway, err error) {
 		}()
 	}
 	wg.Wait()
+	m.dispatchRebalance(shard, gateway.EventShardRebalance{
+		State:       gateway.ShardRebalanceStateResharded,
+		ShardCount:  newShardCount,
+		NewShardIDs: newShardIDs,
+	})
 	m.Logger().Debugf("re-sharded shard %d into newShards: %d, newShardCount: %d", shard.ShardID(), newShardIDs, newShardCount)
 }
 
@@ -109,7 +130,7 @@ func (m *shardManagerImpl) Open(ctx context.Context) {
 			}
 			defer m.config.RateLimiter.UnlockBucket(shardID)
 
-			shard := m.config.GatewayCreateFunc(m.token, m.eventHandlerFunc, m.closeHandler, append(m.config.GatewayConfigOpts, gateway.WithShardID(shardID), gateway.WithShardCount(m.config.ShardCount))...)
+			shard := m.config.GatewayCreateFunc(m.token, m.handleEvent, m.closeHandler, append(m.config.GatewayConfigOpts, gateway.WithShardID(shardID), gateway.WithShardCount(m.config.ShardCount), gateway.WithSessionStartLimiter(m.config.RateLimiter))...)
 			m.shards[shardID] = shard
 			if err := shard.Open(ctx); err != nil {
 				m.Logger().Errorf("failed to open shard %d: %s", shardID, err)
@@ -148,7 +169,7 @@ func (m *shardManagerImpl) openShard(ctx context.Context, shardID int, shardCoun
 		return err
 	}
 	defer m.config.RateLimiter.UnlockBucket(shardID)
-	shard := m.config.GatewayCreateFunc(m.token, m.eventHandlerFunc, m.closeHandler, append(m.config.GatewayConfigOpts, gateway.WithShardID(shardID), gateway.WithShardCount(shardCount))...)
+	shard := m.config.GatewayCreateFunc(m.token, m.handleEvent, m.closeHandler, append(m.config.GatewayConfigOpts, gateway.WithShardID(shardID), gateway.WithShardCount(shardCount), gateway.WithSessionStartLimiter(m.config.RateLimiter))...)
 
 	m.shardsMu.Lock()
 	defer m.shardsMu.Unlock()
@@ -168,6 +189,32 @@ func (m *shardManagerImpl) CloseShard(ctx context.Context, shardID int) {
 	}
 }
 
+func (m *shardManagerImpl) Drain(ctx context.Context, shardID int) error {
+	m.Logger().Debugf("draining shard %d...", shardID)
+	m.shardsMu.Lock()
+	shard, ok := m.shards[shardID]
+	delete(m.shards, shardID)
+	m.shardsMu.Unlock()
+	if !ok {
+		return discord.ErrShardNotFound
+	}
+
+	m.dispatchRebalance(shard, gateway.EventShardRebalance{
+		State:      gateway.ShardRebalanceStateDraining,
+		ShardCount: shard.ShardCount(),
+	})
+	shard.Close(ctx)
+	if err := m.inFlight.wait(ctx, shardID); err != nil {
+		return err
+	}
+	m.dispatchRebalance(shard, gateway.EventShardRebalance{
+		State:      gateway.ShardRebalanceStateDrained,
+		ShardCount: shard.ShardCount(),
+	})
+	m.Logger().Debugf("drained shard %d", shardID)
+	return nil
+}
+
 func (m *shardManagerImpl) ShardByGuildID(guildId snowflake.ID) gateway.Gateway {
 	shardCount := m.config.ShardCount
 	var shard gateway.Gateway
@@ -193,3 +240,7 @@ func (m *shardManagerImpl) Shards() map[int]gateway.Gateway {
 	}
 	return m.shards
 }
+
+func (m *shardManagerImpl) SessionStartLimit() *discord.SessionStartLimit {
+	return m.config.RateLimiter.SessionStartLimit()
+}
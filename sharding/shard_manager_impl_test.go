@@ -0,0 +1,133 @@
+package sharding
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeGateway is a gateway.Gateway which hands its gateway.EventHandlerFunc to the test instead of connecting to Discord.
+type fakeGateway struct {
+	gateway.Gateway
+	shardID          int
+	eventHandlerFunc gateway.EventHandlerFunc
+
+	mu     sync.Mutex
+	closed bool
+}
+
+func (g *fakeGateway) ShardID() int {
+	return g.shardID
+}
+
+func (g *fakeGateway) ShardCount() int {
+	return 1
+}
+
+func (g *fakeGateway) LastSequenceReceived() *int {
+	return nil
+}
+
+func (g *fakeGateway) Open(context.Context) error {
+	return nil
+}
+
+func (g *fakeGateway) Close(context.Context) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.closed = true
+}
+
+func (g *fakeGateway) isClosed() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.closed
+}
+
+func newTestShardManager(t *testing.T, eventHandlerFunc gateway.EventHandlerFunc) (ShardManager, *fakeGateway) {
+	var shard *fakeGateway
+	m := New("token", eventHandlerFunc,
+		WithShardIDs(0),
+		WithShardCount(1),
+		WithGatewayCreateFunc(func(_ string, eventHandlerFunc gateway.EventHandlerFunc, _ gateway.CloseHandlerFunc, opts ...gateway.ConfigOpt) gateway.Gateway {
+			config := gateway.DefaultConfig()
+			config.Apply(opts)
+			shard = &fakeGateway{shardID: config.ShardID, eventHandlerFunc: eventHandlerFunc}
+			return shard
+		}),
+	)
+	assert.NoError(t, m.OpenShard(context.Background(), 0))
+	return m, shard
+}
+
+func TestShardManager_Drain(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		states []gateway.ShardRebalanceState
+	)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	m, shard := newTestShardManager(t, func(eventType gateway.EventType, _ int, _ int, event gateway.EventData) {
+		if eventType == gateway.EventTypeShardRebalance {
+			mu.Lock()
+			defer mu.Unlock()
+			states = append(states, event.(gateway.EventShardRebalance).State)
+			return
+		}
+		close(started)
+		<-release
+	})
+
+	// an event of the shard is still handled while it's drained
+	go shard.eventHandlerFunc(gateway.EventTypeMessageCreate, 1, 0, gateway.EventMessageCreate{})
+	<-started
+
+	drained := make(chan error, 1)
+	go func() {
+		drained <- m.Drain(context.Background(), 0)
+	}()
+	select {
+	case <-drained:
+		t.Fatal("shard was drained before the in-flight event was handled")
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.True(t, shard.isClosed())
+	assert.Nil(t, m.Shard(0))
+
+	close(release)
+	select {
+	case err := <-drained:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("shard was not drained after the in-flight event was handled")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []gateway.ShardRebalanceState{gateway.ShardRebalanceStateDraining, gateway.ShardRebalanceStateDrained}, states)
+}
+
+func TestShardManager_DrainContextDone(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{})
+	m, shard := newTestShardManager(t, func(eventType gateway.EventType, _ int, _ int, _ gateway.EventData) {
+		if eventType == gateway.EventTypeShardRebalance {
+			return
+		}
+		close(started)
+		<-release
+	})
+	go shard.eventHandlerFunc(gateway.EventTypeMessageCreate, 1, 0, gateway.EventMessageCreate{})
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, m.Drain(ctx, 0), context.DeadlineExceeded)
+	assert.ErrorIs(t, m.Drain(context.Background(), 0), discord.ErrShardNotFound)
+}
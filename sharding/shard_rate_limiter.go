@@ -3,11 +3,16 @@ package sharding
 import (
 	"context"
 
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
 	"github.com/disgoorg/log"
 )
 
 // RateLimiter limits how many shards can log in to Discord at the same time.
+// It also budgets the identifies of the shards by the discord.SessionStartLimit as their gateway.SessionStartLimiter, so only identifies are counted and resumes are not.
 type RateLimiter interface {
+	gateway.SessionStartLimiter
+
 	// Logger returns the logger the RateLimiter uses
 	Logger() log.Logger
 
@@ -19,6 +24,9 @@ type RateLimiter interface {
 	// If the context deadline is exceeded, WaitBucket will return immediately and no login will be attempted.
 	WaitBucket(ctx context.Context, shardID int) error
 
+	// SessionStartLimit returns the current discord.SessionStartLimit or nil if it is unknown.
+	SessionStartLimit() *discord.SessionStartLimit
+
 	// UnlockBucket unlocks the given shardID bucket.
 	UnlockBucket(shardID int)
 }
@@ -1,6 +1,8 @@
 package sharding
 
 import (
+	"github.com/disgoorg/disgo/clock"
+	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/log"
 )
 
@@ -9,15 +11,23 @@ func DefaultRateLimiterConfig() *RateLimiterConfig {
 	return &RateLimiterConfig{
 		Logger:         log.Default(),
 		MaxConcurrency: 1,
+		Clock:          clock.Default,
 	}
 }
 
 // RateLimiterConfig lets you configure your RateLimiter instance.
 type RateLimiterConfig struct {
-	Logger         log.Logger
-	MaxConcurrency int
+	Logger                        log.Logger
+	MaxConcurrency                int
+	SessionStartLimit             *discord.SessionStartLimit
+	SessionStartLimitExceededFunc SessionStartLimitExceededFunc
+	Clock                         clock.Clock
 }
 
+// SessionStartLimitExceededFunc is called when a shard wants to identify but no identifies are remaining in the discord.SessionStartLimit.
+// Return true to queue the identify until the limit resets or false to refuse it with discord.ErrSessionStartLimitExceeded.
+type SessionStartLimitExceededFunc func(shardID int, limit discord.SessionStartLimit) bool
+
 // RateLimiterConfigOpt is a type alias for a function that takes a RateLimiterConfig and is used to configure your Server.
 type RateLimiterConfigOpt func(config *RateLimiterConfig)
 
@@ -41,3 +51,24 @@ func WithMaxConcurrency(maxConcurrency int) RateLimiterConfigOpt {
 		config.MaxConcurrency = maxConcurrency
 	}
 }
+
+// WithSessionStartLimit sets the discord.SessionStartLimit returned by /gateway/bot, which is used to budget identifies.
+func WithSessionStartLimit(sessionStartLimit discord.SessionStartLimit) RateLimiterConfigOpt {
+	return func(config *RateLimiterConfig) {
+		config.SessionStartLimit = &sessionStartLimit
+	}
+}
+
+// WithSessionStartLimitExceededFunc sets the SessionStartLimitExceededFunc which decides whether identifies exceeding the discord.SessionStartLimit are queued or refused.
+func WithSessionStartLimitExceededFunc(sessionStartLimitExceededFunc SessionStartLimitExceededFunc) RateLimiterConfigOpt {
+	return func(config *RateLimiterConfig) {
+		config.SessionStartLimitExceededFunc = sessionStartLimitExceededFunc
+	}
+}
+
+// WithRateLimiterClock sets the clock.Clock the RateLimiter uses to space identifies and track the session start limit reset.
+func WithRateLimiterClock(clock clock.Clock) RateLimiterConfigOpt {
+	return func(config *RateLimiterConfig) {
+		config.Clock = clock
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/log"
 	"github.com/sasha-s/go-csync"
 )
@@ -16,10 +17,15 @@ func NewRateLimiter(opts ...RateLimiterConfigOpt) RateLimiter {
 	config := DefaultRateLimiterConfig()
 	config.Apply(opts)
 
-	return &rateLimiterImpl{
+	r := &rateLimiterImpl{
 		buckets: map[int]*bucket{},
 		config:  *config,
 	}
+	if config.SessionStartLimit != nil {
+		r.sessionStartLimit = *config.SessionStartLimit
+		r.sessionStartLimitResetAt = r.config.Clock.Now().Add(config.SessionStartLimit.ResetAfterDuration())
+	}
+	return r
 }
 
 type rateLimiterImpl struct {
@@ -27,6 +33,10 @@ type rateLimiterImpl struct {
 
 	buckets map[int]*bucket
 	config  RateLimiterConfig
+
+	sessionStartLimitMu      sync.Mutex
+	sessionStartLimit        discord.SessionStartLimit
+	sessionStartLimitResetAt time.Time
 }
 
 func (r *rateLimiterImpl) Logger() log.Logger {
@@ -72,6 +82,71 @@ func (r *rateLimiterImpl) getBucket(shardID int, create bool) *bucket {
 	return b
 }
 
+func (r *rateLimiterImpl) SessionStartLimit() *discord.SessionStartLimit {
+	if r.config.SessionStartLimit == nil {
+		return nil
+	}
+	r.sessionStartLimitMu.Lock()
+	defer r.sessionStartLimitMu.Unlock()
+	r.resetSessionStartLimit()
+
+	limit := r.sessionStartLimit
+	limit.ResetAfter = int(r.sessionStartLimitResetAt.Sub(r.config.Clock.Now()).Milliseconds())
+	return &limit
+}
+
+// resetSessionStartLimit restores the remaining identifies once the session start limit reset. sessionStartLimitMu must be locked.
+func (r *rateLimiterImpl) resetSessionStartLimit() {
+	if now := r.config.Clock.Now(); !now.Before(r.sessionStartLimitResetAt) {
+		r.sessionStartLimit.Remaining = r.sessionStartLimit.Total
+		r.sessionStartLimitResetAt = now.Add(r.sessionStartLimit.ResetAfterDuration())
+	}
+}
+
+// UseSessionStart takes one identify from the session start limit budget or waits for the reset if the SessionStartLimitExceededFunc allows it.
+// If no identifies are remaining and the SessionStartLimitExceededFunc doesn't allow waiting, discord.ErrSessionStartLimitExceeded is returned.
+func (r *rateLimiterImpl) UseSessionStart(ctx context.Context, shardID int) error {
+	if r.config.SessionStartLimit == nil {
+		return nil
+	}
+	r.sessionStartLimitMu.Lock()
+	r.resetSessionStartLimit()
+	if r.sessionStartLimit.Remaining > 0 {
+		r.sessionStartLimit.Remaining--
+		r.sessionStartLimitMu.Unlock()
+		return nil
+	}
+	limit := r.sessionStartLimit
+	resetAt := r.sessionStartLimitResetAt
+	limit.ResetAfter = int(resetAt.Sub(r.config.Clock.Now()).Milliseconds())
+	r.sessionStartLimitMu.Unlock()
+
+	if r.config.SessionStartLimitExceededFunc == nil || !r.config.SessionStartLimitExceededFunc(shardID, limit) {
+		r.Logger().Warnf("refusing identify of shard %d, session start limit exceeded until %s", shardID, resetAt)
+		return discord.ErrSessionStartLimitExceeded
+	}
+
+	r.Logger().Warnf("queuing identify of shard %d until session start limit resets at %s", shardID, resetAt)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-r.config.Clock.After(resetAt.Sub(r.config.Clock.Now())):
+	}
+	return r.UseSessionStart(ctx, shardID)
+}
+
+func (r *rateLimiterImpl) RefundSessionStart(_ int) {
+	if r.config.SessionStartLimit == nil {
+		return
+	}
+	r.sessionStartLimitMu.Lock()
+	defer r.sessionStartLimitMu.Unlock()
+	r.resetSessionStartLimit()
+	if r.sessionStartLimit.Remaining < r.sessionStartLimit.Total {
+		r.sessionStartLimit.Remaining++
+	}
+}
+
 func (r *rateLimiterImpl) WaitBucket(ctx context.Context, shardID int) error {
 	b := r.getBucket(shardID, true)
 	r.Logger().Debugf("locking shard bucket: Key: %d, Reset: %s", b.Key, b.Reset)
@@ -80,7 +155,7 @@ func (r *rateLimiterImpl) WaitBucket(ctx context.Context, shardID int) error {
 	}
 
 	var until time.Time
-	now := time.Now()
+	now := r.config.Clock.Now()
 
 	if b.Reset.After(now) {
 		until = b.Reset
@@ -88,6 +163,7 @@ func (r *rateLimiterImpl) WaitBucket(ctx context.Context, shardID int) error {
 
 	if until.After(now) {
 		if deadline, ok := ctx.Deadline(); ok && until.After(deadline) {
+			b.mu.Unlock()
 			return context.DeadlineExceeded
 		}
 
@@ -95,7 +171,7 @@ func (r *rateLimiterImpl) WaitBucket(ctx context.Context, shardID int) error {
 		case <-ctx.Done():
 			b.mu.Unlock()
 			return ctx.Err()
-		case <-time.After(until.Sub(now)):
+		case <-r.config.Clock.After(until.Sub(now)):
 		}
 	}
 	return nil
@@ -111,7 +187,7 @@ func (r *rateLimiterImpl) UnlockBucket(shardID int) {
 		b.mu.Unlock()
 	}()
 
-	b.Reset = time.Now().Add(5 * time.Second)
+	b.Reset = r.config.Clock.Now().Add(5 * time.Second)
 }
 
 type bucket struct {
@@ -0,0 +1,77 @@
+package sharding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/clock"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRateLimiter(mock *clock.Mock, exceededFunc SessionStartLimitExceededFunc) RateLimiter {
+	return NewRateLimiter(
+		WithRateLimiterClock(mock),
+		WithSessionStartLimit(discord.SessionStartLimit{Total: 2, Remaining: 2, ResetAfter: int(time.Hour.Milliseconds()), MaxConcurrency: 1}),
+		WithSessionStartLimitExceededFunc(exceededFunc),
+	)
+}
+
+func TestRateLimiter_SessionStart(t *testing.T) {
+	mock := clock.NewMock(time.Now())
+	r := newTestRateLimiter(mock, nil)
+
+	// waiting for the bucket doesn't use the budget, as the shard might resume
+	assert.NoError(t, r.WaitBucket(context.Background(), 0))
+	r.UnlockBucket(0)
+	assert.Equal(t, 2, r.SessionStartLimit().Remaining)
+
+	assert.NoError(t, r.UseSessionStart(context.Background(), 0))
+	assert.NoError(t, r.UseSessionStart(context.Background(), 1))
+	assert.Equal(t, 0, r.SessionStartLimit().Remaining)
+	assert.ErrorIs(t, r.UseSessionStart(context.Background(), 2), discord.ErrSessionStartLimitExceeded)
+
+	// a failed identify is refunded, but never above the total
+	r.RefundSessionStart(1)
+	assert.Equal(t, 1, r.SessionStartLimit().Remaining)
+	r.RefundSessionStart(1)
+	r.RefundSessionStart(1)
+	assert.Equal(t, 2, r.SessionStartLimit().Remaining)
+
+	assert.NoError(t, r.UseSessionStart(context.Background(), 0))
+	assert.NoError(t, r.UseSessionStart(context.Background(), 1))
+	mock.Advance(time.Hour)
+	assert.Equal(t, 2, r.SessionStartLimit().Remaining)
+}
+
+func TestRateLimiter_SessionStartWait(t *testing.T) {
+	mock := clock.NewMock(time.Now())
+	r := newTestRateLimiter(mock, func(int, discord.SessionStartLimit) bool { return true })
+
+	assert.NoError(t, r.UseSessionStart(context.Background(), 0))
+	assert.NoError(t, r.UseSessionStart(context.Background(), 1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, r.UseSessionStart(ctx, 2), context.Canceled)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- r.UseSessionStart(context.Background(), 2)
+	}()
+	select {
+	case <-done:
+		t.Fatal("identify was not queued until the reset")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	mock.Advance(time.Hour)
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("identify was not released after the reset")
+	}
+	assert.Equal(t, 1, r.SessionStartLimit().Remaining)
+}
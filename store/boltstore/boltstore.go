@@ -0,0 +1,111 @@
+// Package boltstore provides a store.KV implementation backed by a bbolt database.
+package boltstore
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"go.etcd.io/bbolt"
+
+	"github.com/disgoorg/disgo/clock"
+	"github.com/disgoorg/disgo/store"
+)
+
+// DefaultBucket is the bucket used when no bucket name is provided to New.
+const DefaultBucket = "disgo"
+
+var _ store.KV = (*boltKV)(nil)
+
+// New returns a new store.KV which stores its values in the given bucket of the bbolt.DB.
+// The bucket is created if it does not exist yet. Closing the store.KV does not close the bbolt.DB.
+func New(db *bbolt.DB, bucket string) (store.KV, error) {
+	return NewWithClock(db, bucket, clock.Default)
+}
+
+// NewWithClock returns a new store.KV like New which uses the given clock.Clock to expire values.
+func NewWithClock(db *bbolt.DB, bucket string, clock clock.Clock) (store.KV, error) {
+	if bucket == "" {
+		bucket = DefaultBucket
+	}
+	if err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return &boltKV{db: db, bucket: []byte(bucket), clock: clock}, nil
+}
+
+type boltKV struct {
+	db     *bbolt.DB
+	bucket []byte
+	clock  clock.Clock
+}
+
+// values are stored as 8 bytes unix nano expiry (0 = never) followed by the actual value
+func encode(value []byte, now time.Time, ttl time.Duration) []byte {
+	var expiresAt int64
+	if ttl > 0 {
+		expiresAt = now.Add(ttl).UnixNano()
+	}
+	data := make([]byte, 8+len(value))
+	binary.BigEndian.PutUint64(data, uint64(expiresAt))
+	copy(data[8:], value)
+	return data
+}
+
+func decode(data []byte, now time.Time) ([]byte, bool) {
+	if len(data) < 8 {
+		return nil, false
+	}
+	expiresAt := int64(binary.BigEndian.Uint64(data))
+	if expiresAt != 0 && now.UnixNano() >= expiresAt {
+		return nil, false
+	}
+	value := make([]byte, len(data)-8)
+	copy(value, data[8:])
+	return value, true
+}
+
+func (b *boltKV) Get(_ context.Context, key string) ([]byte, error) {
+	var (
+		value   []byte
+		found   bool
+		expired bool
+	)
+	if err := b.db.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket(b.bucket).Get([]byte(key))
+		if data == nil {
+			return nil
+		}
+		value, found = decode(data, b.clock.Now())
+		expired = !found
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if expired {
+		_ = b.Delete(context.Background(), key)
+	}
+	if !found {
+		return nil, store.ErrNotFound
+	}
+	return value, nil
+}
+
+func (b *boltKV) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(b.bucket).Put([]byte(key), encode(value, b.clock.Now(), ttl))
+	})
+}
+
+func (b *boltKV) Delete(_ context.Context, key string) error {
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(b.bucket).Delete([]byte(key))
+	})
+}
+
+func (b *boltKV) Close() error {
+	return nil
+}
@@ -0,0 +1,74 @@
+package boltstore
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/bbolt"
+
+	"github.com/disgoorg/disgo/clock"
+	"github.com/disgoorg/disgo/store"
+)
+
+func newTestDB(t *testing.T) *bbolt.DB {
+	db, err := bbolt.Open(filepath.Join(t.TempDir(), "test.db"), 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	return db
+}
+
+func TestBoltKV(t *testing.T) {
+	kv, err := New(newTestDB(t), "")
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = kv.Get(ctx, "key")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	assert.NoError(t, kv.Set(ctx, "key", []byte("value"), 0))
+	value, err := kv.Get(ctx, "key")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	assert.NoError(t, kv.Delete(ctx, "key"))
+	_, err = kv.Get(ctx, "key")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	assert.NoError(t, kv.Delete(ctx, "key"))
+}
+
+func TestBoltKV_Buckets(t *testing.T) {
+	db := newTestDB(t)
+	a, err := New(db, "a")
+	assert.NoError(t, err)
+	b, err := New(db, "b")
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	assert.NoError(t, a.Set(ctx, "key", []byte("a"), 0))
+	_, err = b.Get(ctx, "key")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
+
+func TestBoltKV_TTL(t *testing.T) {
+	mock := clock.NewMock(time.Now())
+	kv, err := NewWithClock(newTestDB(t), "", mock)
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	assert.NoError(t, kv.Set(ctx, "key", []byte("value"), time.Minute))
+	mock.Advance(time.Minute - time.Nanosecond)
+	value, err := kv.Get(ctx, "key")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	mock.Advance(time.Nanosecond)
+	_, err = kv.Get(ctx, "key")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
@@ -0,0 +1,18 @@
+module github.com/disgoorg/disgo/store/boltstore
+
+go 1.18
+
+replace github.com/disgoorg/disgo => ../../
+
+require (
+	github.com/disgoorg/disgo v0.11.5
+	github.com/stretchr/testify v1.7.0
+	go.etcd.io/bbolt v1.3.6
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/sys v0.0.0-20200923182605-d9f96fdee20d // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+)
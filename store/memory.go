@@ -0,0 +1,84 @@
+package store
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/clock"
+)
+
+var _ KV = (*memoryKV)(nil)
+
+// NewMemory returns a new in-memory KV. Expired values are removed lazily when they are accessed or overwritten.
+func NewMemory() KV {
+	return NewMemoryWithClock(clock.Default)
+}
+
+// NewMemoryWithClock returns a new in-memory KV which uses the given clock.Clock to expire values.
+func NewMemoryWithClock(clock clock.Clock) KV {
+	return &memoryKV{
+		clock:  clock,
+		values: map[string]memoryValue{},
+	}
+}
+
+type memoryValue struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+func (v memoryValue) expired(now time.Time) bool {
+	return !v.expiresAt.IsZero() && !now.Before(v.expiresAt)
+}
+
+type memoryKV struct {
+	mu     sync.Mutex
+	clock  clock.Clock
+	values map[string]memoryValue
+}
+
+func (m *memoryKV) Get(_ context.Context, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.values[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if v.expired(m.clock.Now()) {
+		delete(m.values, key)
+		return nil, ErrNotFound
+	}
+	value := make([]byte, len(v.value))
+	copy(value, v.value)
+	return value, nil
+}
+
+func (m *memoryKV) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	v := memoryValue{
+		value: make([]byte, len(value)),
+	}
+	copy(v.value, value)
+	if ttl > 0 {
+		v.expiresAt = m.clock.Now().Add(ttl)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.values[key] = v
+	return nil
+}
+
+func (m *memoryKV) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.values, key)
+	return nil
+}
+
+func (m *memoryKV) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.values = map[string]memoryValue{}
+	return nil
+}
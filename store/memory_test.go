@@ -0,0 +1,35 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/disgo/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemory_TTL(t *testing.T) {
+	mock := clock.NewMock(time.Now())
+	kv := NewMemoryWithClock(mock)
+	ctx := context.Background()
+
+	assert.NoError(t, kv.Set(ctx, "key", []byte("value"), 10*time.Millisecond))
+	value, err := kv.Get(ctx, "key")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	mock.Advance(10 * time.Millisecond)
+	_, err = kv.Get(ctx, "key")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestPrefixed(t *testing.T) {
+	kv := NewMemory()
+	ctx := context.Background()
+
+	assert.NoError(t, Prefixed(kv, "a:").Set(ctx, "key", []byte("value"), 0))
+	value, err := kv.Get(ctx, "a:key")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
@@ -0,0 +1,21 @@
+module github.com/disgoorg/disgo/store/redisstore
+
+go 1.18
+
+replace github.com/disgoorg/disgo => ../../
+
+require (
+	github.com/alicebob/miniredis/v2 v2.39.0
+	github.com/disgoorg/disgo v0.11.5
+	github.com/redis/go-redis/v9 v9.0.5
+	github.com/stretchr/testify v1.7.0
+)
+
+require (
+	github.com/cespare/xxhash/v2 v2.2.0 // indirect
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/yuin/gopher-lua v1.1.1 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+)
@@ -0,0 +1,44 @@
+// Package redisstore provides a store.KV implementation backed by redis.
+package redisstore
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/disgoorg/disgo/store"
+)
+
+var _ store.KV = (*redisKV)(nil)
+
+// New returns a new store.KV which stores its values in redis using the given redis.UniversalClient.
+// Closing the store.KV does not close the redis.UniversalClient.
+func New(client redis.UniversalClient) store.KV {
+	return &redisKV{client: client}
+}
+
+type redisKV struct {
+	client redis.UniversalClient
+}
+
+func (r *redisKV) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := r.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, store.ErrNotFound
+	}
+	return value, err
+}
+
+func (r *redisKV) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return r.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (r *redisKV) Delete(ctx context.Context, key string) error {
+	return r.client.Del(ctx, key).Err()
+}
+
+func (r *redisKV) Close() error {
+	return nil
+}
@@ -0,0 +1,47 @@
+package redisstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo/store"
+)
+
+func TestRedisKV(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	defer client.Close()
+	kv := New(client)
+	ctx := context.Background()
+
+	_, err := kv.Get(ctx, "key")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	assert.NoError(t, kv.Set(ctx, "key", []byte("value"), 0))
+	value, err := kv.Get(ctx, "key")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	assert.NoError(t, kv.Delete(ctx, "key"))
+	_, err = kv.Get(ctx, "key")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	assert.NoError(t, kv.Delete(ctx, "key"))
+}
+
+func TestRedisKV_TTL(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	defer client.Close()
+	kv := New(client)
+	ctx := context.Background()
+
+	assert.NoError(t, kv.Set(ctx, "key", []byte("value"), time.Minute))
+	server.FastForward(time.Minute)
+	_, err := kv.Get(ctx, "key")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
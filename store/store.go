@@ -0,0 +1,55 @@
+// Package store provides a shared key value store abstraction with TTL support which is used to persist state across restarts.
+// The bbolt & redis implementations are the separate boltstore & redisstore modules, so their dependencies are only needed if used.
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrNotFound is returned by KV.Get when no value exists for the given key or the value expired.
+var ErrNotFound = errors.New("key not found")
+
+// KV is a simple key value store with TTL support.
+// Implementations need to be thread safe.
+type KV interface {
+	// Get returns the value stored under the given key or ErrNotFound if there is none.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Set stores the value under the given key. A ttl of 0 means the value never expires.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+
+	// Delete removes the value stored under the given key. Deleting a non-existing key is not an error.
+	Delete(ctx context.Context, key string) error
+
+	// Close releases all resources held by the KV.
+	Close() error
+}
+
+// Prefixed returns a KV which prefixes all keys with the given prefix before passing them to the underlying KV.
+// This allows multiple modules to share one KV without key collisions.
+func Prefixed(kv KV, prefix string) KV {
+	return &prefixedKV{kv: kv, prefix: prefix}
+}
+
+type prefixedKV struct {
+	kv     KV
+	prefix string
+}
+
+func (p *prefixedKV) Get(ctx context.Context, key string) ([]byte, error) {
+	return p.kv.Get(ctx, p.prefix+key)
+}
+
+func (p *prefixedKV) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return p.kv.Set(ctx, p.prefix+key, value, ttl)
+}
+
+func (p *prefixedKV) Delete(ctx context.Context, key string) error {
+	return p.kv.Delete(ctx, p.prefix+key)
+}
+
+func (p *prefixedKV) Close() error {
+	return p.kv.Close()
+}
@@ -0,0 +1,196 @@
+// Package upload provides an Uploader which fetches images & sounds from a URL and uploads them as emojis, stickers or soundboard sounds.
+package upload
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// Maximum file sizes discord accepts for the respective upload.
+const (
+	MaxEmojiSize           = 256 * 1024
+	MaxStickerSize         = 512 * 1024
+	MaxSoundboardSoundSize = 512 * 1024
+)
+
+// emojiTypes, stickerTypes and soundTypes map the content types discord accepts for the respective upload to their discord.IconType & file extension.
+var (
+	emojiTypes = map[string]discord.IconType{
+		"image/png":  discord.IconTypePNG,
+		"image/jpeg": discord.IconTypeJPEG,
+		"image/gif":  discord.IconTypeGIF,
+		"image/webp": discord.IconTypeWEBP,
+	}
+	stickerTypes = map[string]string{
+		"image/png":  ".png",
+		"image/apng": ".png",
+		"image/gif":  ".gif",
+	}
+	soundTypes = map[string]discord.IconType{
+		"audio/mpeg":      discord.IconTypeMP3,
+		"audio/mp3":       discord.IconTypeMP3,
+		"audio/ogg":       discord.IconTypeOGG,
+		"application/ogg": discord.IconTypeOGG,
+	}
+)
+
+// New returns a new Uploader with the given ConfigOpt(s) applied.
+// The bot requires the discord.PermissionCreateGuildExpressions or discord.PermissionManageEmojisAndStickers permission in the guilds it uploads to.
+func New(client bot.Client, opts ...ConfigOpt) *Uploader {
+	config := DefaultConfig()
+	config.Apply(opts)
+	if config.HTTPClient == nil {
+		config.HTTPClient = client.Rest().HTTPClient()
+	}
+
+	return &Uploader{
+		client: client,
+		config: *config,
+	}
+}
+
+// Uploader fetches files from a URL, validates their size & content type and uploads them to a guild in one call.
+type Uploader struct {
+	client bot.Client
+	config Config
+}
+
+// Emoji fetches the image at the URL and creates an emoji from it. The Image of the discord.EmojiCreate is replaced by the fetched image.
+// PNG, JPEG, GIF & WEBP images of at most MaxEmojiSize bytes are accepted.
+func (u *Uploader) Emoji(ctx context.Context, guildID snowflake.ID, emojiCreate discord.EmojiCreate, url string) (*discord.Emoji, error) {
+	data, contentType, err := fetch(ctx, u.config.HTTPClient, url, MaxEmojiSize, emojiTypes)
+	if err != nil {
+		return nil, err
+	}
+	iconType := emojiTypes[contentType]
+	if err = u.checkEmojiLimit(guildID, iconType == discord.IconTypeGIF); err != nil {
+		return nil, err
+	}
+	emojiCreate.Image = *discord.NewIconRaw(iconType, data)
+	return u.client.Rest().CreateEmoji(guildID, emojiCreate, rest.WithCtx(ctx))
+}
+
+// Sticker fetches the image at the URL and creates a sticker from it. The File of the discord.StickerCreate is replaced by the fetched image.
+// PNG, APNG & GIF images of at most MaxStickerSize bytes are accepted.
+func (u *Uploader) Sticker(ctx context.Context, guildID snowflake.ID, stickerCreate discord.StickerCreate, url string) (*discord.Sticker, error) {
+	data, contentType, err := fetch(ctx, u.config.HTTPClient, url, MaxStickerSize, stickerTypes)
+	if err != nil {
+		return nil, err
+	}
+	if err = u.checkStickerLimit(guildID); err != nil {
+		return nil, err
+	}
+	stickerCreate.File = discord.NewFile(stickerCreate.Name+stickerTypes[contentType], "", bytes.NewReader(data))
+	return u.client.Rest().CreateSticker(guildID, stickerCreate, rest.WithCtx(ctx))
+}
+
+// SoundboardSound fetches the sound at the URL and creates a soundboard sound from it. The Sound of the discord.SoundboardSoundCreate is replaced by the fetched sound.
+// MP3 & OGG files of at most MaxSoundboardSoundSize bytes are accepted. Discord additionally rejects sounds longer than 5.2 seconds.
+func (u *Uploader) SoundboardSound(ctx context.Context, guildID snowflake.ID, soundCreate discord.SoundboardSoundCreate, url string) (*discord.SoundboardSound, error) {
+	data, contentType, err := fetch(ctx, u.config.HTTPClient, url, MaxSoundboardSoundSize, soundTypes)
+	if err != nil {
+		return nil, err
+	}
+	soundCreate.Sound = *discord.NewIconRaw(soundTypes[contentType], data)
+	return u.client.Rest().CreateSoundboardSound(guildID, soundCreate, rest.WithCtx(ctx))
+}
+
+// fetch downloads the file at the URL and returns it with its content type if it is at most maxSize bytes and of one of the accepted content types.
+func fetch[T any](ctx context.Context, httpClient *http.Client, url string, maxSize int, accepted map[string]T) ([]byte, string, error) {
+	rq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	rs, err := httpClient.Do(rq)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rs.Body.Close()
+
+	if rs.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("failed to fetch %s: %s", url, rs.Status)
+	}
+	if rs.ContentLength > int64(maxSize) {
+		return nil, "", fmt.Errorf("%w: %d bytes, at most %d bytes are allowed", discord.ErrUploadTooLarge, rs.ContentLength, maxSize)
+	}
+	data, err := io.ReadAll(io.LimitReader(rs.Body, int64(maxSize)+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(data) > maxSize {
+		return nil, "", fmt.Errorf("%w: more than %d bytes", discord.ErrUploadTooLarge, maxSize)
+	}
+
+	// the sniffed content type is preferred as servers often send a generic one like application/octet-stream
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(data))
+	if _, ok := accepted[contentType]; ok {
+		return data, contentType, nil
+	}
+	if header, _, err := mime.ParseMediaType(rs.Header.Get("Content-Type")); err == nil {
+		if _, ok := accepted[header]; ok {
+			return data, header, nil
+		}
+		contentType = header
+	}
+	return nil, "", fmt.Errorf("%w: %s", discord.ErrUnsupportedContentType, contentType)
+}
+
+func (u *Uploader) checkEmojiLimit(guildID snowflake.ID, animated bool) error {
+	if !u.config.CheckGuildLimits {
+		return nil
+	}
+	guild, ok := u.client.Caches().Guilds().Get(guildID)
+	if !ok {
+		return nil
+	}
+	limit := guild.PremiumTier.EmojiLimit()
+	if hasFeature(guild.Features, discord.GuildFeatureMoreEmoji) {
+		limit = discord.PremiumTier3.EmojiLimit()
+	}
+	var count int
+	u.client.Caches().Emojis().GroupForEach(guildID, func(emoji discord.Emoji) {
+		if emoji.Animated == animated {
+			count++
+		}
+	})
+	if count >= limit {
+		return fmt.Errorf("%w: %d/%d", discord.ErrGuildEmojiLimitReached, count, limit)
+	}
+	return nil
+}
+
+func (u *Uploader) checkStickerLimit(guildID snowflake.ID) error {
+	if !u.config.CheckGuildLimits {
+		return nil
+	}
+	guild, ok := u.client.Caches().Guilds().Get(guildID)
+	if !ok {
+		return nil
+	}
+	limit := guild.PremiumTier.StickerLimit()
+	if hasFeature(guild.Features, discord.GuildFeatureMoreStickers) {
+		limit = discord.PremiumTier3.StickerLimit()
+	}
+	if count := u.client.Caches().Stickers().GroupLen(guildID); count >= limit {
+		return fmt.Errorf("%w: %d/%d", discord.ErrGuildStickerLimitReached, count, limit)
+	}
+	return nil
+}
+
+func hasFeature(features []discord.GuildFeature, feature discord.GuildFeature) bool {
+	for _, f := range features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
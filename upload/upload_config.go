@@ -0,0 +1,42 @@
+package upload
+
+import (
+	"net/http"
+)
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() *Config {
+	return &Config{
+		CheckGuildLimits: true,
+	}
+}
+
+// Config lets you configure your Uploader instance.
+type Config struct {
+	HTTPClient       *http.Client
+	CheckGuildLimits bool
+}
+
+// ConfigOpt is a type alias for a function that takes a Config and is used to configure your Uploader.
+type ConfigOpt func(config *Config)
+
+// Apply applies the given ConfigOpt(s) to the Config
+func (c *Config) Apply(opts []ConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithHTTPClient sets the http.Client used to fetch files. By default, the http.Client of the rest client is used.
+func WithHTTPClient(httpClient *http.Client) ConfigOpt {
+	return func(config *Config) {
+		config.HTTPClient = httpClient
+	}
+}
+
+// WithCheckGuildLimits sets whether the free emoji & sticker slots of cached guilds are checked before uploading. By default, this is true.
+func WithCheckGuildLimits(checkGuildLimits bool) ConfigOpt {
+	return func(config *Config) {
+		config.CheckGuildLimits = checkGuildLimits
+	}
+}
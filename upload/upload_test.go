@@ -0,0 +1,43 @@
+package upload
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetch(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n0000")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/image":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write(png)
+		case "/sound":
+			w.Header().Set("Content-Type", "audio/mpeg")
+			_, _ = w.Write([]byte{0xff, 0xfb, 0x90, 0x00})
+		case "/text":
+			_, _ = w.Write([]byte("hello"))
+		}
+	}))
+	defer server.Close()
+
+	data, contentType, err := fetch(context.Background(), server.Client(), server.URL+"/image", MaxEmojiSize, emojiTypes)
+	assert.NoError(t, err)
+	assert.Equal(t, "image/png", contentType)
+	assert.Equal(t, png, data)
+
+	_, contentType, err = fetch(context.Background(), server.Client(), server.URL+"/sound", MaxSoundboardSoundSize, soundTypes)
+	assert.NoError(t, err)
+	assert.Equal(t, "audio/mpeg", contentType)
+
+	_, _, err = fetch(context.Background(), server.Client(), server.URL+"/image", 4, emojiTypes)
+	assert.ErrorIs(t, err, discord.ErrUploadTooLarge)
+
+	_, _, err = fetch(context.Background(), server.Client(), server.URL+"/text", MaxStickerSize, stickerTypes)
+	assert.ErrorIs(t, err, discord.ErrUnsupportedContentType)
+}
@@ -0,0 +1,133 @@
+package voice
+
+import (
+	"math"
+	"sync"
+)
+
+var _ PCMFrameProvider = (*AudioMixer)(nil)
+
+// NewAudioMixer returns a new AudioMixer without any sources.
+func NewAudioMixer() *AudioMixer {
+	return &AudioMixer{
+		sources: map[*MixerSource]struct{}{},
+	}
+}
+
+// AudioMixer is a PCMFrameProvider which combines the frames of multiple PCMFrameProvider(s) into one, so sound effects can overlay background music.
+// Use NewPCMOpusProvider to send the mixed audio to a voice connection.
+type AudioMixer struct {
+	mu      sync.Mutex
+	sources map[*MixerSource]struct{}
+	buf     []int32
+}
+
+// MixerSource is a PCMFrameProvider added to an AudioMixer.
+type MixerSource struct {
+	mixer    *AudioMixer
+	provider PCMFrameProvider
+	volume   float64
+}
+
+// AddSource adds the given PCMFrameProvider with the given volume to the AudioMixer. A volume of 1 keeps the original volume.
+// The source is removed and closed once it returns an error like io.EOF, so a single failing source does not end the mixed stream.
+func (m *AudioMixer) AddSource(provider PCMFrameProvider, volume float64) *MixerSource {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	source := &MixerSource{
+		mixer:    m,
+		provider: provider,
+		volume:   volume,
+	}
+	m.sources[source] = struct{}{}
+	return source
+}
+
+// Len returns the number of sources of the AudioMixer.
+func (m *AudioMixer) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.sources)
+}
+
+// SetVolume sets the volume of the MixerSource. A volume of 1 keeps the original volume.
+func (s *MixerSource) SetVolume(volume float64) {
+	s.mixer.mu.Lock()
+	defer s.mixer.mu.Unlock()
+	s.volume = volume
+}
+
+// Volume returns the volume of the MixerSource.
+func (s *MixerSource) Volume() float64 {
+	s.mixer.mu.Lock()
+	defer s.mixer.mu.Unlock()
+	return s.volume
+}
+
+// Remove removes the MixerSource from its AudioMixer and closes it.
+func (s *MixerSource) Remove() {
+	s.mixer.mu.Lock()
+	_, ok := s.mixer.sources[s]
+	delete(s.mixer.sources, s)
+	s.mixer.mu.Unlock()
+	if ok {
+		s.provider.Close()
+	}
+}
+
+// ProvidePCMFrame returns the mixed frames of all sources or nil if none of them provided audio.
+// It never returns io.EOF, so the AudioMixer can be reused when new sources are added.
+func (m *AudioMixer) ProvidePCMFrame() ([]int16, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.buf == nil {
+		m.buf = make([]int32, PCMFrameLen)
+	}
+	for i := range m.buf {
+		m.buf[i] = 0
+	}
+
+	var mixed int
+	for source := range m.sources {
+		pcm, err := source.provider.ProvidePCMFrame()
+		if err != nil {
+			delete(m.sources, source)
+			source.provider.Close()
+			continue
+		}
+		if pcm == nil {
+			continue
+		}
+		mixed++
+		for i := 0; i < len(pcm) && i < PCMFrameLen; i++ {
+			m.buf[i] += int32(float64(pcm[i]) * source.volume)
+		}
+	}
+	if mixed == 0 {
+		return nil, nil
+	}
+
+	frame := make([]int16, PCMFrameLen)
+	for i, sample := range m.buf {
+		switch {
+		case sample > math.MaxInt16:
+			frame[i] = math.MaxInt16
+		case sample < math.MinInt16:
+			frame[i] = math.MinInt16
+		default:
+			frame[i] = int16(sample)
+		}
+	}
+	return frame, nil
+}
+
+// Close removes and closes all sources of the AudioMixer.
+func (m *AudioMixer) Close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for source := range m.sources {
+		source.provider.Close()
+	}
+	m.sources = map[*MixerSource]struct{}{}
+}
@@ -0,0 +1,60 @@
+package voice
+
+import (
+	"io"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type constPCMProvider struct {
+	sample int16
+	frames int
+}
+
+func (p *constPCMProvider) ProvidePCMFrame() ([]int16, error) {
+	if p.frames == 0 {
+		return nil, io.EOF
+	}
+	p.frames--
+	frame := make([]int16, PCMFrameLen)
+	for i := range frame {
+		frame[i] = p.sample
+	}
+	return frame, nil
+}
+
+func (p *constPCMProvider) Close() {}
+
+func TestAudioMixer(t *testing.T) {
+	mixer := NewAudioMixer()
+
+	frame, err := mixer.ProvidePCMFrame()
+	assert.NoError(t, err)
+	assert.Nil(t, frame)
+
+	mixer.AddSource(&constPCMProvider{sample: 1000, frames: 2}, 1)
+	effect := mixer.AddSource(&constPCMProvider{sample: 1000, frames: 1}, 0.5)
+
+	frame, err = mixer.ProvidePCMFrame()
+	assert.NoError(t, err)
+	assert.Len(t, frame, PCMFrameLen)
+	assert.Equal(t, int16(1500), frame[0])
+
+	effect.SetVolume(2)
+	frame, err = mixer.ProvidePCMFrame()
+	assert.NoError(t, err)
+	assert.Equal(t, int16(1000), frame[0])
+	assert.Equal(t, 1, mixer.Len())
+
+	mixer.AddSource(&constPCMProvider{sample: math.MaxInt16, frames: 1}, 1)
+	frame, err = mixer.ProvidePCMFrame()
+	assert.NoError(t, err)
+	assert.Equal(t, int16(math.MaxInt16), frame[0])
+
+	frame, err = mixer.ProvidePCMFrame()
+	assert.NoError(t, err)
+	assert.Nil(t, frame)
+	assert.Equal(t, 0, mixer.Len())
+}
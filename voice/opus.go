@@ -0,0 +1,17 @@
+package voice
+
+// SilenceAudioFrame is an opus encoded frame of silence.
+// Discord recommends sending SilenceAudioFrames of them whenever audio pauses to avoid unintended opus interpolation on listening clients.
+var SilenceAudioFrame = []byte{0xF8, 0xFF, 0xFE}
+
+// SilenceAudioFrames is the number of SilenceAudioFrame(s) to send when audio pauses.
+const SilenceAudioFrames = 5
+
+// OpusFrameProvider is used to provide opus frames to a voice connection.
+type OpusFrameProvider interface {
+	// ProvideOpusFrame returns the next opus frame. A nil frame means there is currently no audio to send.
+	ProvideOpusFrame() ([]byte, error)
+
+	// Close is called when the OpusFrameProvider is no longer used.
+	Close()
+}
@@ -0,0 +1,74 @@
+package voice
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+)
+
+// ErrInvalidRTPPacket is returned by ParsePacket when the given data is not a valid RTP packet.
+var ErrInvalidRTPPacket = errors.New("invalid rtp packet")
+
+// RTPHeaderSize is the size of the fixed RTP header.
+const RTPHeaderSize = 12
+
+// Packet is a decrypted RTP packet received from or sent to a voice connection.
+type Packet struct {
+	// PayloadType is the RTP payload type, 0x78 for opus.
+	PayloadType uint8
+	// Sequence is the RTP sequence number.
+	Sequence uint16
+	// Timestamp is the RTP timestamp in samples.
+	Timestamp uint32
+	// SSRC identifies the user the audio belongs to. It is announced via the voice gateway Speaking opcode.
+	SSRC uint32
+	// Opus is the opus frame of the packet with the RTP header & header extensions stripped.
+	Opus []byte
+	// ReceivedAt is the time the packet was received.
+	ReceivedAt time.Time
+}
+
+// PacketHook is called for every decrypted Packet of a voice connection, so packets can be forwarded to recording or WebRTC pipelines.
+// The Packet is only valid during the call and has to be copied if it's used afterwards.
+// This package has no voice connection to register it with, so it is the signature for the receive loop of your own connection to call after ParsePacket.
+type PacketHook func(packet *Packet)
+
+// ParsePacket parses a decrypted RTP packet. CSRC identifiers, header extensions & padding are stripped from the payload.
+func ParsePacket(data []byte, receivedAt time.Time) (*Packet, error) {
+	if len(data) < RTPHeaderSize || data[0]>>6 != 2 {
+		return nil, ErrInvalidRTPPacket
+	}
+
+	offset := RTPHeaderSize + int(data[0]&0x0F)*4
+	if len(data) < offset {
+		return nil, ErrInvalidRTPPacket
+	}
+
+	end := len(data)
+	if data[0]&0x20 != 0 {
+		padding := int(data[end-1])
+		if padding == 0 || end-padding < offset {
+			return nil, ErrInvalidRTPPacket
+		}
+		end -= padding
+	}
+
+	if data[0]&0x10 != 0 {
+		if end < offset+4 {
+			return nil, ErrInvalidRTPPacket
+		}
+		offset += 4 + int(binary.BigEndian.Uint16(data[offset+2:offset+4]))*4
+		if end < offset {
+			return nil, ErrInvalidRTPPacket
+		}
+	}
+
+	return &Packet{
+		PayloadType: data[1] & 0x7F,
+		Sequence:    binary.BigEndian.Uint16(data[2:4]),
+		Timestamp:   binary.BigEndian.Uint32(data[4:8]),
+		SSRC:        binary.BigEndian.Uint32(data[8:12]),
+		Opus:        data[offset:end],
+		ReceivedAt:  receivedAt,
+	}, nil
+}
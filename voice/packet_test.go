@@ -0,0 +1,34 @@
+package voice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePacket(t *testing.T) {
+	now := time.Now()
+	data := []byte{
+		0x90, 0x78, 0x00, 0x01, // version 2 with extension, payload type, sequence
+		0x00, 0x00, 0x03, 0xC0, // timestamp
+		0x00, 0x00, 0x00, 0x2A, // ssrc
+		0xBE, 0xDE, 0x00, 0x01, // extension header with 1 word
+		0x00, 0x00, 0x00, 0x00,
+		0xF8, 0xFF, 0xFE,
+	}
+
+	packet, err := ParsePacket(data, now)
+	assert.NoError(t, err)
+	assert.Equal(t, &Packet{
+		PayloadType: 0x78,
+		Sequence:    1,
+		Timestamp:   960,
+		SSRC:        42,
+		Opus:        SilenceAudioFrame,
+		ReceivedAt:  now,
+	}, packet)
+
+	_, err = ParsePacket(data[:8], now)
+	assert.ErrorIs(t, err, ErrInvalidRTPPacket)
+}
@@ -0,0 +1,54 @@
+package voice
+
+const (
+	// SampleRate is the sample rate of the audio discord expects.
+	SampleRate = 48000
+	// Channels is the number of audio channels discord expects.
+	Channels = 2
+	// FrameSize is the number of samples per channel in a 20ms frame.
+	FrameSize = SampleRate / 50
+	// PCMFrameLen is the number of int16 values in a 20ms interleaved stereo PCM frame.
+	PCMFrameLen = FrameSize * Channels
+)
+
+// PCMFrameProvider is used to provide 20ms interleaved stereo 48kHz PCM frames.
+type PCMFrameProvider interface {
+	// ProvidePCMFrame returns the next PCM frame of PCMFrameLen samples. A nil frame means there is currently no audio.
+	ProvidePCMFrame() ([]int16, error)
+
+	// Close is called when the PCMFrameProvider is no longer used.
+	Close()
+}
+
+// OpusEncoder encodes PCM frames to opus frames. disgo does not ship an opus encoder, use a library like gopus or layeh.com/gopus.
+type OpusEncoder interface {
+	// Encode encodes a PCM frame of PCMFrameLen samples to an opus frame.
+	Encode(pcm []int16) ([]byte, error)
+}
+
+var _ OpusFrameProvider = (*pcmOpusProvider)(nil)
+
+// NewPCMOpusProvider returns an OpusFrameProvider which encodes the frames of the given PCMFrameProvider with the given OpusEncoder.
+func NewPCMOpusProvider(encoder OpusEncoder, provider PCMFrameProvider) OpusFrameProvider {
+	return &pcmOpusProvider{
+		encoder:  encoder,
+		provider: provider,
+	}
+}
+
+type pcmOpusProvider struct {
+	encoder  OpusEncoder
+	provider PCMFrameProvider
+}
+
+func (p *pcmOpusProvider) ProvideOpusFrame() ([]byte, error) {
+	pcm, err := p.provider.ProvidePCMFrame()
+	if err != nil || pcm == nil {
+		return nil, err
+	}
+	return p.encoder.Encode(pcm)
+}
+
+func (p *pcmOpusProvider) Close() {
+	p.provider.Close()
+}
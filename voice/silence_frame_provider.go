@@ -0,0 +1,94 @@
+package voice
+
+import "sync"
+
+var _ OpusFrameProvider = (*silenceFrameProvider)(nil)
+
+// NewSilenceFrameProvider wraps the given OpusFrameProvider and appends SilenceAudioFrames SilenceAudioFrame(s) whenever its audio pauses or ends.
+// speakingFunc is called with true before the first frame after a pause and with false after the last SilenceAudioFrame was provided, so the speaking state can be sent at the right time.
+// This package has no voice connection, so the returned OpusFrameProvider is meant to be wrapped around the audio of your own sender, with speakingFunc sending OpcodeSpeaking.
+// speakingFunc is called from ProvideOpusFrame without holding any lock, so it may block on the network.
+func NewSilenceFrameProvider(provider OpusFrameProvider, speakingFunc func(speaking bool)) OpusFrameProvider {
+	if speakingFunc == nil {
+		speakingFunc = func(bool) {}
+	}
+	return &silenceFrameProvider{
+		provider:     provider,
+		speakingFunc: speakingFunc,
+	}
+}
+
+type silenceFrameProvider struct {
+	provider     OpusFrameProvider
+	speakingFunc func(speaking bool)
+
+	mu            sync.Mutex
+	speaking      bool
+	silenceFrames int
+	deferredErr   error
+}
+
+func (p *silenceFrameProvider) ProvideOpusFrame() ([]byte, error) {
+	p.mu.Lock()
+	wasSpeaking := p.speaking
+	frame, err := p.provideOpusFrame()
+	speaking := p.speaking
+	p.mu.Unlock()
+
+	if speaking != wasSpeaking {
+		p.speakingFunc(speaking)
+	}
+	return frame, err
+}
+
+// provideOpusFrame returns the next frame and updates the speaking state. It must be called with p.mu held.
+func (p *silenceFrameProvider) provideOpusFrame() ([]byte, error) {
+	// the underlying provider ended, send the remaining silence before returning its error
+	if p.deferredErr != nil {
+		if frame := p.nextSilenceFrame(); frame != nil {
+			return frame, nil
+		}
+		return nil, p.deferredErr
+	}
+
+	frame, err := p.provider.ProvideOpusFrame()
+	if err != nil {
+		if !p.speaking {
+			return nil, err
+		}
+		p.deferredErr = err
+		p.silenceFrames = SilenceAudioFrames
+		return p.nextSilenceFrame(), nil
+	}
+
+	if frame == nil {
+		if !p.speaking {
+			return nil, nil
+		}
+		if p.silenceFrames == 0 {
+			p.silenceFrames = SilenceAudioFrames
+		}
+		return p.nextSilenceFrame(), nil
+	}
+
+	// audio resumed, cancel any pending silence
+	p.silenceFrames = 0
+	p.speaking = true
+	return frame, nil
+}
+
+// nextSilenceFrame returns the next pending SilenceAudioFrame and stops speaking after the last one.
+func (p *silenceFrameProvider) nextSilenceFrame() []byte {
+	if p.silenceFrames == 0 {
+		return nil
+	}
+	p.silenceFrames--
+	if p.silenceFrames == 0 {
+		p.speaking = false
+	}
+	return SilenceAudioFrame
+}
+
+func (p *silenceFrameProvider) Close() {
+	p.provider.Close()
+}
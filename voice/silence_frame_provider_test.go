@@ -0,0 +1,70 @@
+package voice
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type sliceFrameProvider struct {
+	frames [][]byte
+}
+
+func (p *sliceFrameProvider) ProvideOpusFrame() ([]byte, error) {
+	if len(p.frames) == 0 {
+		return nil, io.EOF
+	}
+	frame := p.frames[0]
+	p.frames = p.frames[1:]
+	return frame, nil
+}
+
+func (p *sliceFrameProvider) Close() {}
+
+func TestSilenceFrameProvider(t *testing.T) {
+	audio := []byte{0x01}
+	var speaking []bool
+	provider := NewSilenceFrameProvider(&sliceFrameProvider{
+		frames: [][]byte{nil, audio, nil, nil, audio, audio},
+	}, func(s bool) {
+		speaking = append(speaking, s)
+	})
+
+	var frames [][]byte
+	for {
+		frame, err := provider.ProvideOpusFrame()
+		if err != nil {
+			assert.ErrorIs(t, err, io.EOF)
+			break
+		}
+		frames = append(frames, frame)
+	}
+
+	assert.Equal(t, [][]byte{
+		nil,
+		audio,
+		SilenceAudioFrame, SilenceAudioFrame,
+		audio, audio,
+		SilenceAudioFrame, SilenceAudioFrame, SilenceAudioFrame, SilenceAudioFrame, SilenceAudioFrame,
+	}, frames)
+	assert.Equal(t, []bool{true, false}, speaking)
+}
+
+func TestSilenceFrameProvider_SpeakingFuncUnlocked(t *testing.T) {
+	var provider *silenceFrameProvider
+	provider = NewSilenceFrameProvider(&sliceFrameProvider{
+		frames: [][]byte{{0x01}},
+	}, func(bool) {
+		// the speaking state is usually sent over the network, which must not block other callers
+		if assert.True(t, provider.mu.TryLock(), "speakingFunc was called while holding the lock") {
+			provider.mu.Unlock()
+		}
+	}).(*silenceFrameProvider)
+
+	for {
+		if _, err := provider.ProvideOpusFrame(); err != nil {
+			break
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package voice
+
+import (
+	"sync"
+	"time"
+)
+
+// EncryptionMode is the mode used to encrypt the packets of a voice connection, negotiated via OpcodeSelectProtocol.
+type EncryptionMode string
+
+// All EncryptionMode(s) supported by discord.
+const (
+	EncryptionModeAEADAES256GCMRTPSize         EncryptionMode = "aead_aes256_gcm_rtpsize"
+	EncryptionModeAEADXChaCha20Poly1305RTPSize EncryptionMode = "aead_xchacha20_poly1305_rtpsize"
+)
+
+// maxPendingKeepalives is the number of unanswered keepalives remembered by a StatsTracker. Older ones are considered lost.
+const maxPendingKeepalives = 16
+
+// Stats are statistics of a voice connection, for example to show them in a debug command of a music bot.
+type Stats struct {
+	// Ping is the round trip time of the UDP connection estimated from the keepalives. It is 0 until the first keepalive was answered.
+	Ping time.Duration
+	// PacketsSent is the number of audio packets sent.
+	PacketsSent uint64
+	// PacketsReceived is the number of audio packets received.
+	PacketsReceived uint64
+	// KeepalivesLost is the number of keepalives which were not answered.
+	KeepalivesLost uint64
+	// EncryptionMode is the EncryptionMode in use.
+	EncryptionMode EncryptionMode
+	// LastError is the last error of the connection and LastErrorAt when it happened.
+	LastError   error
+	LastErrorAt time.Time
+}
+
+// NewStatsTracker returns a new StatsTracker.
+func NewStatsTracker() *StatsTracker {
+	return &StatsTracker{
+		keepalives: map[uint32]time.Time{},
+	}
+}
+
+// StatsTracker collects the Stats of a voice connection. It is safe for concurrent use, so the send & receive loops of a connection can record into it directly.
+// This package has no voice connection exposing its Stats, so your connection records into the StatsTracker and exposes StatsTracker.Stats itself.
+type StatsTracker struct {
+	mu         sync.Mutex
+	stats      Stats
+	keepalives map[uint32]time.Time
+}
+
+// PacketSent records a sent audio packet.
+func (t *StatsTracker) PacketSent() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stats.PacketsSent++
+}
+
+// PacketReceived records a received audio packet.
+func (t *StatsTracker) PacketReceived() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stats.PacketsReceived++
+}
+
+// KeepaliveSent records a keepalive with the given sequence sent at the given time.
+func (t *StatsTracker) KeepaliveSent(sequence uint32, sentAt time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.keepalives) >= maxPendingKeepalives {
+		var oldest uint32
+		var oldestAt time.Time
+		for seq, at := range t.keepalives {
+			if oldestAt.IsZero() || at.Before(oldestAt) {
+				oldest, oldestAt = seq, at
+			}
+		}
+		delete(t.keepalives, oldest)
+		t.stats.KeepalivesLost++
+	}
+	t.keepalives[sequence] = sentAt
+}
+
+// KeepaliveReceived records the answer to the keepalive with the given sequence received at the given time and updates the Stats.Ping.
+// Like the smoothed round trip time of TCP, each measurement only accounts for 1/8 of the Ping, so single delayed packets don't make it jump.
+func (t *StatsTracker) KeepaliveReceived(sequence uint32, receivedAt time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sentAt, ok := t.keepalives[sequence]
+	if !ok {
+		return
+	}
+	delete(t.keepalives, sequence)
+	rtt := receivedAt.Sub(sentAt)
+	if t.stats.Ping == 0 {
+		t.stats.Ping = rtt
+		return
+	}
+	t.stats.Ping += (rtt - t.stats.Ping) / 8
+}
+
+// SetEncryptionMode sets the EncryptionMode in use.
+func (t *StatsTracker) SetEncryptionMode(mode EncryptionMode) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stats.EncryptionMode = mode
+}
+
+// Error records the given error of the connection which happened at the given time.
+func (t *StatsTracker) Error(err error, at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stats.LastError = err
+	t.stats.LastErrorAt = at
+}
+
+// Stats returns the current Stats.
+func (t *StatsTracker) Stats() Stats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.stats
+}
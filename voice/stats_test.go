@@ -0,0 +1,40 @@
+package voice
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsTracker(t *testing.T) {
+	tracker := NewStatsTracker()
+	now := time.Now()
+
+	tracker.PacketSent()
+	tracker.PacketSent()
+	tracker.PacketReceived()
+	tracker.SetEncryptionMode(EncryptionModeAEADAES256GCMRTPSize)
+
+	tracker.KeepaliveSent(1, now)
+	tracker.KeepaliveReceived(1, now.Add(80*time.Millisecond))
+	tracker.KeepaliveSent(2, now)
+	tracker.KeepaliveReceived(2, now.Add(160*time.Millisecond))
+	tracker.KeepaliveReceived(3, now.Add(time.Second))
+
+	err := errors.New("udp write failed")
+	tracker.Error(err, now)
+
+	stats := tracker.Stats()
+	assert.Equal(t, uint64(2), stats.PacketsSent)
+	assert.Equal(t, uint64(1), stats.PacketsReceived)
+	assert.Equal(t, 90*time.Millisecond, stats.Ping)
+	assert.Equal(t, EncryptionModeAEADAES256GCMRTPSize, stats.EncryptionMode)
+	assert.Equal(t, err, stats.LastError)
+
+	for i := uint32(10); i < 10+maxPendingKeepalives+2; i++ {
+		tracker.KeepaliveSent(i, now.Add(time.Duration(i)))
+	}
+	assert.Equal(t, uint64(2), tracker.Stats().KeepalivesLost)
+}
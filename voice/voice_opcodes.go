@@ -0,0 +1,125 @@
+// Package voice contains the types used to talk to the Discord voice gateway.
+// It does not implement a voice connection itself, but provides building blocks for one like OpusFrameProvider(s), Packet parsing and a StatsTracker.
+package voice
+
+import "fmt"
+
+// Opcode are opcodes used by the discord voice gateway
+type Opcode int
+
+// https://discord.com/developers/docs/topics/opcodes-and-status-codes#voice-voice-opcodes
+const (
+	OpcodeIdentify Opcode = iota
+	OpcodeSelectProtocol
+	OpcodeReady
+	OpcodeHeartbeat
+	OpcodeSessionDescription
+	OpcodeSpeaking
+	OpcodeHeartbeatACK
+	OpcodeResume
+	OpcodeHello
+	OpcodeResumed
+	_
+	_
+	_
+	OpcodeClientDisconnect
+)
+
+func (o Opcode) String() string {
+	switch o {
+	case OpcodeIdentify:
+		return "Identify"
+	case OpcodeSelectProtocol:
+		return "SelectProtocol"
+	case OpcodeReady:
+		return "Ready"
+	case OpcodeHeartbeat:
+		return "Heartbeat"
+	case OpcodeSessionDescription:
+		return "SessionDescription"
+	case OpcodeSpeaking:
+		return "Speaking"
+	case OpcodeHeartbeatACK:
+		return "HeartbeatACK"
+	case OpcodeResume:
+		return "Resume"
+	case OpcodeHello:
+		return "Hello"
+	case OpcodeResumed:
+		return "Resumed"
+	case OpcodeClientDisconnect:
+		return "ClientDisconnect"
+	default:
+		return fmt.Sprintf("Opcode(%d)", int(o))
+	}
+}
+
+type CloseEventCode int
+
+// https://discord.com/developers/docs/topics/opcodes-and-status-codes#voice-voice-close-event-codes
+const (
+	CloseEventCodeUnknownOpcode CloseEventCode = iota + 4001
+	CloseEventCodeFailedToDecode
+	CloseEventCodeNotAuthenticated
+	CloseEventCodeAuthenticationFailed
+	CloseEventCodeAlreadyAuthenticated
+	CloseEventCodeSessionNoLongerValid
+	_
+	_
+	CloseEventCodeSessionTimeout
+	_
+	CloseEventCodeServerNotFound
+	CloseEventCodeUnknownProtocol
+	_
+	CloseEventCodeDisconnected
+	CloseEventCodeVoiceServerCrashed
+	CloseEventCodeUnknownEncryptionMode
+)
+
+func (c CloseEventCode) String() string {
+	switch c {
+	case CloseEventCodeUnknownOpcode:
+		return "UnknownOpcode"
+	case CloseEventCodeFailedToDecode:
+		return "FailedToDecode"
+	case CloseEventCodeNotAuthenticated:
+		return "NotAuthenticated"
+	case CloseEventCodeAuthenticationFailed:
+		return "AuthenticationFailed"
+	case CloseEventCodeAlreadyAuthenticated:
+		return "AlreadyAuthenticated"
+	case CloseEventCodeSessionNoLongerValid:
+		return "SessionNoLongerValid"
+	case CloseEventCodeSessionTimeout:
+		return "SessionTimeout"
+	case CloseEventCodeServerNotFound:
+		return "ServerNotFound"
+	case CloseEventCodeUnknownProtocol:
+		return "UnknownProtocol"
+	case CloseEventCodeDisconnected:
+		return "Disconnected"
+	case CloseEventCodeVoiceServerCrashed:
+		return "VoiceServerCrashed"
+	case CloseEventCodeUnknownEncryptionMode:
+		return "UnknownEncryptionMode"
+	default:
+		return fmt.Sprintf("CloseEventCode(%d)", int(c))
+	}
+}
+
+// ShouldReconnect returns whether a voice connection closed with this code can be reconnected.
+// Codes like CloseEventCodeDisconnected mean the bot was kicked or the channel was deleted and a new voice session has to be requested via the gateway instead.
+func (c CloseEventCode) ShouldReconnect() bool {
+	switch c {
+	case CloseEventCodeAuthenticationFailed,
+		CloseEventCodeSessionNoLongerValid,
+		CloseEventCodeServerNotFound,
+		CloseEventCodeUnknownProtocol,
+		CloseEventCodeDisconnected,
+		CloseEventCodeUnknownEncryptionMode:
+		return false
+
+	default:
+		return true
+	}
+}
@@ -32,11 +32,18 @@ type Client interface {
 	CreateMessage(messageCreate discord.WebhookMessageCreate, opts ...rest.RequestOpt) (*discord.Message, error)
 	// CreateMessageInThread creates a new Message from the discord.WebhookMessageCreate in the provided thread
 	CreateMessageInThread(messageCreate discord.WebhookMessageCreate, threadID snowflake.ID, opts ...rest.RequestOpt) (*discord.Message, error)
+	// CreateForumPost creates a new thread with the provided name and forum tags in the forum channel of the Webhook and posts the discord.WebhookMessageCreate as first Message
+	CreateForumPost(messageCreate discord.WebhookMessageCreate, threadName string, appliedTags []snowflake.ID, opts ...rest.RequestOpt) (*discord.Message, error)
 	// CreateContent creates a new Message from the provided content
 	CreateContent(content string, opts ...rest.RequestOpt) (*discord.Message, error)
 	// CreateEmbeds creates a new Message from the provided discord.Embed(s)
 	CreateEmbeds(embeds []discord.Embed, opts ...rest.RequestOpt) (*discord.Message, error)
 
+	// GetMessage returns an already sent Webhook Message
+	GetMessage(messageID snowflake.ID, opts ...rest.RequestOpt) (*discord.Message, error)
+	// GetMessageInThread returns an already sent Webhook Message in the provided thread
+	GetMessageInThread(messageID snowflake.ID, threadID snowflake.ID, opts ...rest.RequestOpt) (*discord.Message, error)
+
 	// UpdateMessage updates an already sent Webhook Message with the discord.WebhookMessageUpdate
 	UpdateMessage(messageID snowflake.ID, messageUpdate discord.WebhookMessageUpdate, opts ...rest.RequestOpt) (*discord.Message, error)
 	// UpdateMessageInThread updates an already sent Webhook Message with the discord.WebhookMessageUpdate in the provided thread
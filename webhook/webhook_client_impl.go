@@ -76,6 +76,12 @@ func (c *clientImpl) CreateMessage(messageCreate discord.WebhookMessageCreate, o
 	return c.CreateMessageInThread(messageCreate, 0, opts...)
 }
 
+func (c *clientImpl) CreateForumPost(messageCreate discord.WebhookMessageCreate, threadName string, appliedTags []snowflake.ID, opts ...rest.RequestOpt) (*discord.Message, error) {
+	messageCreate.ThreadName = threadName
+	messageCreate.AppliedTags = appliedTags
+	return c.CreateMessage(messageCreate, opts...)
+}
+
 func (c *clientImpl) CreateContent(content string, opts ...rest.RequestOpt) (*discord.Message, error) {
 	return c.CreateMessage(discord.WebhookMessageCreate{Content: content}, opts...)
 }
@@ -84,6 +90,14 @@ func (c *clientImpl) CreateEmbeds(embeds []discord.Embed, opts ...rest.RequestOp
 	return c.CreateMessage(discord.WebhookMessageCreate{Embeds: embeds}, opts...)
 }
 
+func (c *clientImpl) GetMessage(messageID snowflake.ID, opts ...rest.RequestOpt) (*discord.Message, error) {
+	return c.GetMessageInThread(messageID, 0, opts...)
+}
+
+func (c *clientImpl) GetMessageInThread(messageID snowflake.ID, threadID snowflake.ID, opts ...rest.RequestOpt) (*discord.Message, error) {
+	return c.Rest().GetWebhookMessage(c.id, c.token, messageID, threadID, opts...)
+}
+
 func (c *clientImpl) UpdateMessage(messageID snowflake.ID, messageUpdate discord.WebhookMessageUpdate, opts ...rest.RequestOpt) (*discord.Message, error) {
 	return c.UpdateMessageInThread(messageID, messageUpdate, 0, opts...)
 }